@@ -79,32 +79,39 @@ func (s *activityLogServiceImpl) GetUserHistory(ctx context.Context, userID uuid
 		return nil, 0, err
 	}
 
+	return s.toHistoryResponses(ctx, logs), total, nil
+}
+
+// GetUserHistoryAfter - GetUserHistory แบบ cursor (ไม่นับ total)
+func (s *activityLogServiceImpl) GetUserHistoryAfter(ctx context.Context, userID uuid.UUID, cursor string, limit int) ([]*dto.ActivityLogResponse, string, error) {
+	after, err := keysetFromCursor(cursor, activityCursorSort)
+	if err != nil {
+		return nil, "", err
+	}
+
+	logs, err := s.repo.GetByUserAfter(ctx, userID, limit+1, after)
+	if err != nil {
+		return nil, "", err
+	}
+
+	logs, nextCursor := activityPage(logs, limit)
+	return s.toHistoryResponses(ctx, logs), nextCursor, nil
+}
+
+func (s *activityLogServiceImpl) toHistoryResponses(ctx context.Context, logs []*models.ActivityLog) []*dto.ActivityLogResponse {
 	responses := dto.ActivityLogsToResponse(logs)
 
 	// Enrich with video titles
-	videoIDs := make([]uuid.UUID, 0)
-	for _, log := range logs {
+	titles := s.videoTitles(ctx, logs)
+	for i, log := range logs {
 		if log.PageType == models.PageTypeVideo && log.PageID != nil {
-			videoIDs = append(videoIDs, *log.PageID)
-		}
-	}
-
-	if len(videoIDs) > 0 {
-		titles, err := s.videoRepo.GetTitlesByIDs(ctx, videoIDs)
-		if err != nil {
-			logger.WarnContext(ctx, "Failed to get video titles for user history", "error", err)
-		} else {
-			for i, log := range logs {
-				if log.PageType == models.PageTypeVideo && log.PageID != nil {
-					if title, ok := titles[*log.PageID]; ok {
-						responses[i].PageTitle = &title
-					}
-				}
+			if title, ok := titles[*log.PageID]; ok {
+				responses[i].PageTitle = &title
 			}
 		}
 	}
 
-	return responses, total, nil
+	return responses
 }
 
 func (s *activityLogServiceImpl) GetPageViews(ctx context.Context, pageType string, pageID *uuid.UUID) (int64, error) {
@@ -168,30 +175,67 @@ func (s *activityLogServiceImpl) GetAllActivity(ctx context.Context, pageType st
 		return nil, 0, err
 	}
 
+	return s.toWithUserResponses(ctx, logs), total, nil
+}
+
+// GetAllActivityAfter - GetAllActivity แบบ cursor (ไม่นับ total)
+func (s *activityLogServiceImpl) GetAllActivityAfter(ctx context.Context, pageType string, cursor string, limit int) ([]*dto.ActivityLogWithUserResponse, string, error) {
+	after, err := keysetFromCursor(cursor, activityCursorSort)
+	if err != nil {
+		return nil, "", err
+	}
+
+	logs, err := s.repo.GetAllAfter(ctx, pageType, limit+1, after)
+	if err != nil {
+		return nil, "", err
+	}
+
+	logs, nextCursor := activityPage(logs, limit)
+	return s.toWithUserResponses(ctx, logs), nextCursor, nil
+}
+
+func (s *activityLogServiceImpl) toWithUserResponses(ctx context.Context, logs []*models.ActivityLog) []*dto.ActivityLogWithUserResponse {
 	responses := dto.ActivityLogsToWithUserResponse(logs)
 
 	// Enrich with video titles
+	titles := s.videoTitles(ctx, logs)
+	for i, log := range logs {
+		if log.PageType == models.PageTypeVideo && log.PageID != nil {
+			if title, ok := titles[*log.PageID]; ok {
+				responses[i].PageTitle = &title
+			}
+		}
+	}
+
+	return responses
+}
+
+// videoTitles ดึงชื่อ video ของ logs ที่เป็น page video (ดึงไม่ได้ก็แสดงแบบไม่มีชื่อ)
+func (s *activityLogServiceImpl) videoTitles(ctx context.Context, logs []*models.ActivityLog) map[uuid.UUID]string {
 	videoIDs := make([]uuid.UUID, 0)
 	for _, log := range logs {
 		if log.PageType == models.PageTypeVideo && log.PageID != nil {
 			videoIDs = append(videoIDs, *log.PageID)
 		}
 	}
+	if len(videoIDs) == 0 {
+		return nil
+	}
 
-	if len(videoIDs) > 0 {
-		titles, err := s.videoRepo.GetTitlesByIDs(ctx, videoIDs)
-		if err != nil {
-			logger.WarnContext(ctx, "Failed to get video titles", "error", err)
-		} else {
-			for i, log := range logs {
-				if log.PageType == models.PageTypeVideo && log.PageID != nil {
-					if title, ok := titles[*log.PageID]; ok {
-						responses[i].PageTitle = &title
-					}
-				}
-			}
-		}
+	titles, err := s.videoRepo.GetTitlesByIDs(ctx, videoIDs)
+	if err != nil {
+		logger.WarnContext(ctx, "Failed to get video titles", "error", err)
+		return nil
 	}
+	return titles
+}
 
-	return responses, total, nil
+// activityPage ตัดแถวที่ดึงเกินมา 1 แถวออก แล้วสร้าง cursor ของหน้าถัดไป
+func activityPage(logs []*models.ActivityLog, limit int) ([]*models.ActivityLog, string) {
+	if len(logs) <= limit {
+		return logs, ""
+	}
+	logs = logs[:limit]
+	last := logs[limit-1]
+	return logs, timeCursor(activityCursorSort, last.CreatedAt, last.ID)
 }
@@ -0,0 +1,86 @@
+package serviceimpl
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"gofiber-template/domain/models"
+	"gofiber-template/infrastructure/redis"
+	"gofiber-template/pkg/cache"
+	"gofiber-template/pkg/logger"
+)
+
+// invalidateArticlePages ล้าง cache หน้าสาธารณะที่บทความเหล่านี้อาจโผล่:
+// detail, related, list, RSS/Atom feed และหน้า cast/tag/maker ของ video (video = nil ข้ามส่วนนี้)
+// ใช้ทั้งตอน publish/แก้ไข และตอนบทความเข้า/ออกจากถังขยะ (ไม่งั้นบทความที่ลบแล้วยังถูกเสิร์ฟจาก cache จนหมด TTL)
+func invalidateArticlePages(ctx context.Context, c *redis.RedisClient, articles []models.Article, video *models.Video) {
+	if c == nil {
+		return
+	}
+
+	for _, article := range articles {
+		cacheKey := cache.ArticleKeyWithLang(string(article.Type), article.Slug, article.Language)
+		if err := c.Delete(ctx, cacheKey); err == nil {
+			logger.InfoContext(ctx, "Article cache invalidated", "cache_key", cacheKey)
+		}
+		invalidateRelatedArticles(ctx, c, article.ID)
+	}
+
+	// Article list + RSS/Atom feeds (all + by type)
+	if deleted, err := c.DeleteByPattern(ctx, cache.ArticleListPattern()); err == nil && deleted > 0 {
+		logger.InfoContext(ctx, "Article list cache invalidated", "deleted_keys", deleted)
+	}
+	if deleted, err := c.DeleteByPattern(ctx, cache.FeedListPattern()); err == nil && deleted > 0 {
+		logger.InfoContext(ctx, "Article feed cache invalidated", "deleted_keys", deleted)
+	}
+
+	if video == nil {
+		return
+	}
+
+	// Cast/tag/maker pages + feeds
+	for _, cast := range video.Casts {
+		if deleted, err := c.DeleteByPattern(ctx, cache.ArticleByCastPattern(cast.Slug)); err == nil && deleted > 0 {
+			logger.InfoContext(ctx, "Cast articles cache invalidated", "cast_slug", cast.Slug, "deleted_keys", deleted)
+		}
+		_, _ = c.DeleteByPattern(ctx, cache.FeedByCastPattern(cast.Slug))
+	}
+	for _, tag := range video.Tags {
+		if deleted, err := c.DeleteByPattern(ctx, cache.ArticleByTagPattern(tag.Slug)); err == nil && deleted > 0 {
+			logger.InfoContext(ctx, "Tag articles cache invalidated", "tag_slug", tag.Slug, "deleted_keys", deleted)
+		}
+		_, _ = c.DeleteByPattern(ctx, cache.FeedByTagPattern(tag.Slug))
+	}
+	if video.Maker != nil {
+		if deleted, err := c.DeleteByPattern(ctx, cache.ArticleByMakerPattern(video.Maker.Slug)); err == nil && deleted > 0 {
+			logger.InfoContext(ctx, "Maker articles cache invalidated", "maker_slug", video.Maker.Slug, "deleted_keys", deleted)
+		}
+		_, _ = c.DeleteByPattern(ctx, cache.FeedByMakerPattern(video.Maker.Slug))
+	}
+}
+
+// invalidateRelatedArticles ล้าง related cache ของบทความนี้
+// และของทุกบทความที่ related list มีบทความนี้อยู่ (ตาม refs set)
+func invalidateRelatedArticles(ctx context.Context, c *redis.RedisClient, articleID uuid.UUID) {
+	if c == nil {
+		return
+	}
+
+	_, _ = c.DeleteByPattern(ctx, cache.ArticleRelatedPattern(articleID.String()))
+
+	refsKey := cache.ArticleRelatedRefsKey(articleID.String())
+	sourceIDs, err := c.SetMembers(ctx, refsKey)
+	if err != nil {
+		logger.WarnContext(ctx, "Failed to read related article refs", "article_id", articleID, "error", err)
+		return
+	}
+	for _, sourceID := range sourceIDs {
+		_, _ = c.DeleteByPattern(ctx, cache.ArticleRelatedPattern(sourceID))
+	}
+	_ = c.Delete(ctx, refsKey)
+
+	if len(sourceIDs) > 0 {
+		logger.InfoContext(ctx, "Related article caches invalidated", "article_id", articleID, "sources", len(sourceIDs))
+	}
+}
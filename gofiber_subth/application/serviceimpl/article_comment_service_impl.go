@@ -141,6 +141,29 @@ func (s *articleCommentServiceImpl) ListByArticle(ctx context.Context, articleID
 	return dto.ToArticleCommentResponseList(comments), total, nil
 }
 
+func (s *articleCommentServiceImpl) ListByArticleAfter(ctx context.Context, articleID uuid.UUID, cursor string, limit int) ([]dto.ArticleCommentResponse, string, error) {
+	after, err := keysetFromCursor(cursor, commentCursorSort)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// ดึงเกิน 1 แถวเพื่อรู้ว่ามีหน้าถัดไปหรือไม่
+	comments, err := s.commentRepo.ListByArticleAfter(ctx, articleID, limit+1, after)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list article comments", "error", err)
+		return nil, "", err
+	}
+
+	nextCursor := ""
+	if len(comments) > limit {
+		comments = comments[:limit]
+		last := comments[limit-1]
+		nextCursor = timeCursor(commentCursorSort, last.CreatedAt, last.ID)
+	}
+
+	return dto.ToArticleCommentResponseList(comments), nextCursor, nil
+}
+
 func (s *articleCommentServiceImpl) ListReplies(ctx context.Context, parentID uuid.UUID, page, limit int) ([]dto.ArticleCommentResponse, int64, error) {
 	offset := (page - 1) * limit
 	replies, total, err := s.commentRepo.ListReplies(ctx, parentID, limit, offset)
@@ -0,0 +1,372 @@
+package serviceimpl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/repositories"
+	"gofiber-template/domain/services"
+	"gofiber-template/pkg/logger"
+)
+
+// ========================================
+// Article Coverage Report + "needs article" queue
+// ========================================
+// รายงาน: video ที่ยังไม่มีบทความ (ต่อภาษา/type) และ cast/maker ที่ยังไม่มีบทความรวม
+// คิว: admin enqueue video ที่ขาดบทความ -> SEO worker claim (lease) -> IngestArticle ปิดงาน
+
+var coverageLanguages = []string{"th", "en"}
+
+const (
+	coverageEnqueueDefaultLimit = 500
+	queueDefaultLeaseDuration   = 15 * time.Minute
+)
+
+type articleCoverageServiceImpl struct {
+	coverageRepo repositories.ArticleCoverageRepository
+	makerRepo    repositories.MakerRepository
+	castRepo     repositories.CastRepository
+	categoryRepo repositories.CategoryRepository
+}
+
+func NewArticleCoverageService(
+	coverageRepo repositories.ArticleCoverageRepository,
+	makerRepo repositories.MakerRepository,
+	castRepo repositories.CastRepository,
+	categoryRepo repositories.CategoryRepository,
+) services.ArticleCoverageService {
+	return &articleCoverageServiceImpl{
+		coverageRepo: coverageRepo,
+		makerRepo:    makerRepo,
+		castRepo:     castRepo,
+		categoryRepo: categoryRepo,
+	}
+}
+
+// resolveFilter แปลง slug เป็น ID
+func (s *articleCoverageServiceImpl) resolveFilter(ctx context.Context, maker, cast, category, seoStatus string) (repositories.CoverageFilter, error) {
+	filter := repositories.CoverageFilter{SEOStatus: seoStatus}
+
+	if maker != "" {
+		m, err := s.makerRepo.GetBySlug(ctx, maker)
+		if err != nil {
+			return filter, errors.New("maker not found")
+		}
+		filter.MakerID = &m.ID
+	}
+	if cast != "" {
+		c, err := s.castRepo.GetBySlug(ctx, cast)
+		if err != nil {
+			return filter, errors.New("cast not found")
+		}
+		filter.CastID = &c.ID
+	}
+	if category != "" {
+		c, err := s.categoryRepo.GetBySlug(ctx, category)
+		if err != nil {
+			return filter, errors.New("category not found")
+		}
+		filter.CategoryID = &c.ID
+	}
+	return filter, nil
+}
+
+func (s *articleCoverageServiceImpl) GetCoverage(ctx context.Context, params *dto.ArticleCoverageParams) (*dto.ArticleCoverageResponse, error) {
+	params.SetDefaults()
+
+	filter, err := s.resolveFilter(ctx, params.Maker, params.Cast, params.Category, params.SEOStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := s.coverageRepo.CountVideos(ctx, filter)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to count videos for coverage", "error", err)
+		return nil, err
+	}
+
+	langRows, err := s.coverageRepo.LanguageCoverage(ctx, filter, coverageLanguages)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to get language coverage", "error", err)
+		return nil, err
+	}
+
+	typeRows, err := s.coverageRepo.TypeCoverage(ctx, filter)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to get type coverage", "error", err)
+		return nil, err
+	}
+
+	response := &dto.ArticleCoverageResponse{
+		TotalVideos: total,
+		Languages:   make([]dto.LanguageCoverageResponse, 0, len(langRows)),
+	}
+	for _, row := range langRows {
+		lang := dto.LanguageCoverageResponse{
+			Language:    row.Language,
+			WithArticle: row.WithArticle,
+			Published:   row.Published,
+			Missing:     total - row.WithArticle,
+			Queued:      row.Queued,
+			ByType:      []dto.TypeCoverageResponse{},
+		}
+		for _, t := range typeRows {
+			if t.Language == row.Language {
+				lang.ByType = append(lang.ByType, dto.TypeCoverageResponse{Type: t.Type, Total: t.Total, Published: t.Published})
+			}
+		}
+		response.Languages = append(response.Languages, lang)
+	}
+
+	// Hub: cast ต้องมี ranking, maker ต้องมี best-of (เงื่อนไขเดียวกับ generator)
+	// filter maker/cast จำกัด hub ด้วย, category/seo_status ไม่เกี่ยวกับ hub
+	hubs := []struct {
+		kind        string
+		articleKind string
+		slugFormat  string
+		subjectID   *uuid.UUID
+	}{
+		{"cast", GeneratorKindCastRanking, castRankingSlugFormat, filter.CastID},
+		// best-of มีหลาย sortBy -> ตัวไหนก็ได้ (%% = LIKE wildcard หลัง format)
+		{"maker", GeneratorKindMakerBestOf, fmt.Sprintf(makerBestOfSlugFormat, "%s", "%%"), filter.MakerID},
+	}
+	for _, hub := range hubs {
+		// กรองด้วย cast อย่างเดียว ไม่ต้องแสดง maker hub ทั้งหมด (และกลับกัน)
+		if (hub.kind == "cast" && filter.MakerID != nil && filter.CastID == nil) ||
+			(hub.kind == "maker" && filter.CastID != nil && filter.MakerID == nil) {
+			continue
+		}
+		for _, lang := range coverageLanguages {
+			result, err := s.coverageRepo.HubCoverage(ctx, repositories.HubCoverageParams{
+				Kind:       hub.kind,
+				Language:   lang,
+				SlugFormat: hub.slugFormat,
+				MinVideos:  generatorMinVideos,
+				SubjectID:  hub.subjectID,
+				Limit:      params.HubLimit,
+			})
+			if err != nil {
+				logger.ErrorContext(ctx, "Failed to get hub coverage", "kind", hub.kind, "language", lang, "error", err)
+				return nil, err
+			}
+
+			item := dto.HubCoverageResponse{
+				Kind:        hub.kind,
+				ArticleKind: hub.articleKind,
+				Language:    lang,
+				Eligible:    result.Total,
+				Covered:     result.Covered,
+				Missing:     make([]dto.HubGapResponse, 0, len(result.Missing)),
+			}
+			for _, gap := range result.Missing {
+				item.Missing = append(item.Missing, dto.HubGapResponse{
+					ID:         gap.ID.String(),
+					Name:       gap.Name,
+					Slug:       gap.Slug,
+					VideoCount: gap.VideoCount,
+				})
+			}
+			response.Hubs = append(response.Hubs, item)
+		}
+	}
+
+	queue, err := s.coverageRepo.CountQueueByStatus(ctx)
+	if err != nil {
+		logger.WarnContext(ctx, "Failed to count article queue", "error", err)
+	}
+	response.Queue = queue
+
+	return response, nil
+}
+
+func (s *articleCoverageServiceImpl) ListMissingVideos(ctx context.Context, params *dto.ArticleCoverageMissingParams) ([]dto.MissingVideoResponse, int64, error) {
+	params.SetDefaults()
+
+	filter, err := s.resolveFilter(ctx, params.Maker, params.Cast, params.Category, params.SEOStatus)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, total, err := s.coverageRepo.ListMissingVideos(ctx, filter, params.Lang, (params.Page-1)*params.Limit, params.Limit)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list videos missing articles", "language", params.Lang, "error", err)
+		return nil, 0, err
+	}
+
+	result := make([]dto.MissingVideoResponse, len(rows))
+	for i, row := range rows {
+		item := dto.MissingVideoResponse{
+			VideoID:     row.VideoID.String(),
+			Code:        row.Code,
+			Title:       row.Title,
+			SEOStatus:   row.SEOStatus,
+			Views:       row.Views,
+			QueueStatus: row.QueueStatus,
+		}
+		if row.ReleaseDate != nil {
+			d := row.ReleaseDate.Format("2006-01-02")
+			item.ReleaseDate = &d
+		}
+		result[i] = item
+	}
+	return result, total, nil
+}
+
+func (s *articleCoverageServiceImpl) EnqueueMissing(ctx context.Context, req *dto.EnqueueCoverageRequest) (*dto.EnqueueCoverageResponse, error) {
+	filter, err := s.resolveFilter(ctx, req.Maker, req.Cast, req.Category, req.SEOStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	languages := req.Languages
+	if len(languages) == 0 {
+		languages = coverageLanguages
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = coverageEnqueueDefaultLimit
+	}
+
+	response := &dto.EnqueueCoverageResponse{Enqueued: make(map[string]int64, len(languages))}
+	for _, lang := range languages {
+		count, err := s.coverageRepo.EnqueueMissing(ctx, filter, lang, limit)
+		if err != nil {
+			logger.ErrorContext(ctx, "Failed to enqueue videos missing articles", "language", lang, "error", err)
+			return nil, err
+		}
+		response.Enqueued[lang] = count
+	}
+
+	logger.InfoContext(ctx, "Videos missing articles enqueued", "enqueued", response.Enqueued)
+	return response, nil
+}
+
+func (s *articleCoverageServiceImpl) ListQueue(ctx context.Context, params *dto.ArticleQueueListParams) ([]dto.ArticleQueueItemResponse, int64, error) {
+	params.SetDefaults()
+
+	items, total, err := s.coverageRepo.ListQueue(ctx, repositories.QueueListParams{
+		Status:   params.Status,
+		Language: params.Lang,
+		Offset:   (params.Page - 1) * params.Limit,
+		Limit:    params.Limit,
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list article queue", "error", err)
+		return nil, 0, err
+	}
+
+	result := make([]dto.ArticleQueueItemResponse, len(items))
+	for i := range items {
+		result[i] = toQueueItemResponse(&items[i], false)
+	}
+	return result, total, nil
+}
+
+func (s *articleCoverageServiceImpl) ClaimQueueItems(ctx context.Context, req *dto.ClaimArticleQueueRequest) ([]dto.ArticleQueueItemResponse, error) {
+	limit := req.Limit
+	if limit == 0 {
+		limit = 1
+	}
+
+	items, err := s.coverageRepo.Claim(ctx, repositories.QueueClaimParams{
+		WorkerID:   req.WorkerID,
+		Language:   req.Language,
+		Limit:      limit,
+		LeaseUntil: time.Now().Add(leaseDuration(req.LeaseSeconds)),
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to claim article queue items", "worker_id", req.WorkerID, "error", err)
+		return nil, err
+	}
+
+	result := make([]dto.ArticleQueueItemResponse, len(items))
+	for i := range items {
+		result[i] = toQueueItemResponse(&items[i], true)
+	}
+
+	if len(items) > 0 {
+		logger.InfoContext(ctx, "Article queue items claimed", "worker_id", req.WorkerID, "count", len(items))
+	}
+	return result, nil
+}
+
+func (s *articleCoverageServiceImpl) ExtendLease(ctx context.Context, id uuid.UUID, req *dto.ArticleQueueLeaseRequest) (*dto.ArticleQueueItemResponse, error) {
+	token, err := uuid.Parse(req.LeaseToken)
+	if err != nil {
+		return nil, errors.New("lease not found")
+	}
+
+	item, err := s.coverageRepo.ExtendLease(ctx, id, token, time.Now().Add(leaseDuration(req.LeaseSeconds)))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("lease not found")
+		}
+		logger.ErrorContext(ctx, "Failed to extend article queue lease", "item_id", id, "error", err)
+		return nil, err
+	}
+
+	response := toQueueItemResponse(item, true)
+	return &response, nil
+}
+
+func (s *articleCoverageServiceImpl) ReleaseLease(ctx context.Context, id uuid.UUID, req *dto.ArticleQueueLeaseRequest) error {
+	token, err := uuid.Parse(req.LeaseToken)
+	if err != nil {
+		return errors.New("lease not found")
+	}
+
+	if err := s.coverageRepo.ReleaseLease(ctx, id, token); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("lease not found")
+		}
+		logger.ErrorContext(ctx, "Failed to release article queue lease", "item_id", id, "error", err)
+		return err
+	}
+
+	logger.InfoContext(ctx, "Article queue lease released", "item_id", id)
+	return nil
+}
+
+func leaseDuration(seconds int) time.Duration {
+	if seconds <= 0 {
+		return queueDefaultLeaseDuration
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// toQueueItemResponse - withToken = ส่ง leaseToken (เฉพาะ worker ที่ถือ lease)
+func toQueueItemResponse(item *models.ArticleQueueItem, withToken bool) dto.ArticleQueueItemResponse {
+	response := dto.ArticleQueueItemResponse{
+		ID:        item.ID.String(),
+		VideoID:   item.VideoID.String(),
+		Language:  item.Language,
+		Type:      string(item.Type),
+		Status:    string(item.Status),
+		Priority:  item.Priority,
+		LeasedBy:  item.LeasedBy,
+		Attempts:  item.Attempts,
+		CreatedAt: item.CreatedAt.Format(time.RFC3339),
+	}
+	if item.Video != nil {
+		response.VideoCode = item.Video.Code
+	}
+	if withToken && item.LeaseToken != nil {
+		response.LeaseToken = item.LeaseToken.String()
+	}
+	if item.Status == models.ArticleQueueLeased && item.LeaseExpiresAt != nil {
+		t := item.LeaseExpiresAt.Format(time.RFC3339)
+		response.LeaseExpiresAt = &t
+	}
+	if item.CompletedAt != nil {
+		t := item.CompletedAt.Format(time.RFC3339)
+		response.CompletedAt = &t
+	}
+	return response
+}
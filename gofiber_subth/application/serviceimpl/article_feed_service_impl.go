@@ -0,0 +1,230 @@
+package serviceimpl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/repositories"
+	"gofiber-template/domain/services"
+	"gofiber-template/infrastructure/redis"
+	"gofiber-template/pkg/cache"
+	"gofiber-template/pkg/logger"
+	"gofiber-template/pkg/seo"
+)
+
+// articleFeedLimit - จำนวนบทความล่าสุดต่อ feed
+const articleFeedLimit = 30
+
+// articleTypeFeedTitles - ชื่อ feed ตาม type แยกภาษา
+var articleTypeFeedTitles = map[string]map[string]string{
+	"review":  {"th": "รีวิวล่าสุด", "en": "Latest Reviews"},
+	"ranking": {"th": "จัดอันดับ", "en": "Rankings"},
+	"best-of": {"th": "รวมที่สุด", "en": "Best Of"},
+	"guide":   {"th": "คู่มือ", "en": "Guides"},
+	"news":    {"th": "ข่าว", "en": "News"},
+	"":        {"th": "บทความล่าสุด", "en": "Latest Articles"},
+}
+
+type ArticleFeedServiceImpl struct {
+	articleRepo repositories.ArticleRepository
+	cache       *redis.RedisClient
+	siteURL     string
+}
+
+func NewArticleFeedService(
+	articleRepo repositories.ArticleRepository,
+	cache *redis.RedisClient,
+	siteURL string,
+) services.ArticleFeedService {
+	return &ArticleFeedServiceImpl{
+		articleRepo: articleRepo,
+		cache:       cache,
+		siteURL:     siteURL,
+	}
+}
+
+func (s *ArticleFeedServiceImpl) GetLatestFeed(ctx context.Context, format string, params *dto.ArticleFeedParams) (*dto.ArticleFeedResponse, error) {
+	params.SetDefaults()
+	if params.Type != "" && !models.IsValidArticleType(params.Type) {
+		return nil, errors.New("invalid article type")
+	}
+
+	channel := seo.FeedChannel{
+		Title: fmt.Sprintf("%s - %s", seo.SiteName, localizedFeedTitle(articleTypeFeedTitles[params.Type], params.Lang)),
+		Link:  seo.PageURL(s.siteURL, seo.LangPrefix(params.Lang)+"/articles"),
+	}
+	if params.Type != "" {
+		channel.Link += "?type=" + params.Type
+	}
+
+	return s.getFeed(ctx, cache.FeedListKey(params.Type, params.Lang, format), format, params.Lang, channel,
+		func(p repositories.PublicArticleListParams) ([]repositories.PublishedArticleWithVideo, int64, error) {
+			p.ArticleType = params.Type
+			return s.articleRepo.ListPublished(ctx, p)
+		}, "", nil)
+}
+
+func (s *ArticleFeedServiceImpl) GetCastFeed(ctx context.Context, castSlug string, format string, params *dto.ArticleFeedParams) (*dto.ArticleFeedResponse, error) {
+	params.SetDefaults()
+
+	channel := seo.FeedChannel{Link: seo.PageURL(s.siteURL, seo.CastPath(params.Lang, castSlug))}
+
+	return s.getFeed(ctx, cache.FeedByCastKey(castSlug, params.Lang, format), format, params.Lang, channel,
+		func(p repositories.PublicArticleListParams) ([]repositories.PublishedArticleWithVideo, int64, error) {
+			return s.articleRepo.ListPublishedByCast(ctx, castSlug, p)
+		},
+		castSlug, func(a repositories.PublishedArticleWithVideo) ([]string, []string) {
+			return a.CastSlugs, a.CastNames
+		})
+}
+
+func (s *ArticleFeedServiceImpl) GetTagFeed(ctx context.Context, tagSlug string, format string, params *dto.ArticleFeedParams) (*dto.ArticleFeedResponse, error) {
+	params.SetDefaults()
+
+	channel := seo.FeedChannel{Link: seo.PageURL(s.siteURL, seo.TagPath(params.Lang, tagSlug))}
+
+	return s.getFeed(ctx, cache.FeedByTagKey(tagSlug, params.Lang, format), format, params.Lang, channel,
+		func(p repositories.PublicArticleListParams) ([]repositories.PublishedArticleWithVideo, int64, error) {
+			return s.articleRepo.ListPublishedByTag(ctx, tagSlug, p)
+		},
+		tagSlug, func(a repositories.PublishedArticleWithVideo) ([]string, []string) {
+			return a.TagSlugs, a.TagNames
+		})
+}
+
+func (s *ArticleFeedServiceImpl) GetMakerFeed(ctx context.Context, makerSlug string, format string, params *dto.ArticleFeedParams) (*dto.ArticleFeedResponse, error) {
+	params.SetDefaults()
+
+	channel := seo.FeedChannel{Link: seo.PageURL(s.siteURL, seo.MakerPath(params.Lang, makerSlug))}
+
+	return s.getFeed(ctx, cache.FeedByMakerKey(makerSlug, params.Lang, format), format, params.Lang, channel,
+		func(p repositories.PublicArticleListParams) ([]repositories.PublishedArticleWithVideo, int64, error) {
+			return s.articleRepo.ListPublishedByMaker(ctx, makerSlug, p)
+		},
+		makerSlug, func(a repositories.PublishedArticleWithVideo) ([]string, []string) {
+			return []string{a.MakerSlug}, []string{a.MakerName}
+		})
+}
+
+// getFeed อ่าน feed จาก cache หรือ render ใหม่จาก list function
+// namesFn (ถ้ามี) ใช้หาชื่อ cast/tag/maker ของ entitySlug จากบทความแรก เพื่อตั้งชื่อ feed
+func (s *ArticleFeedServiceImpl) getFeed(
+	ctx context.Context,
+	cacheKey string,
+	format string,
+	lang string,
+	channel seo.FeedChannel,
+	listFn func(repositories.PublicArticleListParams) ([]repositories.PublishedArticleWithVideo, int64, error),
+	entitySlug string,
+	namesFn func(repositories.PublishedArticleWithVideo) (slugs []string, names []string),
+) (*dto.ArticleFeedResponse, error) {
+	if format != dto.ArticleFeedRSS && format != dto.ArticleFeedAtom {
+		return nil, errors.New("invalid feed format")
+	}
+	if lang != "th" && lang != "en" {
+		return nil, errors.New("invalid language")
+	}
+
+	if s.cache != nil {
+		var cached dto.ArticleFeedResponse
+		if err := s.cache.Get(ctx, cacheKey, &cached); err == nil && cached.Data != "" {
+			return &cached, nil
+		}
+	}
+
+	articles, _, err := listFn(repositories.PublicArticleListParams{
+		Limit:    articleFeedLimit,
+		Language: lang,
+		Sort:     "published_at",
+		Order:    "desc",
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list articles for feed", "cache_key", cacheKey, "error", err)
+		return nil, err
+	}
+
+	if namesFn != nil {
+		name := entitySlug
+		if len(articles) > 0 {
+			slugs, names := namesFn(articles[0])
+			if found := nameBySlug(slugs, names, entitySlug); found != "" {
+				name = found
+			}
+		}
+		channel.Title = fmt.Sprintf("%s - %s", seo.SiteName, name)
+	}
+	channel.Description = channel.Title
+	channel.Language = lang
+
+	items := make([]seo.FeedItem, len(articles))
+	for i, a := range articles {
+		items[i] = s.mapToFeedItem(a)
+		if updated := items[i].UpdatedAt; updated.After(channel.Updated) {
+			channel.Updated = updated
+		}
+	}
+
+	var data []byte
+	if format == dto.ArticleFeedAtom {
+		data, err = seo.EncodeAtom(channel, items)
+	} else {
+		data, err = seo.EncodeRSS(channel, items)
+	}
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to encode feed", "cache_key", cacheKey, "error", err)
+		return nil, err
+	}
+
+	response := &dto.ArticleFeedResponse{
+		Format:    format,
+		Data:      string(data),
+		UpdatedAt: channel.Updated,
+	}
+
+	if s.cache != nil {
+		if err := s.cache.Set(ctx, cacheKey, response, cache.FeedCacheTTL); err != nil {
+			logger.WarnContext(ctx, "Failed to cache feed", "cache_key", cacheKey, "error", err)
+		}
+	}
+
+	return response, nil
+}
+
+func (s *ArticleFeedServiceImpl) mapToFeedItem(a repositories.PublishedArticleWithVideo) seo.FeedItem {
+	item := seo.FeedItem{
+		Title:       a.Title,
+		Link:        seo.ArticleURL(s.siteURL, a.Language, string(a.Type), a.Slug),
+		Description: a.MetaDescription,
+		ImageURL:    extractThumbnailFromContent(a.Content),
+		Categories:  append([]string{string(a.Type)}, a.TagNames...),
+		UpdatedAt:   a.UpdatedAt,
+	}
+	if a.PublishedAt != nil {
+		item.PublishedAt = *a.PublishedAt
+	}
+	if item.UpdatedAt.IsZero() {
+		item.UpdatedAt = item.PublishedAt
+	}
+	return item
+}
+
+// localizedFeedTitle เลือกชื่อตามภาษา ถ้าไม่มีใช้ภาษาหลัก
+func localizedFeedTitle(titles map[string]string, lang string) string {
+	if title, ok := titles[lang]; ok {
+		return title
+	}
+	return titles[seo.DefaultLanguage]
+}
+
+// nameBySlug หาชื่อที่ตรงกับ slug จาก slice คู่ slugs/names
+func nameBySlug(slugs []string, names []string, slug string) string {
+	for i, s := range slugs {
+		if s == slug && i < len(names) {
+			return names[i]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,403 @@
+package serviceimpl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/repositories"
+	"gofiber-template/domain/services"
+	"gofiber-template/pkg/logger"
+	"gofiber-template/pkg/schema"
+	"gofiber-template/pkg/seo"
+)
+
+// Generator kinds
+const (
+	GeneratorKindCastRanking = "cast-ranking"
+	GeneratorKindMakerBestOf = "maker-best-of"
+	GeneratorKindTagGuide    = "tag-guide"
+)
+
+const (
+	generatorDefaultLimit = 10
+	// generatorMinVideos - น้อยกว่านี้ไม่คุ้มทำเป็นบทความรวม
+	generatorMinVideos = 3
+	// generatorMetaDescriptionMax - ตาม metaDescription ของ worker (150-160 chars)
+	generatorMetaDescriptionMax = 160
+)
+
+type articleGeneratorServiceImpl struct {
+	articleRepo repositories.ArticleRepository
+	castRepo    repositories.CastRepository
+	makerRepo   repositories.MakerRepository
+	tagRepo     repositories.TagRepository
+}
+
+func NewArticleGeneratorService(
+	articleRepo repositories.ArticleRepository,
+	castRepo repositories.CastRepository,
+	makerRepo repositories.MakerRepository,
+	tagRepo repositories.TagRepository,
+) services.ArticleGeneratorService {
+	return &articleGeneratorServiceImpl{
+		articleRepo: articleRepo,
+		castRepo:    castRepo,
+		makerRepo:   makerRepo,
+		tagRepo:     tagRepo,
+	}
+}
+
+// generatorSubject - cast/maker/tag ที่บทความพูดถึง
+type generatorSubject struct {
+	ID   uuid.UUID
+	Slug string
+	Name string // ชื่อตามภาษาของบทความ
+}
+
+// generatorInput - ข้อมูลทั้งหมดที่ใช้ประกอบบทความ
+type generatorInput struct {
+	kind     string
+	language string
+	sortBy   string
+	subject  generatorSubject
+	videos   []repositories.ArticleCandidateVideo
+}
+
+// GenerateArticle สร้างบทความจาก catalogue แล้วบันทึกเป็น draft
+// slug คงที่ต่อ kind + subject ทำให้สั่งซ้ำได้: ถ้ายังเป็น draft ของ generator จะเขียนทับ
+// ถ้าบทความถูก publish/แก้เป็นบทความอื่นไปแล้วจะไม่แตะ ("article already exists")
+func (s *articleGeneratorServiceImpl) GenerateArticle(ctx context.Context, req *dto.GenerateArticleRequest) (*dto.GenerateArticleResponse, error) {
+	in := generatorInput{
+		kind:     req.Kind,
+		language: "th",
+		sortBy:   "views",
+	}
+	if req.Language != "" {
+		in.language = req.Language
+	}
+	// เรียงตาม rating ได้เฉพาะ best-of (ranking/guide จัดอันดับตามความนิยม)
+	if req.Kind == GeneratorKindMakerBestOf && req.SortBy != "" {
+		in.sortBy = req.SortBy
+	}
+	limit := generatorDefaultLimit
+	if req.Limit > 0 {
+		limit = req.Limit
+	}
+
+	params := repositories.ArticleCandidateParams{
+		Language: in.language,
+		SortBy:   in.sortBy,
+		Limit:    limit,
+	}
+	subject, err := s.resolveSubject(ctx, req.Kind, req.Slug, in.language, &params)
+	if err != nil {
+		return nil, err
+	}
+	in.subject = *subject
+
+	in.videos, err = s.articleRepo.ListGeneratorCandidates(ctx, params)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list generator candidates", "kind", req.Kind, "slug", req.Slug, "error", err)
+		return nil, err
+	}
+	if len(in.videos) < generatorMinVideos {
+		logger.WarnContext(ctx, "Not enough reviewed videos to generate article", "kind", req.Kind, "slug", req.Slug, "language", in.language, "count", len(in.videos))
+		return nil, errors.New("not enough videos")
+	}
+
+	articleType := generatorArticleType(in.kind)
+	text := newGeneratorText(in)
+	content := buildGeneratedContent(in, text)
+
+	raw, err := json.Marshal(content)
+	if err != nil {
+		return nil, err
+	}
+	// content ต้องผ่าน schema เดียวกับที่ ingest ใช้ ไม่งั้นเป็น bug ของ generator
+	if err := schema.ValidateArticleContent(string(articleType), raw); err != nil {
+		logger.ErrorContext(ctx, "Generated content failed schema validation", "kind", in.kind, "slug", content.Slug, "error", err)
+		return nil, err
+	}
+
+	existing, err := s.articleRepo.GetBySlugAndLanguage(ctx, content.Slug, in.language)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.ErrorContext(ctx, "Failed to check existing article", "slug", content.Slug, "language", in.language, "error", err)
+		return nil, err
+	}
+
+	article := existing
+	created := existing == nil
+	if existing != nil {
+		if existing.Origin != models.ArticleOriginGenerator || existing.Status != models.ArticleStatusDraft {
+			return nil, errors.New("article already exists")
+		}
+	} else {
+		article = &models.Article{
+			Language:       in.language,
+			Origin:         models.ArticleOriginGenerator,
+			Slug:           content.Slug,
+			Status:         models.ArticleStatusDraft,
+			IndexingStatus: models.IndexingPending,
+		}
+	}
+
+	article.VideoID = in.videos[0].VideoID
+	article.Type = articleType
+	article.Title = truncateRunes(text.title, 200)
+	article.MetaTitle = truncateRunes(text.title, 100)
+	article.MetaDescription = content.MetaDescription
+	article.Content = json.RawMessage(raw)
+	article.QualityScore = int(math.Round(content.Rating * 2))
+	article.ReadingTime = generatorReadingTime(len(in.videos))
+
+	if created {
+		err = s.articleRepo.Create(ctx, article)
+	} else {
+		err = s.articleRepo.Update(ctx, article)
+	}
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to save generated article", "slug", article.Slug, "language", in.language, "error", err)
+		return nil, err
+	}
+
+	videos := make([]models.ArticleVideo, len(in.videos))
+	for i, v := range in.videos {
+		videos[i] = models.ArticleVideo{VideoID: v.VideoID, Position: i + 1}
+	}
+	if err := s.articleRepo.ReplaceVideos(ctx, article.ID, videos); err != nil {
+		logger.ErrorContext(ctx, "Failed to save article videos", "article_id", article.ID, "error", err)
+		return nil, err
+	}
+
+	if err := s.articleRepo.RefreshSearchVector(ctx, article.ID); err != nil {
+		logger.WarnContext(ctx, "Failed to refresh article search vector", "article_id", article.ID, "error", err)
+	}
+
+	logger.InfoContext(ctx, "Article generated",
+		"article_id", article.ID,
+		"kind", in.kind,
+		"subject", in.subject.Slug,
+		"language", in.language,
+		"videos", len(in.videos),
+		"created", created,
+	)
+
+	return &dto.GenerateArticleResponse{
+		ID:         article.ID.String(),
+		Type:       string(article.Type),
+		Slug:       article.Slug,
+		Language:   article.Language,
+		Title:      article.Title,
+		Status:     string(article.Status),
+		VideoCount: len(in.videos),
+		Created:    created,
+	}, nil
+}
+
+// resolveSubject หา cast/maker/tag จาก slug แล้วใส่ filter ให้ params
+func (s *articleGeneratorServiceImpl) resolveSubject(ctx context.Context, kind string, slug string, language string, params *repositories.ArticleCandidateParams) (*generatorSubject, error) {
+	switch kind {
+	case GeneratorKindCastRanking:
+		cast, err := s.castRepo.GetBySlug(ctx, slug)
+		if err != nil {
+			return nil, errors.New("cast not found")
+		}
+		name := cast.Name
+		for _, t := range cast.Translations {
+			if t.Lang == language && t.Name != "" {
+				name = t.Name
+			}
+		}
+		params.CastID = &cast.ID
+		return &generatorSubject{ID: cast.ID, Slug: cast.Slug, Name: name}, nil
+
+	case GeneratorKindMakerBestOf:
+		maker, err := s.makerRepo.GetBySlug(ctx, slug)
+		if err != nil {
+			return nil, errors.New("maker not found")
+		}
+		params.MakerID = &maker.ID
+		return &generatorSubject{ID: maker.ID, Slug: maker.Slug, Name: maker.Name}, nil
+
+	case GeneratorKindTagGuide:
+		tag, err := s.tagRepo.GetBySlug(ctx, slug)
+		if err != nil {
+			return nil, errors.New("tag not found")
+		}
+		name := tag.Name
+		for _, t := range tag.Translations {
+			if t.Lang == language && t.Name != "" {
+				name = t.Name
+			}
+		}
+		params.TagID = &tag.ID
+		return &generatorSubject{ID: tag.ID, Slug: tag.Slug, Name: name}, nil
+	}
+	return nil, errors.New("invalid kind")
+}
+
+func generatorArticleType(kind string) models.ArticleType {
+	switch kind {
+	case GeneratorKindMakerBestOf:
+		return models.ArticleTypeBestOf
+	case GeneratorKindTagGuide:
+		return models.ArticleTypeGuide
+	}
+	return models.ArticleTypeRanking
+}
+
+// slug format ของบทความ generator (coverage report ใช้หา hub ที่มีบทความแล้ว)
+const (
+	castRankingSlugFormat = "top-%s-videos"
+	makerBestOfSlugFormat = "best-%s-by-%s" // subject, sortBy
+	tagGuideSlugFormat    = "%s-guide"
+)
+
+// generatedSlug - slug คงที่ต่อ kind + subject (+ sortBy ของ best-of) ใช้ร่วมกันทุกภาษา
+func generatedSlug(in generatorInput) string {
+	var slug string
+	switch in.kind {
+	case GeneratorKindMakerBestOf:
+		slug = fmt.Sprintf(makerBestOfSlugFormat, in.subject.Slug, in.sortBy)
+	case GeneratorKindTagGuide:
+		slug = fmt.Sprintf(tagGuideSlugFormat, in.subject.Slug)
+	default:
+		slug = fmt.Sprintf(castRankingSlugFormat, in.subject.Slug)
+	}
+	return truncateRunes(slug, 100)
+}
+
+// generatorReadingTime - ประมาณ 1 นาทีต่อ 2 เรื่อง + intro/FAQ
+func generatorReadingTime(videoCount int) int {
+	return 2 + (videoCount+1)/2
+}
+
+// buildGeneratedContent ประกอบ content โครงสร้าง V3 + rankingItems
+func buildGeneratedContent(in generatorInput, text generatorText) *dto.GeneratedArticleContent {
+	top := in.videos[0]
+	now := time.Now().Format(time.RFC3339)
+
+	content := &dto.GeneratedArticleContent{
+		ArticleContentV3: dto.ArticleContentV3{
+			QuickAnswer: text.quickAnswer,
+			MainHook:    text.mainHook,
+			Verdict:     text.verdict,
+			Facts: dto.ArticleFactsV3{
+				Code:            top.Code,
+				Studio:          top.MakerName,
+				Cast:            nonNilStrings(top.CastNames),
+				DurationMinutes: top.DurationMinutes,
+				Genre:           nonNilStrings(top.TagNames),
+			},
+			Synopsis:        text.synopsis,
+			KeyScenes:       []string{},
+			ReviewSummary:   text.reviewSummary,
+			Strengths:       text.strengths,
+			Weaknesses:      []string{},
+			WhoShouldWatch:  text.whoShouldWatch,
+			VerdictReason:   text.verdictReason,
+			FAQItems:        text.faqItems,
+			TitleAggressive: text.title,
+			TitleBalanced:   text.title,
+			MetaDescription: truncateRunes(text.metaDescription, generatorMetaDescriptionMax),
+			Slug:            generatedSlug(in),
+			Keywords:        text.keywords,
+			SearchIntents:   text.searchIntents,
+			Rating:          averageRating(in.videos),
+			ThumbnailUrl:    top.ThumbnailURL,
+			CreatedAt:       now,
+			UpdatedAt:       now,
+		},
+		RankingItems: make([]dto.GeneratedRankingItem, len(in.videos)),
+	}
+	if top.DurationMinutes > 0 {
+		content.Facts.Duration = localizedDuration(in.language, top.DurationMinutes)
+	}
+	if top.ReleaseDate != nil {
+		content.Facts.ReleaseYear = top.ReleaseDate.Format("2006")
+	}
+
+	switch in.kind {
+	case GeneratorKindCastRanking:
+		content.CastProfiles = []dto.CastProfileV3{{
+			ID:         in.subject.ID.String(),
+			Name:       in.subject.Name,
+			ProfileUrl: seo.CastPath(in.language, in.subject.Slug),
+		}}
+	case GeneratorKindMakerBestOf:
+		content.MakerInfo = &dto.MakerInfoV3{
+			ID:         in.subject.ID.String(),
+			Name:       in.subject.Name,
+			ProfileUrl: seo.MakerPath(in.language, in.subject.Slug),
+		}
+	case GeneratorKindTagGuide:
+		content.TagDescriptions = []dto.TagDescriptionV3{{
+			ID:          in.subject.ID.String(),
+			Name:        in.subject.Name,
+			Description: text.quickAnswer,
+			Url:         seo.TagPath(in.language, in.subject.Slug),
+		}}
+	}
+
+	for i, v := range in.videos {
+		item := dto.GeneratedRankingItem{
+			Position:     i + 1,
+			VideoID:      v.VideoID.String(),
+			Code:         v.Code,
+			Title:        v.Title,
+			ThumbnailUrl: v.ThumbnailURL,
+			Views:        v.Views,
+			Rating:       v.Rating,
+			Cast:         nonNilStrings(v.CastNames),
+			ReviewSlug:   v.ReviewSlug,
+		}
+		if v.ReleaseDate != nil {
+			item.ReleaseDate = v.ReleaseDate.Format("2006-01-02")
+		}
+		content.RankingItems[i] = item
+	}
+
+	return content
+}
+
+// averageRating - เฉลี่ยเฉพาะ video ที่มี rating (ปัด 1 ตำแหน่ง)
+func averageRating(videos []repositories.ArticleCandidateVideo) float64 {
+	var sum float64
+	var n int
+	for _, v := range videos {
+		if v.Rating > 0 {
+			sum += v.Rating
+			n++
+		}
+	}
+	if n == 0 {
+		return 0
+	}
+	return math.Round(sum/float64(n)*10) / 10
+}
+
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// truncateRunes ตัด string ตามจำนวนตัวอักษร (ไม่ตัดกลางตัวอักษรไทย)
+func truncateRunes(s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	return strings.TrimSpace(string([]rune(s)[:max]))
+}
@@ -0,0 +1,225 @@
+package serviceimpl
+
+import (
+	"fmt"
+	"strings"
+
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/repositories"
+)
+
+// ========================================
+// Article Generator - ข้อความ (th / en)
+// ========================================
+
+// generatorText - ข้อความทุกส่วนของบทความที่ generator สร้าง
+type generatorText struct {
+	title           string
+	metaDescription string
+	quickAnswer     string
+	mainHook        string
+	verdict         string
+	synopsis        string
+	reviewSummary   string
+	strengths       []string
+	whoShouldWatch  string
+	verdictReason   string
+	faqItems        []dto.FAQItemV3
+	keywords        []string
+	searchIntents   []string
+}
+
+// generatorStrengthCount - จุดเด่น = n อันดับแรก
+const generatorStrengthCount = 3
+
+func newGeneratorText(in generatorInput) generatorText {
+	if in.language == "en" {
+		return newGeneratorTextEN(in)
+	}
+	return newGeneratorTextTH(in)
+}
+
+func newGeneratorTextTH(in generatorInput) generatorText {
+	name := in.subject.Name
+	n := len(in.videos)
+	top := in.videos[0]
+	t := generatorText{}
+
+	criteria := "ยอดวิว"
+	if in.sortBy == "rating" {
+		criteria = "คะแนนรีวิว"
+	}
+
+	switch in.kind {
+	case GeneratorKindMakerBestOf:
+		t.title = fmt.Sprintf("%d ผลงานที่ดีที่สุดของค่าย %s จัดอันดับตาม%s", n, name, criteria)
+		t.quickAnswer = fmt.Sprintf("ผลงานอันดับ 1 ของค่าย %s ตาม%sคือ %s %s รายการนี้รวม %d เรื่องเด่นของค่ายที่มีรีวิวซับไทยแล้ว", name, criteria, top.Code, top.Title, n)
+		t.mainHook = fmt.Sprintf("อยากรู้ว่าค่าย %s มีเรื่องไหนห้ามพลาด เริ่มจากรายการนี้", name)
+		t.whoShouldWatch = fmt.Sprintf("เหมาะกับคนที่ชอบแนวของค่าย %s และอยากไล่ดูผลงานที่ดีที่สุดก่อน", name)
+		t.keywords = []string{name, name + " ซับไทย", "ค่าย " + name, name + " เรื่องไหนดี"}
+		t.searchIntents = []string{
+			fmt.Sprintf("ค่าย %s เรื่องไหนดี", name),
+			fmt.Sprintf("%s แนะนำ", name),
+			fmt.Sprintf("ผลงานดีที่สุดของ %s", name),
+		}
+	case GeneratorKindTagGuide:
+		t.title = fmt.Sprintf("คู่มือแนว %s: %d เรื่องแนะนำสำหรับมือใหม่", name, n)
+		t.quickAnswer = fmt.Sprintf("ถ้าเพิ่งเริ่มดูแนว %s แนะนำให้เริ่มจาก %s %s ซึ่งเป็นเรื่องยอดนิยมที่สุดในแนวนี้ รายการนี้รวม %d เรื่องที่มีรีวิวซับไทยแล้ว", name, top.Code, top.Title, n)
+		t.mainHook = fmt.Sprintf("แนว %s มีให้เลือกเยอะ รายการนี้คัดเรื่องที่คนดูมากที่สุดมาให้แล้ว", name)
+		t.whoShouldWatch = fmt.Sprintf("เหมาะกับคนที่อยากลองแนว %s หรือกำลังหาเรื่องใหม่ในแนวที่ชอบ", name)
+		t.keywords = []string{name, name + " ซับไทย", "แนว " + name, name + " แนะนำ"}
+		t.searchIntents = []string{
+			fmt.Sprintf("แนว %s เรื่องไหนดี", name),
+			fmt.Sprintf("%s แนะนำ", name),
+			fmt.Sprintf("%s มือใหม่", name),
+		}
+	default:
+		t.title = fmt.Sprintf("จัดอันดับ %d เรื่องยอดนิยมของ %s ซับไทย", n, name)
+		t.quickAnswer = fmt.Sprintf("เรื่องยอดนิยมอันดับ 1 ของ %s คือ %s %s รายการนี้จัดอันดับ %d เรื่องของ %s ตามยอดวิวบนเว็บ", name, top.Code, top.Title, n, name)
+		t.mainHook = fmt.Sprintf("รวมผลงานที่คนดูมากที่สุดของ %s ไว้ในที่เดียว", name)
+		t.whoShouldWatch = fmt.Sprintf("เหมาะกับแฟนของ %s และคนที่อยากรู้ว่าควรเริ่มดูเรื่องไหนก่อน", name)
+		t.keywords = []string{name, name + " ซับไทย", name + " เรื่องไหนดี", name + " ยอดนิยม"}
+		t.searchIntents = []string{
+			fmt.Sprintf("%s เรื่องไหนดี", name),
+			fmt.Sprintf("%s ยอดนิยม", name),
+			fmt.Sprintf("ผลงาน %s", name),
+		}
+	}
+
+	t.metaDescription = t.quickAnswer
+	t.verdict = fmt.Sprintf("เริ่มจาก %s แล้วไล่ดูตามอันดับได้เลย", top.Code)
+	t.verdictReason = fmt.Sprintf("ทุกเรื่องในรายการมีรีวิวซับไทยให้อ่านก่อนดู จัดอันดับตาม%sจริงของผู้ชม", criteria)
+
+	items := make([]string, n)
+	for i, v := range in.videos {
+		items[i] = fmt.Sprintf("อันดับ %d: %s %s (ยอดวิว %d%s)", i+1, v.Code, v.Title, v.Views, ratingSuffix(v.Rating, " คะแนน %.1f/5"))
+	}
+	t.synopsis = strings.Join(items, " [PARA] ")
+	t.strengths = items[:min(generatorStrengthCount, n)]
+
+	t.reviewSummary = fmt.Sprintf("รายการนี้รวม %d เรื่องของ %s ยอดวิวรวม %d ครั้ง", n, name, totalViews(in.videos))
+	if avg := averageRating(in.videos); avg > 0 {
+		t.reviewSummary += fmt.Sprintf(" คะแนนรีวิวเฉลี่ย %.1f/5", avg)
+	}
+	t.reviewSummary += " [PARA] กดที่แต่ละเรื่องเพื่ออ่านรีวิวเต็มพร้อมเรื่องย่อและจุดเด่นก่อนตัดสินใจดู"
+
+	t.faqItems = []dto.FAQItemV3{
+		{
+			Question: fmt.Sprintf("%s เรื่องไหนดีที่สุด?", name),
+			Answer:   fmt.Sprintf("ตาม%s อันดับ 1 คือ %s %s", criteria, top.Code, top.Title),
+		},
+		{
+			Question: "รายการนี้จัดอันดับจากอะไร?",
+			Answer:   fmt.Sprintf("จัดอันดับจาก%sของผู้ชมบนเว็บ และเลือกเฉพาะเรื่องที่มีรีวิวซับไทยแล้ว", criteria),
+		},
+		{
+			Question: "มีทั้งหมดกี่เรื่อง?",
+			Answer:   fmt.Sprintf("รายการนี้มีทั้งหมด %d เรื่อง", n),
+		},
+	}
+	return t
+}
+
+func newGeneratorTextEN(in generatorInput) generatorText {
+	name := in.subject.Name
+	n := len(in.videos)
+	top := in.videos[0]
+	t := generatorText{}
+
+	criteria, criteriaTitle := "views", "Views"
+	if in.sortBy == "rating" {
+		criteria, criteriaTitle = "review rating", "Rating"
+	}
+
+	switch in.kind {
+	case GeneratorKindMakerBestOf:
+		t.title = fmt.Sprintf("Best %d %s Titles Ranked by %s", n, name, criteriaTitle)
+		t.quickAnswer = fmt.Sprintf("The best %s title by %s is %s %s. This list covers %d standout %s releases that already have a full review.", name, criteria, top.Code, top.Title, n, name)
+		t.mainHook = fmt.Sprintf("Not sure where to start with %s? Start here.", name)
+		t.whoShouldWatch = fmt.Sprintf("Fans of the %s style who want to catch the studio's strongest releases first.", name)
+		t.keywords = []string{name, name + " best", name + " studio", name + " recommendations"}
+		t.searchIntents = []string{
+			fmt.Sprintf("best %s videos", name),
+			fmt.Sprintf("%s recommendations", name),
+			fmt.Sprintf("top %s titles", name),
+		}
+	case GeneratorKindTagGuide:
+		t.title = fmt.Sprintf("%s Guide: %d Recommended Videos for Beginners", name, n)
+		t.quickAnswer = fmt.Sprintf("New to %s? Start with %s %s, the most watched title in the genre. This guide lists %d reviewed videos to explore next.", name, top.Code, top.Title, n)
+		t.mainHook = fmt.Sprintf("There is a lot of %s out there. These are the ones viewers actually watch.", name)
+		t.whoShouldWatch = fmt.Sprintf("Viewers curious about %s, or fans looking for their next pick in the genre.", name)
+		t.keywords = []string{name, name + " guide", name + " recommendations", "best " + name}
+		t.searchIntents = []string{
+			fmt.Sprintf("best %s videos", name),
+			fmt.Sprintf("%s for beginners", name),
+			fmt.Sprintf("%s recommendations", name),
+		}
+	default:
+		t.title = fmt.Sprintf("Top %d %s Videos Ranked by Views", n, name)
+		t.quickAnswer = fmt.Sprintf("The most popular %s video is %s %s. This ranking lists %d %s titles by total views on the site.", name, top.Code, top.Title, n, name)
+		t.mainHook = fmt.Sprintf("Every fan favourite from %s in one list.", name)
+		t.whoShouldWatch = fmt.Sprintf("Fans of %s and anyone deciding which title to watch first.", name)
+		t.keywords = []string{name, name + " best videos", name + " ranking", name + " popular"}
+		t.searchIntents = []string{
+			fmt.Sprintf("best %s videos", name),
+			fmt.Sprintf("most popular %s", name),
+			fmt.Sprintf("%s filmography", name),
+		}
+	}
+
+	t.metaDescription = t.quickAnswer
+	t.verdict = fmt.Sprintf("Start with %s and work your way down the list.", top.Code)
+	t.verdictReason = fmt.Sprintf("Every title here has a full review, ranked by real viewer %s.", criteria)
+
+	items := make([]string, n)
+	for i, v := range in.videos {
+		items[i] = fmt.Sprintf("#%d: %s %s (%d views%s)", i+1, v.Code, v.Title, v.Views, ratingSuffix(v.Rating, ", rated %.1f/5"))
+	}
+	t.synopsis = strings.Join(items, " [PARA] ")
+	t.strengths = items[:min(generatorStrengthCount, n)]
+
+	t.reviewSummary = fmt.Sprintf("This list covers %d %s titles with %d total views.", n, name, totalViews(in.videos))
+	if avg := averageRating(in.videos); avg > 0 {
+		t.reviewSummary += fmt.Sprintf(" Average review rating: %.1f/5.", avg)
+	}
+	t.reviewSummary += " [PARA] Open any title to read the full review, synopsis and highlights before you watch."
+
+	t.faqItems = []dto.FAQItemV3{
+		{
+			Question: fmt.Sprintf("What is the best %s video?", name),
+			Answer:   fmt.Sprintf("By %s, the top pick is %s %s.", criteria, top.Code, top.Title),
+		},
+		{
+			Question: "How is this list ranked?",
+			Answer:   fmt.Sprintf("Titles are ranked by viewer %s on the site, and only titles with a published review are included.", criteria),
+		},
+		{
+			Question: "How many titles are in this list?",
+			Answer:   fmt.Sprintf("This list includes %d titles.", n),
+		},
+	}
+	return t
+}
+
+// localizedDuration - รูปแบบเดียวกับ facts.duration ของ worker ("120 นาที")
+func localizedDuration(language string, minutes int) string {
+	if language == "en" {
+		return fmt.Sprintf("%d minutes", minutes)
+	}
+	return fmt.Sprintf("%d นาที", minutes)
+}
+
+func ratingSuffix(rating float64, format string) string {
+	if rating <= 0 {
+		return ""
+	}
+	return fmt.Sprintf(format, rating)
+}
+
+func totalViews(videos []repositories.ArticleCandidateVideo) int {
+	total := 0
+	for _, v := range videos {
+		total += v.Views
+	}
+	return total
+}
@@ -0,0 +1,156 @@
+package serviceimpl
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"gofiber-template/domain/dto"
+	"gofiber-template/pkg/cache"
+	"gofiber-template/pkg/logger"
+	"gofiber-template/pkg/utils"
+)
+
+// ========================================
+// Article Preview Tokens
+// ========================================
+// token เป็น JWT (sign ด้วย key แยกจาก access token) + record ใน Redis
+// ต้องผ่านทั้งสองอย่าง: signature/วันหมดอายุ และ record ยังไม่ถูกลบ (revoke)
+
+// CreatePreviewToken ออก token สำหรับดูบทความทุกสถานะบน frontend
+func (s *ArticleServiceImpl) CreatePreviewToken(ctx context.Context, articleID uuid.UUID, req *dto.CreateArticlePreviewRequest) (*dto.ArticlePreviewTokenResponse, error) {
+	if s.cache == nil {
+		return nil, errors.New("cache not available")
+	}
+
+	article, err := s.articleRepo.GetByID(ctx, articleID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("article not found")
+		}
+		return nil, err
+	}
+
+	ttl := cache.ArticlePreviewDefaultTTL
+	if req.TTLMinutes > 0 {
+		ttl = time.Duration(req.TTLMinutes) * time.Minute
+	}
+	if ttl > cache.ArticlePreviewMaxTTL {
+		ttl = cache.ArticlePreviewMaxTTL
+	}
+	expiresAt := time.Now().Add(ttl)
+	tokenID := uuid.New().String()
+
+	token, err := utils.GenerateArticlePreviewToken(article.ID, tokenID, expiresAt, s.previewSecret)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to sign preview token", "article_id", article.ID, "error", err)
+		return nil, err
+	}
+
+	if err := s.cache.Set(ctx, cache.ArticlePreviewTokenKey(tokenID), article.ID.String(), ttl); err != nil {
+		logger.ErrorContext(ctx, "Failed to store preview token", "article_id", article.ID, "error", err)
+		return nil, err
+	}
+	// set หมดอายุตาม TTL สูงสุด ให้ครอบ token ทุกตัวของบทความ
+	if err := s.cache.SetAdd(ctx, cache.ArticlePreviewTokensKey(article.ID.String()), cache.ArticlePreviewMaxTTL, tokenID); err != nil {
+		logger.WarnContext(ctx, "Failed to track preview token", "article_id", article.ID, "error", err)
+	}
+
+	logger.InfoContext(ctx, "Article preview token created", "article_id", article.ID, "token_id", tokenID, "expires_at", expiresAt)
+
+	return &dto.ArticlePreviewTokenResponse{
+		TokenID:   tokenID,
+		Token:     token,
+		ArticleID: article.ID.String(),
+		Type:      string(article.Type),
+		Slug:      article.Slug,
+		Language:  article.Language,
+		ExpiresAt: expiresAt.Format(time.RFC3339),
+	}, nil
+}
+
+// RevokePreviewTokens ยกเลิก token ของบทความ (tokenID ว่าง = ทั้งหมด)
+func (s *ArticleServiceImpl) RevokePreviewTokens(ctx context.Context, articleID uuid.UUID, tokenID string) (*dto.RevokeArticlePreviewResponse, error) {
+	if s.cache == nil {
+		return nil, errors.New("cache not available")
+	}
+
+	setKey := cache.ArticlePreviewTokensKey(articleID.String())
+	tokenIDs := []string{tokenID}
+	if tokenID == "" {
+		members, err := s.cache.SetMembers(ctx, setKey)
+		if err != nil {
+			logger.ErrorContext(ctx, "Failed to list preview tokens", "article_id", articleID, "error", err)
+			return nil, err
+		}
+		tokenIDs = members
+	}
+
+	revoked := 0
+	for _, id := range tokenIDs {
+		key := cache.ArticlePreviewTokenKey(id)
+		// token ต้องเป็นของบทความนี้ (กันลบ token ของบทความอื่นด้วย ID ที่เดามา)
+		var owner string
+		if err := s.cache.Get(ctx, key, &owner); err != nil || owner != articleID.String() {
+			continue
+		}
+		if err := s.cache.Delete(ctx, key); err != nil {
+			logger.ErrorContext(ctx, "Failed to revoke preview token", "article_id", articleID, "token_id", id, "error", err)
+			return nil, err
+		}
+		revoked++
+	}
+
+	if tokenID == "" {
+		if err := s.cache.Delete(ctx, setKey); err != nil {
+			logger.WarnContext(ctx, "Failed to clear preview token set", "article_id", articleID, "error", err)
+		}
+	}
+
+	logger.InfoContext(ctx, "Article preview tokens revoked", "article_id", articleID, "token_id", tokenID, "revoked", revoked)
+	return &dto.RevokeArticlePreviewResponse{Revoked: revoked}, nil
+}
+
+// GetPreviewArticle คืนบทความตาม token ไม่ว่าสถานะไหน
+// อ่านจาก DB ตรงเสมอ (ไม่อ่าน/เขียน article cache) เพื่อให้เห็นเนื้อหาล่าสุด
+func (s *ArticleServiceImpl) GetPreviewArticle(ctx context.Context, token string) (*dto.PublicArticleResponse, error) {
+	if s.cache == nil {
+		return nil, errors.New("cache not available")
+	}
+
+	claims, err := utils.ParseArticlePreviewToken(token, s.previewSecret)
+	if err != nil {
+		return nil, errors.New("invalid preview token")
+	}
+
+	var owner string
+	if err := s.cache.Get(ctx, cache.ArticlePreviewTokenKey(claims.ID), &owner); err != nil || owner != claims.ArticleID {
+		logger.WarnContext(ctx, "Preview token revoked or unknown", "token_id", claims.ID, "article_id", claims.ArticleID)
+		return nil, errors.New("invalid preview token")
+	}
+
+	articleID, err := uuid.Parse(claims.ArticleID)
+	if err != nil {
+		return nil, errors.New("invalid preview token")
+	}
+
+	article, err := s.articleRepo.GetByID(ctx, articleID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("article not found")
+		}
+		return nil, err
+	}
+
+	response, err := s.mapToPublicResponse(ctx, article)
+	if err != nil {
+		return nil, err
+	}
+	response.Status = string(article.Status)
+	response.Preview = true
+
+	return response, nil
+}
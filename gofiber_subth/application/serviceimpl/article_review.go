@@ -0,0 +1,260 @@
+package serviceimpl
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/repositories"
+	"gofiber-template/pkg/cache"
+	"gofiber-template/pkg/logger"
+)
+
+// ========================================
+// Editorial Review Workflow
+// ========================================
+//
+// draft ──assign/submit──▶ in_review ──approve──▶ approved ──▶ scheduled ──▶ published
+//   ▲                          │                                    │
+//   └──────────reject──────────┘                                    │
+// draft → scheduled/published ได้ตรงๆ เฉพาะบทความที่ QualityScore >= AutoApproveQualityScore
+
+// AutoApproveQualityScore - QualityScore (1-10) ขั้นต่ำที่ข้าม review ได้
+const AutoApproveQualityScore = 8
+
+// articleStatusTransitions - สถานะปลายทางที่ UpdateStatus อนุญาต
+// approved ตั้งผ่าน DecideReview เท่านั้น
+var articleStatusTransitions = map[models.ArticleStatus][]models.ArticleStatus{
+	models.ArticleStatusDraft:     {models.ArticleStatusInReview, models.ArticleStatusScheduled, models.ArticleStatusPublished, models.ArticleStatusArchived},
+	models.ArticleStatusInReview:  {models.ArticleStatusDraft, models.ArticleStatusArchived},
+	models.ArticleStatusApproved:  {models.ArticleStatusDraft, models.ArticleStatusInReview, models.ArticleStatusScheduled, models.ArticleStatusPublished, models.ArticleStatusArchived},
+	models.ArticleStatusScheduled: {models.ArticleStatusDraft, models.ArticleStatusScheduled, models.ArticleStatusPublished, models.ArticleStatusArchived},
+	models.ArticleStatusPublished: {models.ArticleStatusDraft, models.ArticleStatusArchived},
+	models.ArticleStatusArchived:  {models.ArticleStatusDraft},
+}
+
+// statusesAllowing - สถานะต้นทางที่เปลี่ยนไป to ได้ (ใช้ใน WHERE ของ bulk update)
+func statusesAllowing(to models.ArticleStatus) []models.ArticleStatus {
+	var from []models.ArticleStatus
+	for status, targets := range articleStatusTransitions {
+		for _, s := range targets {
+			if s == to {
+				from = append(from, status)
+				break
+			}
+		}
+	}
+	return from
+}
+
+// checkStatusTransition ตรวจว่าบทความเปลี่ยนไปสถานะ to ได้หรือไม่
+func checkStatusTransition(article *models.Article, to models.ArticleStatus) error {
+	allowed := false
+	for _, s := range articleStatusTransitions[article.Status] {
+		if s == to {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return errors.New("invalid status transition")
+	}
+
+	// draft ยังไม่ผ่าน review: schedule/publish ได้เฉพาะคะแนนสูงพอ
+	if article.Status == models.ArticleStatusDraft &&
+		(to == models.ArticleStatusScheduled || to == models.ArticleStatusPublished) &&
+		article.QualityScore < AutoApproveQualityScore {
+		return errors.New("approval required")
+	}
+	return nil
+}
+
+// recordStatusChange บันทึกประวัติสถานะ (ไม่ให้ error ทำให้การเปลี่ยนสถานะล้ม)
+func (s *ArticleServiceImpl) recordStatusChange(ctx context.Context, article *models.Article, from models.ArticleStatus, action models.StatusChangeAction, actorID *uuid.UUID, notes string) {
+	if s.statusRepo == nil {
+		return
+	}
+	change := &models.ArticleStatusChange{
+		ArticleID:  article.ID,
+		FromStatus: from,
+		ToStatus:   article.Status,
+		Action:     action,
+		ActorID:    actorID,
+		ReviewerID: article.ReviewerID,
+		Notes:      notes,
+	}
+	if err := s.statusRepo.Create(ctx, change); err != nil {
+		logger.WarnContext(ctx, "Failed to record article status change", "article_id", article.ID, "from", from, "to", article.Status, "error", err)
+	}
+}
+
+// AssignReviewer มอบหมาย reviewer ถ้ายังเป็น draft จะถูกส่งเข้า in_review
+func (s *ArticleServiceImpl) AssignReviewer(ctx context.Context, id uuid.UUID, actorID uuid.UUID, req *dto.AssignReviewerRequest) (*dto.ArticleDetailResponse, error) {
+	article, err := s.articleRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("article not found")
+		}
+		return nil, err
+	}
+
+	reviewerID, err := uuid.Parse(req.ReviewerID)
+	if err != nil {
+		return nil, errors.New("reviewer not found")
+	}
+	if _, err := s.userRepo.GetByID(ctx, reviewerID); err != nil {
+		return nil, errors.New("reviewer not found")
+	}
+
+	from := article.Status
+	switch from {
+	case models.ArticleStatusDraft:
+		article.Status = models.ArticleStatusInReview
+	case models.ArticleStatusInReview:
+		// เปลี่ยนตัว reviewer
+	default:
+		return nil, errors.New("invalid status transition")
+	}
+	article.ReviewerID = &reviewerID
+
+	if err := s.articleRepo.Update(ctx, article); err != nil {
+		logger.ErrorContext(ctx, "Failed to assign reviewer", "article_id", id, "error", err)
+		return nil, err
+	}
+	s.recordStatusChange(ctx, article, from, models.StatusChangeAssign, &actorID, req.Notes)
+
+	logger.InfoContext(ctx, "Article reviewer assigned", "article_id", id, "reviewer_id", reviewerID, "by", actorID)
+	return s.mapToDetailResponse(article, nil), nil
+}
+
+// DecideReview - reviewer อนุมัติ (→ approved) หรือตีกลับ (→ draft พร้อม notes)
+// ถ้ามอบหมาย reviewer ไว้แล้ว ต้องเป็นคนนั้นเท่านั้น, ถ้ายังไม่มี คนตัดสินจะเป็น reviewer
+func (s *ArticleServiceImpl) DecideReview(ctx context.Context, id uuid.UUID, reviewerID uuid.UUID, req *dto.ReviewDecisionRequest) (*dto.ArticleDetailResponse, error) {
+	article, err := s.articleRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("article not found")
+		}
+		return nil, err
+	}
+
+	if article.Status != models.ArticleStatusInReview {
+		return nil, errors.New("article not in review")
+	}
+	if article.ReviewerID != nil && *article.ReviewerID != reviewerID {
+		return nil, errors.New("not assigned reviewer")
+	}
+
+	from := article.Status
+	action := models.StatusChangeApprove
+	article.Status = models.ArticleStatusApproved
+	if req.Decision == "reject" {
+		action = models.StatusChangeReject
+		article.Status = models.ArticleStatusDraft
+	}
+
+	now := time.Now()
+	article.ReviewerID = &reviewerID
+	article.ReviewNotes = req.Notes
+	article.ReviewedAt = &now
+
+	if err := s.articleRepo.Update(ctx, article); err != nil {
+		logger.ErrorContext(ctx, "Failed to save review decision", "article_id", id, "error", err)
+		return nil, err
+	}
+	s.recordStatusChange(ctx, article, from, action, &reviewerID, req.Notes)
+
+	logger.InfoContext(ctx, "Article review decided", "article_id", id, "decision", req.Decision, "reviewer_id", reviewerID)
+	return s.mapToDetailResponse(article, nil), nil
+}
+
+// ListStatusHistory ประวัติสถานะของบทความ (ล่าสุดก่อน)
+func (s *ArticleServiceImpl) ListStatusHistory(ctx context.Context, id uuid.UUID, params *dto.ArticleStatusHistoryParams) ([]dto.ArticleStatusChangeResponse, int64, error) {
+	params.SetDefaults()
+
+	if _, err := s.articleRepo.GetByID(ctx, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, 0, errors.New("article not found")
+		}
+		return nil, 0, err
+	}
+
+	changes, total, err := s.statusRepo.ListByArticle(ctx, id, (params.Page-1)*params.Limit, params.Limit)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list article status history", "article_id", id, "error", err)
+		return nil, 0, err
+	}
+
+	result := make([]dto.ArticleStatusChangeResponse, len(changes))
+	for i, c := range changes {
+		item := dto.ArticleStatusChangeResponse{
+			ID:         c.ID.String(),
+			FromStatus: string(c.FromStatus),
+			ToStatus:   string(c.ToStatus),
+			Action:     string(c.Action),
+			Notes:      c.Notes,
+			CreatedAt:  c.CreatedAt.Format(time.RFC3339),
+		}
+		if c.ActorID != nil {
+			actor := c.ActorID.String()
+			item.ActorID = &actor
+		}
+		if c.ReviewerID != nil {
+			reviewer := c.ReviewerID.String()
+			item.ReviewerID = &reviewer
+		}
+		result[i] = item
+	}
+	return result, total, nil
+}
+
+// ListReviewQueue คิวบทความ in_review (mine = ที่มอบหมายให้ userID)
+func (s *ArticleServiceImpl) ListReviewQueue(ctx context.Context, userID uuid.UUID, params *dto.ReviewQueueParams) ([]dto.ArticleListItemResponse, int64, error) {
+	params.SetDefaults()
+
+	repoParams := repositories.ReviewQueueParams{
+		Limit:           params.Limit,
+		Offset:          (params.Page - 1) * params.Limit,
+		Type:            params.Type,
+		Language:        params.Lang,
+		MinQualityScore: params.MinScore,
+		MaxQualityScore: params.MaxScore,
+		Unassigned:      params.Unassigned,
+		Sort:            params.Sort,
+	}
+	if params.Mine {
+		repoParams.ReviewerID = &userID
+	} else if params.ReviewerID != "" {
+		if reviewerID, err := uuid.Parse(params.ReviewerID); err == nil {
+			repoParams.ReviewerID = &reviewerID
+		}
+	}
+
+	articles, total, err := s.articleRepo.ListReviewQueue(ctx, repoParams)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list review queue", "error", err)
+		return nil, 0, err
+	}
+
+	return s.mapToListItems(ctx, articles), total, nil
+}
+
+// invalidateStatusCaches ล้าง cache หลังเปลี่ยนสถานะ
+// published -> ล้างทุกหน้าที่เกี่ยวข้อง, อื่นๆ -> ล้าง detail และ related ที่ยังแสดงบทความนี้อยู่
+func (s *ArticleServiceImpl) invalidateStatusCaches(ctx context.Context, article *models.Article) {
+	if article.Status == models.ArticleStatusPublished {
+		s.invalidateRelatedCaches(ctx, article)
+		return
+	}
+	if s.cache == nil {
+		return
+	}
+	cacheKey := cache.ArticleKeyWithLang(string(article.Type), article.Slug, article.Language)
+	_ = s.cache.Delete(ctx, cacheKey)
+	s.invalidateRelatedArticleCaches(ctx, article.ID)
+}
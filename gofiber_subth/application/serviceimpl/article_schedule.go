@@ -0,0 +1,476 @@
+package serviceimpl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/repositories"
+	"gofiber-template/pkg/logger"
+)
+
+// ========================================
+// Article Schedule Planner
+// ========================================
+//
+// แบ่งช่วงเวลา [windowStart, windowEnd) ของแต่ละวันเป็น dailyQuota ช่อง (ต่อภาษา)
+// บทความที่ publish/schedule ไว้แล้วในวันนั้นกินช่องและนับรวม quota
+// บทความที่ planner วาง (auto_scheduled) ถูกวางใหม่ได้ตอน rebalance ส่วนที่ admin ตั้งเองล็อกไว้
+
+const (
+	defaultScheduleWindowStart = "09:00"
+	defaultScheduleWindowEnd   = "23:00"
+	defaultScheduleTimezone    = "Asia/Bangkok"
+
+	// scheduleHorizonDays - วางล่วงหน้าได้ไม่เกินนี้ (ที่เหลือถูกข้าม)
+	scheduleHorizonDays = 366
+)
+
+type schedulePolicy struct {
+	dailyQuota  int
+	windowStart time.Duration // นับจากเที่ยงคืน (เวลาท้องถิ่น)
+	windowEnd   time.Duration
+	loc         *time.Location
+	spreadCasts bool
+}
+
+type plannedSlot struct {
+	row          repositories.ArticleScheduleRow
+	at           time.Time
+	castConflict bool
+}
+
+func newSchedulePolicy(dailyQuota int, windowStart, windowEnd, timezone string, spreadCasts bool) (*schedulePolicy, error) {
+	if windowStart == "" {
+		windowStart = defaultScheduleWindowStart
+	}
+	if windowEnd == "" {
+		windowEnd = defaultScheduleWindowEnd
+	}
+	if timezone == "" {
+		timezone = defaultScheduleTimezone
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, errors.New("invalid timezone")
+	}
+	start, err := parseClock(windowStart)
+	if err != nil {
+		return nil, errors.New("invalid publish window")
+	}
+	end, err := parseClock(windowEnd)
+	if err != nil || end <= start {
+		return nil, errors.New("invalid publish window")
+	}
+
+	return &schedulePolicy{
+		dailyQuota:  dailyQuota,
+		windowStart: start,
+		windowEnd:   end,
+		loc:         loc,
+		spreadCasts: spreadCasts,
+	}, nil
+}
+
+func schedulePolicyFromModel(m *models.ArticleSchedulePolicy) (*schedulePolicy, error) {
+	return newSchedulePolicy(m.DailyQuota, m.WindowStart, m.WindowEnd, m.Timezone, m.SpreadCasts)
+}
+
+// parseClock "HH:MM" → duration จากเที่ยงคืน
+func parseClock(value string) (time.Duration, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func formatClock(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+}
+
+func (p *schedulePolicy) toModel(m *models.ArticleSchedulePolicy) {
+	m.DailyQuota = p.dailyQuota
+	m.WindowStart = formatClock(p.windowStart)
+	m.WindowEnd = formatClock(p.windowEnd)
+	m.Timezone = p.loc.String()
+	m.SpreadCasts = p.spreadCasts
+}
+
+func (p *schedulePolicy) toResponse(updatedAt *time.Time) dto.SchedulePolicyResponse {
+	resp := dto.SchedulePolicyResponse{
+		DailyQuota:  p.dailyQuota,
+		WindowStart: formatClock(p.windowStart),
+		WindowEnd:   formatClock(p.windowEnd),
+		Timezone:    p.loc.String(),
+		SpreadCasts: p.spreadCasts,
+	}
+	if updatedAt != nil {
+		t := updatedAt.Format(time.RFC3339)
+		resp.UpdatedAt = &t
+	}
+	return resp
+}
+
+// plan วาง candidates (ตามลำดับที่ส่งมา) ลงช่องว่างตั้งแต่ start
+// fixed = บทความที่กินช่องอยู่แล้ว (ไม่ถูกย้าย), returns ช่องที่วาง + บทความที่วางไม่ลงใน horizon
+func (p *schedulePolicy) plan(start time.Time, candidates, fixed []repositories.ArticleScheduleRow) ([]plannedSlot, []repositories.ArticleScheduleRow) {
+	candidatesByLang := make(map[string][]repositories.ArticleScheduleRow)
+	var languages []string
+	for _, row := range candidates {
+		if _, ok := candidatesByLang[row.Language]; !ok {
+			languages = append(languages, row.Language)
+		}
+		candidatesByLang[row.Language] = append(candidatesByLang[row.Language], row)
+	}
+
+	fixedByLang := make(map[string][]repositories.ArticleScheduleRow)
+	for _, row := range fixed {
+		if row.SlotAt != nil {
+			fixedByLang[row.Language] = append(fixedByLang[row.Language], row)
+		}
+	}
+
+	var planned []plannedSlot
+	var unplaced []repositories.ArticleScheduleRow
+	for _, lang := range languages {
+		slots, rest := p.planLanguage(start, candidatesByLang[lang], fixedByLang[lang])
+		planned = append(planned, slots...)
+		unplaced = append(unplaced, rest...)
+	}
+
+	sort.SliceStable(planned, func(i, j int) bool {
+		return planned[i].at.Before(planned[j].at)
+	})
+	return planned, unplaced
+}
+
+func (p *schedulePolicy) planLanguage(start time.Time, pending, fixed []repositories.ArticleScheduleRow) ([]plannedSlot, []repositories.ArticleScheduleRow) {
+	sort.SliceStable(fixed, func(i, j int) bool {
+		return fixed[i].SlotAt.Before(*fixed[j].SlotAt)
+	})
+
+	// quota ที่ใช้ไปแล้วต่อวัน (เวลาท้องถิ่น)
+	usedPerDay := make(map[string]int)
+	for _, row := range fixed {
+		usedPerDay[row.SlotAt.In(p.loc).Format("2006-01-02")]++
+	}
+
+	interval := (p.windowEnd - p.windowStart) / time.Duration(p.dailyQuota)
+	localStart := start.In(p.loc)
+	firstDay := time.Date(localStart.Year(), localStart.Month(), localStart.Day(), 0, 0, 0, 0, p.loc)
+
+	var planned []plannedSlot
+	var lastCasts []string
+	fixedIdx := 0
+
+	for day := 0; day < scheduleHorizonDays && len(pending) > 0; day++ {
+		dayStart := time.Date(firstDay.Year(), firstDay.Month(), firstDay.Day()+day, 0, 0, 0, 0, p.loc)
+		dayKey := dayStart.Format("2006-01-02")
+
+		for i := 0; i < p.dailyQuota && len(pending) > 0; i++ {
+			if usedPerDay[dayKey] >= p.dailyQuota {
+				break
+			}
+			at := dayStart.Add(p.windowStart + time.Duration(i)*interval)
+
+			// บทความก่อนหน้าช่องนี้ (ใช้เช็ค cast ติดกัน) + ช่องที่มีบทความอยู่แล้ว
+			occupied := false
+			for fixedIdx < len(fixed) && fixed[fixedIdx].SlotAt.Before(at.Add(interval)) {
+				if !fixed[fixedIdx].SlotAt.Before(at) {
+					occupied = true
+				}
+				lastCasts = fixed[fixedIdx].CastIDs
+				fixedIdx++
+			}
+			if occupied || at.Before(start) {
+				continue
+			}
+
+			pick, conflict := 0, false
+			if p.spreadCasts && len(lastCasts) > 0 {
+				pick = -1
+				for idx, row := range pending {
+					if !sharesCast(lastCasts, row.CastIDs) {
+						pick = idx
+						break
+					}
+				}
+				if pick < 0 {
+					pick, conflict = 0, true
+				}
+			}
+
+			row := pending[pick]
+			pending = append(pending[:pick:pick], pending[pick+1:]...)
+			planned = append(planned, plannedSlot{row: row, at: at, castConflict: conflict})
+			usedPerDay[dayKey]++
+			lastCasts = row.CastIDs
+		}
+	}
+
+	return planned, pending
+}
+
+func sharesCast(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// PlanSchedule วางเวลา publish ให้บทความ (apply = false คือ preview อย่างเดียว)
+// policy ที่ apply จะถูกเก็บไว้ใช้ rebalance
+func (s *ArticleServiceImpl) PlanSchedule(ctx context.Context, actorID uuid.UUID, req *dto.SchedulePlanRequest, apply bool) (*dto.SchedulePlanResponse, error) {
+	policy, err := newSchedulePolicy(req.DailyQuota, req.WindowStart, req.WindowEnd, req.Timezone, !req.AllowSameCastInRow)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	start := now
+	if req.StartAt != nil && req.StartAt.After(now) {
+		start = *req.StartAt
+	}
+
+	ids := make([]uuid.UUID, 0, len(req.ArticleIDs))
+	seen := make(map[uuid.UUID]bool)
+	for _, idStr := range req.ArticleIDs {
+		id, err := uuid.Parse(idStr)
+		if err != nil || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+
+	rows, err := s.articleRepo.ListScheduleCandidates(ctx, ids)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to load schedule candidates", "count", len(ids), "error", err)
+		return nil, err
+	}
+
+	response := &dto.SchedulePlanResponse{Applied: apply, Skipped: []dto.ScheduleSkipResponse{}}
+	found := make(map[uuid.UUID]bool, len(rows))
+	candidates := make([]repositories.ArticleScheduleRow, 0, len(rows))
+	for _, row := range rows {
+		found[row.ID] = true
+		article := &models.Article{Status: row.Status, QualityScore: row.QualityScore}
+		if err := checkStatusTransition(article, models.ArticleStatusScheduled); err != nil {
+			response.Skipped = append(response.Skipped, dto.ScheduleSkipResponse{ArticleID: row.ID.String(), Reason: err.Error()})
+			continue
+		}
+		candidates = append(candidates, row)
+	}
+	for _, id := range ids {
+		if !found[id] {
+			response.Skipped = append(response.Skipped, dto.ScheduleSkipResponse{ArticleID: id.String(), Reason: "article not found"})
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, errors.New("no valid article IDs provided")
+	}
+
+	// คุณภาพสูงก่อน, เท่ากัน = บทความเก่ากว่าก่อน
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].QualityScore != candidates[j].QualityScore {
+			return candidates[i].QualityScore > candidates[j].QualityScore
+		}
+		return candidates[i].CreatedAt.Before(candidates[j].CreatedAt)
+	})
+
+	// บทความที่กำลังวางใหม่ไม่นับเป็นช่องที่ถูกใช้
+	replanned := make(map[uuid.UUID]bool, len(candidates))
+	for _, row := range candidates {
+		replanned[row.ID] = true
+	}
+	fixed, err := s.scheduleOccupancy(ctx, policy, start, replanned)
+	if err != nil {
+		return nil, err
+	}
+
+	planned, unplaced := policy.plan(start, candidates, fixed)
+	for _, row := range unplaced {
+		response.Skipped = append(response.Skipped, dto.ScheduleSkipResponse{ArticleID: row.ID.String(), Reason: "no free slot within horizon"})
+	}
+
+	if apply && len(planned) > 0 {
+		if err := s.articleRepo.ApplySchedule(ctx, toScheduleSlots(planned)); err != nil {
+			logger.ErrorContext(ctx, "Failed to apply schedule plan", "count", len(planned), "error", err)
+			return nil, err
+		}
+		for _, slot := range planned {
+			if slot.row.Status == models.ArticleStatusScheduled {
+				continue
+			}
+			article := &models.Article{ID: slot.row.ID, Status: models.ArticleStatusScheduled}
+			s.recordStatusChange(ctx, article, slot.row.Status, models.StatusChangeSchedulePlan, &actorID, "")
+		}
+		s.saveSchedulePolicy(ctx, policy, actorID)
+		logger.InfoContext(ctx, "Schedule plan applied", "count", len(planned), "skipped", len(response.Skipped), "daily_quota", policy.dailyQuota, "by", actorID)
+	}
+
+	response.Policy = policy.toResponse(nil)
+	response.Items = toScheduleSlotResponses(planned)
+	return response, nil
+}
+
+// RebalanceSchedule วางบทความที่ planner ตั้งไว้ (ยังไม่ถึงเวลา) ใหม่ตาม policy ล่าสุด
+// ลำดับเดิมคงไว้ - บทความเลื่อนขึ้นมาแทนช่องที่ว่าง
+func (s *ArticleServiceImpl) RebalanceSchedule(ctx context.Context, dryRun bool) (*dto.SchedulePlanResponse, error) {
+	stored, err := s.articleRepo.GetSchedulePolicy(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("schedule policy not found")
+		}
+		logger.ErrorContext(ctx, "Failed to get schedule policy", "error", err)
+		return nil, err
+	}
+	policy, err := schedulePolicyFromModel(stored)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	occupancy, err := s.scheduleOccupancy(ctx, policy, now, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates, fixed []repositories.ArticleScheduleRow
+	for _, row := range occupancy {
+		if row.Status == models.ArticleStatusScheduled && row.AutoScheduled && row.SlotAt.After(now) {
+			candidates = append(candidates, row)
+			continue
+		}
+		fixed = append(fixed, row)
+	}
+
+	planned, _ := policy.plan(now, candidates, fixed)
+
+	// เขียนเฉพาะที่เวลาเปลี่ยน
+	var moved []plannedSlot
+	for _, slot := range planned {
+		if !slot.at.Equal(*slot.row.SlotAt) {
+			moved = append(moved, slot)
+		}
+	}
+	if !dryRun && len(moved) > 0 {
+		if err := s.articleRepo.ApplySchedule(ctx, toScheduleSlots(moved)); err != nil {
+			logger.ErrorContext(ctx, "Failed to rebalance schedule", "count", len(moved), "error", err)
+			return nil, err
+		}
+		logger.InfoContext(ctx, "Schedule rebalanced", "moved", len(moved), "queued", len(candidates))
+	}
+
+	return &dto.SchedulePlanResponse{
+		Applied: !dryRun,
+		Policy:  policy.toResponse(&stored.UpdatedAt),
+		Items:   toScheduleSlotResponses(moved),
+		Skipped: []dto.ScheduleSkipResponse{},
+	}, nil
+}
+
+func (s *ArticleServiceImpl) GetSchedulePolicy(ctx context.Context) (*dto.SchedulePolicyResponse, error) {
+	stored, err := s.articleRepo.GetSchedulePolicy(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("schedule policy not found")
+		}
+		logger.ErrorContext(ctx, "Failed to get schedule policy", "error", err)
+		return nil, err
+	}
+	policy, err := schedulePolicyFromModel(stored)
+	if err != nil {
+		return nil, err
+	}
+	resp := policy.toResponse(&stored.UpdatedAt)
+	return &resp, nil
+}
+
+// rebalanceAfterRemoval เรียกเมื่อบทความ scheduled ถูกถอดออกจากคิว (เปลี่ยนสถานะ/ลบ)
+// ยังไม่เคย apply policy = ไม่มีอะไรให้ rebalance
+func (s *ArticleServiceImpl) rebalanceAfterRemoval(ctx context.Context, articleID uuid.UUID, from models.ArticleStatus, scheduledAt *time.Time) {
+	if from != models.ArticleStatusScheduled || scheduledAt == nil || !scheduledAt.After(time.Now()) {
+		return
+	}
+	if _, err := s.RebalanceSchedule(ctx, false); err != nil && err.Error() != "schedule policy not found" {
+		logger.WarnContext(ctx, "Failed to rebalance schedule after removal", "article_id", articleID, "error", err)
+	}
+}
+
+// scheduleOccupancy บทความที่กินช่อง/quota ตั้งแต่ต้นวันของ start (ยกเว้น exclude)
+func (s *ArticleServiceImpl) scheduleOccupancy(ctx context.Context, policy *schedulePolicy, start time.Time, exclude map[uuid.UUID]bool) ([]repositories.ArticleScheduleRow, error) {
+	local := start.In(policy.loc)
+	dayStart := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, policy.loc)
+
+	rows, err := s.articleRepo.ListScheduledSince(ctx, dayStart)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to load scheduled articles", "since", dayStart, "error", err)
+		return nil, err
+	}
+
+	occupancy := rows[:0]
+	for _, row := range rows {
+		if !exclude[row.ID] && row.SlotAt != nil {
+			occupancy = append(occupancy, row)
+		}
+	}
+	return occupancy, nil
+}
+
+func (s *ArticleServiceImpl) saveSchedulePolicy(ctx context.Context, policy *schedulePolicy, actorID uuid.UUID) {
+	stored, err := s.articleRepo.GetSchedulePolicy(ctx)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.WarnContext(ctx, "Failed to get schedule policy", "error", err)
+			return
+		}
+		stored = &models.ArticleSchedulePolicy{}
+	}
+	policy.toModel(stored)
+	stored.UpdatedBy = &actorID
+	if err := s.articleRepo.SaveSchedulePolicy(ctx, stored); err != nil {
+		logger.WarnContext(ctx, "Failed to save schedule policy", "error", err)
+	}
+}
+
+func toScheduleSlots(planned []plannedSlot) []repositories.ArticleScheduleSlot {
+	slots := make([]repositories.ArticleScheduleSlot, len(planned))
+	for i, slot := range planned {
+		slots[i] = repositories.ArticleScheduleSlot{ArticleID: slot.row.ID, FromStatus: slot.row.Status, ScheduledAt: slot.at}
+	}
+	return slots
+}
+
+func toScheduleSlotResponses(planned []plannedSlot) []dto.ScheduleSlotResponse {
+	items := make([]dto.ScheduleSlotResponse, len(planned))
+	for i, slot := range planned {
+		items[i] = dto.ScheduleSlotResponse{
+			ArticleID:    slot.row.ID.String(),
+			Title:        slot.row.Title,
+			Slug:         slot.row.Slug,
+			Language:     slot.row.Language,
+			QualityScore: slot.row.QualityScore,
+			ScheduledAt:  slot.at.Format(time.RFC3339),
+			CastConflict: slot.castConflict,
+		}
+		if slot.row.Status == models.ArticleStatusScheduled && slot.row.SlotAt != nil {
+			t := slot.row.SlotAt.Format(time.RFC3339)
+			items[i].PreviousAt = &t
+		}
+	}
+	return items
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"fmt"
+	"net/http"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -18,26 +20,53 @@ import (
 	"gofiber-template/infrastructure/redis"
 	"gofiber-template/pkg/cache"
 	"gofiber-template/pkg/logger"
+	"gofiber-template/pkg/schema"
+	"gofiber-template/pkg/seo"
+	"gofiber-template/pkg/utils"
 )
 
 type ArticleServiceImpl struct {
-	articleRepo repositories.ArticleRepository
-	videoRepo   repositories.VideoRepository
-	storage     ports.Storage
-	cache       *redis.RedisClient
+	articleRepo  repositories.ArticleRepository
+	revisionRepo repositories.ArticleRevisionRepository
+	redirectRepo repositories.ArticleRedirectRepository
+	statusRepo   repositories.ArticleStatusChangeRepository
+	queueRepo    repositories.ArticleCoverageRepository
+	videoRepo    repositories.VideoRepository
+	userRepo     repositories.UserRepository
+	storage      ports.Storage
+	cache        *redis.RedisClient
+
+	// previewSecret - key สำหรับ sign preview token (JWT secret)
+	previewSecret string
+	jsonld        jsonldBuilder
 }
 
 func NewArticleService(
 	articleRepo repositories.ArticleRepository,
+	revisionRepo repositories.ArticleRevisionRepository,
+	redirectRepo repositories.ArticleRedirectRepository,
+	statusRepo repositories.ArticleStatusChangeRepository,
+	queueRepo repositories.ArticleCoverageRepository,
 	videoRepo repositories.VideoRepository,
+	userRepo repositories.UserRepository,
 	storage ports.Storage,
 	cache *redis.RedisClient,
+	previewSecret string,
+	siteURL string,
 ) services.ArticleService {
 	return &ArticleServiceImpl{
-		articleRepo: articleRepo,
-		videoRepo:   videoRepo,
-		storage:     storage,
-		cache:       cache,
+		articleRepo:  articleRepo,
+		revisionRepo: revisionRepo,
+		redirectRepo: redirectRepo,
+		statusRepo:   statusRepo,
+		queueRepo:    queueRepo,
+		videoRepo:    videoRepo,
+		userRepo:     userRepo,
+		storage:      storage,
+		cache:        cache,
+
+		previewSecret: previewSecret,
+		jsonld:        newJSONLDBuilder(siteURL, storage),
 	}
 }
 
@@ -83,6 +112,10 @@ func (s *ArticleServiceImpl) IngestArticle(ctx context.Context, req *dto.IngestA
 	// ตรวจสอบว่ามี article สำหรับ video + language นี้แล้วหรือไม่
 	existing, _ := s.articleRepo.GetByVideoIDAndLanguage(ctx, videoID, language)
 	if existing != nil {
+		// เก็บสถานะเดิมไว้ก่อนถูกเขียนทับ (บทความที่สร้างก่อนมีระบบ revision)
+		s.ensureBaselineRevision(ctx, existing)
+		oldType, oldSlug := existing.Type, existing.Slug
+
 		// Update existing article
 		existing.Type = articleType
 		existing.Title = req.Title
@@ -98,15 +131,11 @@ func (s *ArticleServiceImpl) IngestArticle(ctx context.Context, req *dto.IngestA
 			return nil, err
 		}
 
-		// Invalidate all related caches when published article is updated
-		// This ensures cast/tag/maker pages show updated content
-		if existing.Status == models.ArticleStatusPublished {
-			s.invalidateRelatedCaches(ctx, existing)
-		} else if s.cache != nil {
-			// For non-published articles, just invalidate article detail cache
-			cacheKey := cache.ArticleKeyWithLang(string(existing.Type), existing.Slug, existing.Language)
-			_ = s.cache.Delete(ctx, cacheKey)
-		}
+		s.recordRevision(ctx, existing, models.RevisionSourceIngest, nil, nil)
+		s.refreshSearchIndex(ctx, existing)
+		s.trackSlugChange(ctx, existing, oldType, oldSlug)
+		s.invalidateContentCaches(ctx, existing, oldType, oldSlug)
+		s.completeQueueItem(ctx, videoID, language)
 
 		logger.InfoContext(ctx, "Article updated", "article_id", existing.ID, "video_id", videoID, "language", language)
 		return s.mapToDetailResponse(existing, video), nil
@@ -133,10 +162,24 @@ func (s *ArticleServiceImpl) IngestArticle(ctx context.Context, req *dto.IngestA
 		return nil, err
 	}
 
+	s.recordRevision(ctx, article, models.RevisionSourceIngest, nil, nil)
+	s.refreshSearchIndex(ctx, article)
+	s.completeQueueItem(ctx, videoID, language)
+
 	logger.InfoContext(ctx, "Article created", "article_id", article.ID, "video_id", videoID, "language", language)
 	return s.mapToDetailResponse(article, video), nil
 }
 
+// completeQueueItem ปิดงานในคิว "needs article" ของ video + ภาษานี้ (ปล่อย lease ของ worker)
+func (s *ArticleServiceImpl) completeQueueItem(ctx context.Context, videoID uuid.UUID, language string) {
+	if s.queueRepo == nil {
+		return
+	}
+	if err := s.queueRepo.CompleteForVideo(ctx, videoID, language); err != nil {
+		logger.WarnContext(ctx, "Failed to complete article queue item", "video_id", videoID, "language", language, "error", err)
+	}
+}
+
 func (s *ArticleServiceImpl) GetArticle(ctx context.Context, id uuid.UUID) (*dto.ArticleDetailResponse, error) {
 	article, err := s.articleRepo.GetByID(ctx, id)
 	if err != nil {
@@ -175,44 +218,17 @@ func (s *ArticleServiceImpl) DeleteArticle(ctx context.Context, id uuid.UUID) er
 		return err
 	}
 
-	// Get video to get the videoCode (used for R2 path)
-	video, err := s.videoRepo.GetByID(ctx, article.VideoID)
-	if err != nil {
-		logger.WarnContext(ctx, "Failed to get video for R2 cleanup", "video_id", article.VideoID, "error", err)
-		// Continue with deletion even if video not found
-	}
-
-	// Delete article from database first
+	// ย้ายเข้าถังขยะ - ไฟล์ใน R2 (articles/{videoCode}/, audio/articles/{videoCode}/) จะถูกลบตอน purge (TrashService)
 	if err := s.articleRepo.Delete(ctx, id); err != nil {
 		logger.ErrorContext(ctx, "Failed to delete article", "article_id", id, "error", err)
 		return err
 	}
 
-	// Delete R2 files
-	// SEO Worker เก็บที่:
-	// - articles/{videoCode}/ (cover, images)
-	// - audio/articles/{videoCode}/ (TTS audio)
-	if s.storage != nil && video != nil && video.Code != "" {
-		// Delete article files (cover, gallery)
-		articlePrefix := fmt.Sprintf("articles/%s/", video.Code)
-		deletedCount, err := s.storage.DeleteByPrefix(ctx, articlePrefix)
-		if err != nil {
-			logger.WarnContext(ctx, "Failed to delete R2 article files", "article_id", id, "prefix", articlePrefix, "error", err)
-		} else if deletedCount > 0 {
-			logger.InfoContext(ctx, "R2 article files deleted", "article_id", id, "prefix", articlePrefix, "count", deletedCount)
-		}
+	// บทความที่ลบแล้วต้องไม่ถูกเสิร์ฟจาก cache (detail, list, feed, related ของบทความอื่น)
+	s.invalidateContentCaches(ctx, article, article.Type, article.Slug)
+	s.rebalanceAfterRemoval(ctx, id, article.Status, article.ScheduledAt)
 
-		// Delete audio files (TTS summary)
-		audioPrefix := fmt.Sprintf("audio/articles/%s/", video.Code)
-		audioDeleted, err := s.storage.DeleteByPrefix(ctx, audioPrefix)
-		if err != nil {
-			logger.WarnContext(ctx, "Failed to delete R2 audio files", "article_id", id, "prefix", audioPrefix, "error", err)
-		} else if audioDeleted > 0 {
-			logger.InfoContext(ctx, "R2 audio files deleted", "article_id", id, "prefix", audioPrefix, "count", audioDeleted)
-		}
-	}
-
-	logger.InfoContext(ctx, "Article deleted", "article_id", id)
+	logger.InfoContext(ctx, "Article moved to trash", "article_id", id)
 	return nil
 }
 
@@ -236,6 +252,11 @@ func (s *ArticleServiceImpl) ListArticles(ctx context.Context, params *dto.Artic
 		return nil, 0, err
 	}
 
+	return s.mapToListItems(ctx, articles), total, nil
+}
+
+// mapToListItems แปลงบทความเป็นรายการของหน้า admin (ดึง video code ให้ด้วย)
+func (s *ArticleServiceImpl) mapToListItems(ctx context.Context, articles []models.Article) []dto.ArticleListItemResponse {
 	// ดึง video IDs เพื่อ get video codes
 	videoIDs := make([]uuid.UUID, 0, len(articles))
 	for _, a := range articles {
@@ -263,6 +284,7 @@ func (s *ArticleServiceImpl) ListArticles(ctx context.Context, params *dto.Artic
 			QualityScore:   a.QualityScore,
 			ReadingTime:    a.ReadingTime,
 			CreatedAt:      a.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:      a.UpdatedAt.Format(time.RFC3339),
 		}
 
 		// ใช้ thumbnail จาก article content (SEO-safe) แทน video.Thumbnail
@@ -280,11 +302,15 @@ func (s *ArticleServiceImpl) ListArticles(ctx context.Context, params *dto.Artic
 			t := a.PublishedAt.Format(time.RFC3339)
 			item.PublishedAt = &t
 		}
+		if a.ReviewerID != nil {
+			reviewer := a.ReviewerID.String()
+			item.ReviewerID = &reviewer
+		}
 
 		result = append(result, item)
 	}
 
-	return result, total, nil
+	return result
 }
 
 func (s *ArticleServiceImpl) GetStats(ctx context.Context) (*dto.ArticleStatsResponse, error) {
@@ -297,15 +323,19 @@ func (s *ArticleServiceImpl) GetStats(ctx context.Context) (*dto.ArticleStatsRes
 	return &dto.ArticleStatsResponse{
 		TotalArticles:  int(stats.TotalArticles),
 		DraftCount:     int(stats.DraftCount),
+		InReviewCount:  int(stats.InReviewCount),
+		ApprovedCount:  int(stats.ApprovedCount),
 		ScheduledCount: int(stats.ScheduledCount),
 		PublishedCount: int(stats.PublishedCount),
 		IndexedCount:   int(stats.IndexedCount),
+		SubmittedIndex: int(stats.SubmittedIndex),
 		PendingIndex:   int(stats.PendingIndex),
 		FailedIndex:    int(stats.FailedIndex),
 	}, nil
 }
 
-func (s *ArticleServiceImpl) UpdateStatus(ctx context.Context, id uuid.UUID, req *dto.UpdateArticleStatusRequest) error {
+// UpdateStatus เปลี่ยนสถานะตาม articleStatusTransitions แล้วบันทึกประวัติ
+func (s *ArticleServiceImpl) UpdateStatus(ctx context.Context, id uuid.UUID, actorID uuid.UUID, req *dto.UpdateArticleStatusRequest) error {
 	article, err := s.articleRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -316,6 +346,12 @@ func (s *ArticleServiceImpl) UpdateStatus(ctx context.Context, id uuid.UUID, req
 	}
 
 	status := models.ArticleStatus(req.Status)
+	if err := checkStatusTransition(article, status); err != nil {
+		logger.WarnContext(ctx, "Article status transition rejected", "article_id", id, "from", article.Status, "to", status, "quality_score", article.QualityScore, "error", err)
+		return err
+	}
+
+	removedAt := article.ScheduledAt
 
 	// Validate status transition
 	switch status {
@@ -327,58 +363,82 @@ func (s *ArticleServiceImpl) UpdateStatus(ctx context.Context, id uuid.UUID, req
 	case models.ArticleStatusPublished:
 		now := time.Now()
 		article.PublishedAt = &now
+	default:
+		article.ScheduledAt = nil
 	}
 
+	from := article.Status
 	article.Status = status
+	article.AutoScheduled = false // admin ตั้งเอง = planner ไม่ย้าย
 
 	if err := s.articleRepo.Update(ctx, article); err != nil {
 		logger.ErrorContext(ctx, "Failed to update article status", "article_id", id, "error", err)
 		return err
 	}
+	s.recordStatusChange(ctx, article, from, models.StatusChangeManual, &actorID, req.Notes)
+
+	// ถอดออกจากคิว schedule → เลื่อนบทความที่ planner วางไว้ขึ้นมาแทน
+	if status != models.ArticleStatusScheduled {
+		s.rebalanceAfterRemoval(ctx, id, from, removedAt)
+	}
 
 	// Invalidate all related caches when article is published
 	// This includes: article detail, article list, cast pages, tag pages, maker pages
-	if status == models.ArticleStatusPublished {
-		s.invalidateRelatedCaches(ctx, article)
-	} else if s.cache != nil {
-		// For other status changes, just invalidate article detail cache
-		cacheKey := cache.ArticleKeyWithLang(string(article.Type), article.Slug, article.Language)
-		_ = s.cache.Delete(ctx, cacheKey)
-	}
+	s.invalidateStatusCaches(ctx, article)
 
-	logger.InfoContext(ctx, "Article status updated", "article_id", id, "status", status)
+	logger.InfoContext(ctx, "Article status updated", "article_id", id, "from", from, "status", status, "by", actorID)
 	return nil
 }
 
-func (s *ArticleServiceImpl) BulkSchedule(ctx context.Context, req *dto.BulkScheduleRequest) error {
+// BulkSchedule ตั้งเวลาเฉพาะบทความที่ schedule ได้ตาม workflow (ที่เหลือถูกข้าม)
+// returns: จำนวนบทความที่ถูก schedule
+func (s *ArticleServiceImpl) BulkSchedule(ctx context.Context, actorID uuid.UUID, req *dto.BulkScheduleRequest) (int, error) {
 	ids := make([]uuid.UUID, 0, len(req.ArticleIDs))
 	scheduledTimes := make([]interface{}, 0, len(req.ArticleIDs))
+	articles := make([]*models.Article, 0, len(req.ArticleIDs))
 
 	baseTime := req.ScheduledAt
-	for i, idStr := range req.ArticleIDs {
+	for _, idStr := range req.ArticleIDs {
 		id, err := uuid.Parse(idStr)
 		if err != nil {
 			logger.WarnContext(ctx, "Invalid article ID in bulk schedule", "id", idStr, "error", err)
 			continue
 		}
-		ids = append(ids, id)
+
+		article, err := s.articleRepo.GetByID(ctx, id)
+		if err != nil {
+			logger.WarnContext(ctx, "Article not found in bulk schedule", "id", id, "error", err)
+			continue
+		}
+		if err := checkStatusTransition(article, models.ArticleStatusScheduled); err != nil {
+			logger.WarnContext(ctx, "Article skipped in bulk schedule", "id", id, "status", article.Status, "quality_score", article.QualityScore, "error", err)
+			continue
+		}
 
 		// Calculate scheduled time with interval
-		scheduledTime := baseTime.Add(time.Duration(i*req.Interval) * time.Minute)
+		scheduledTime := baseTime.Add(time.Duration(len(ids)*req.Interval) * time.Minute)
+		ids = append(ids, id)
 		scheduledTimes = append(scheduledTimes, scheduledTime)
+		articles = append(articles, article)
 	}
 
 	if len(ids) == 0 {
-		return errors.New("no valid article IDs provided")
+		return 0, errors.New("no valid article IDs provided")
 	}
 
-	if err := s.articleRepo.BulkSchedule(ctx, ids, scheduledTimes); err != nil {
+	if err := s.articleRepo.BulkSchedule(ctx, ids, scheduledTimes, statusesAllowing(models.ArticleStatusScheduled), AutoApproveQualityScore); err != nil {
 		logger.ErrorContext(ctx, "Failed to bulk schedule articles", "count", len(ids), "error", err)
-		return err
+		return 0, err
+	}
+
+	for _, article := range articles {
+		from := article.Status
+		article.Status = models.ArticleStatusScheduled
+		s.recordStatusChange(ctx, article, from, models.StatusChangeBulkSchedule, &actorID, "")
 	}
 
 	logger.InfoContext(ctx, "Articles bulk scheduled", "count", len(ids), "start_time", baseTime)
-	return nil
+	return len(ids), nil
 }
 
 func (s *ArticleServiceImpl) PublishScheduledArticles(ctx context.Context) (int, error) {
@@ -390,10 +450,17 @@ func (s *ArticleServiceImpl) PublishScheduledArticles(ctx context.Context) (int,
 
 	count := 0
 	for _, article := range articles {
-		if err := s.articleRepo.UpdateStatus(ctx, article.ID, models.ArticleStatusPublished); err != nil {
+		published, err := s.articleRepo.PublishIfScheduled(ctx, article.ID)
+		if err != nil {
 			logger.ErrorContext(ctx, "Failed to publish scheduled article", "article_id", article.ID, "error", err)
 			continue
 		}
+		if !published {
+			// replica อื่น publish ไปแล้ว หรือ status ถูกเปลี่ยนระหว่างทาง
+			continue
+		}
+		article.Status = models.ArticleStatusPublished
+		s.recordStatusChange(ctx, &article, models.ArticleStatusScheduled, models.StatusChangeAutoPublish, nil, "")
 
 		// Invalidate all related caches for the newly published article
 		s.invalidateRelatedCaches(ctx, &article)
@@ -415,43 +482,7 @@ func (s *ArticleServiceImpl) GetPublishedArticle(ctx context.Context, slug strin
 		return nil, err
 	}
 
-	video, _ := s.videoRepo.GetByID(ctx, article.VideoID)
-
-	var content map[string]interface{}
-	if err := json.Unmarshal(article.Content, &content); err != nil {
-		logger.ErrorContext(ctx, "Failed to unmarshal article content", "article_id", article.ID, "error", err)
-		return nil, err
-	}
-
-	response := &dto.PublicArticleResponse{
-		ID:              article.ID.String(),
-		Slug:            article.Slug,
-		Language:        article.Language,
-		Type:            string(article.Type),
-		Title:           article.Title,
-		MetaTitle:       article.MetaTitle,
-		MetaDescription: article.MetaDescription,
-		Content:         content,
-		VideoID:         article.VideoID.String(),
-		LikesCount:      article.LikesCount,
-		CommentsCount:   article.CommentsCount,
-		ViewCount:       article.ViewCount,
-	}
-
-	if video != nil {
-		response.VideoCode = video.Code
-	}
-	if article.PublishedAt != nil {
-		response.PublishedAt = article.PublishedAt.Format(time.RFC3339)
-	}
-
-	// Get translations for language switcher
-	translations := s.getTranslations(ctx, article.VideoID, article.Language)
-	if len(translations) > 0 {
-		response.Translations = translations
-	}
-
-	return response, nil
+	return s.mapToPublicResponse(ctx, article)
 }
 
 func (s *ArticleServiceImpl) GetPublishedArticleByType(ctx context.Context, articleType string, slug string, language string) (*dto.PublicArticleResponse, error) {
@@ -474,13 +505,22 @@ func (s *ArticleServiceImpl) GetPublishedArticleByType(ctx context.Context, arti
 	article, err := s.articleRepo.GetPublishedByTypeSlugAndLanguage(ctx, articleType, slug, language)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			// Fallback: ลองหา article จาก slug เดิมแต่ภาษาอื่น
+			// 1. slug เก่าที่ถูกเปลี่ยนไปแล้ว (article_redirects)
+			if redirect := s.findArticleRedirect(ctx, articleType, slug, language); redirect != nil {
+				return &dto.PublicArticleResponse{
+					RedirectSlug: redirect.Slug,
+					Redirect:     redirect,
+				}, nil
+			}
+
+			// 2. Fallback: ลองหา article จาก slug เดิมแต่ภาษาอื่น
 			// แล้วหา article ที่มี videoId เดียวกันในภาษาที่ต้องการ
-			fallbackArticle, fallbackErr := s.findArticleBySlugFallback(ctx, articleType, slug, language)
-			if fallbackErr == nil && fallbackArticle != nil {
+			redirect, fallbackErr := s.findArticleBySlugFallback(ctx, articleType, slug, language)
+			if fallbackErr == nil && redirect != nil {
 				// พบ article ในภาษาที่ต้องการ แต่ slug ต่างกัน → return redirect
 				return &dto.PublicArticleResponse{
-					RedirectSlug: fallbackArticle.Slug,
+					RedirectSlug: redirect.Slug,
+					Redirect:     redirect,
 				}, nil
 			}
 			return nil, errors.New("article not found")
@@ -489,7 +529,24 @@ func (s *ArticleServiceImpl) GetPublishedArticleByType(ctx context.Context, arti
 		return nil, err
 	}
 
-	video, _ := s.videoRepo.GetByID(ctx, article.VideoID)
+	response, err := s.mapToPublicResponse(ctx, article)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Cache for next time
+	if s.cache != nil {
+		if err := s.cache.Set(ctx, cacheKey, response, cache.ArticleCacheTTL); err != nil {
+			logger.WarnContext(ctx, "Failed to cache article", "type", articleType, "slug", slug, "error", err)
+		}
+	}
+
+	return response, nil
+}
+
+// mapToPublicResponse - แปลง article เป็น response ของหน้า public (ไม่สนสถานะ ไม่แตะ cache)
+func (s *ArticleServiceImpl) mapToPublicResponse(ctx context.Context, article *models.Article) (*dto.PublicArticleResponse, error) {
+	video, _ := s.videoRepo.GetWithRelations(ctx, article.VideoID)
 
 	var content map[string]interface{}
 	if err := json.Unmarshal(article.Content, &content); err != nil {
@@ -497,6 +554,16 @@ func (s *ArticleServiceImpl) GetPublishedArticleByType(ctx context.Context, arti
 		return nil, err
 	}
 
+	if article.Origin == models.ArticleOriginGenerator && s.dropDetachedRankingItems(ctx, article, content) {
+		// JSON-LD ใช้ content ชุดเดียวกับหน้าเว็บ (ไม่แก้ของที่เก็บใน DB - restore video แล้วรายการกลับมา)
+		filtered, err := json.Marshal(content)
+		if err == nil {
+			copied := *article
+			copied.Content = filtered
+			article = &copied
+		}
+	}
+
 	response := &dto.PublicArticleResponse{
 		ID:              article.ID.String(),
 		Slug:            article.Slug,
@@ -520,32 +587,69 @@ func (s *ArticleServiceImpl) GetPublishedArticleByType(ctx context.Context, arti
 	}
 
 	// Get translations for language switcher (smooth navigation)
-	translations := s.getTranslations(ctx, article.VideoID, article.Language)
+	translations := s.getTranslations(ctx, article)
 	if len(translations) > 0 {
 		response.Translations = translations
 	}
 
-	// 3. Cache for next time
-	if s.cache != nil {
-		if err := s.cache.Set(ctx, cacheKey, response, cache.ArticleCacheTTL); err != nil {
-			logger.WarnContext(ctx, "Failed to cache article", "type", articleType, "slug", slug, "error", err)
+	response.JSONLD = s.jsonld.ArticleGraph(article, video)
+
+	return response, nil
+}
+
+// dropDetachedRankingItems ตัด rankingItems ของ video ที่อยู่ในถังขยะ/ถูกลบออกจากบทความ generator แล้วเรียง position ใหม่
+// returns true ถ้ามีรายการถูกตัด
+func (s *ArticleServiceImpl) dropDetachedRankingItems(ctx context.Context, article *models.Article, content map[string]interface{}) bool {
+	items, ok := content["rankingItems"].([]interface{})
+	if !ok || len(items) == 0 {
+		return false
+	}
+
+	videoIDs, err := s.articleRepo.ListActiveVideoIDs(ctx, article.ID)
+	if err != nil {
+		logger.WarnContext(ctx, "Failed to get article videos", "article_id", article.ID, "error", err)
+		return false
+	}
+	active := make(map[string]bool, len(videoIDs))
+	for _, id := range videoIDs {
+		active[id.String()] = true
+	}
+
+	kept := make([]interface{}, 0, len(items))
+	for _, raw := range items {
+		item, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
 		}
+		if videoID, _ := item["videoId"].(string); !active[videoID] {
+			continue
+		}
+		item["position"] = len(kept) + 1
+		kept = append(kept, item)
+	}
+	if len(kept) == len(items) {
+		return false
 	}
 
-	return response, nil
+	content["rankingItems"] = kept
+	return true
 }
 
 // getTranslations - หา slug ของ article ในภาษาอื่นๆ
 // returns map[language]slug เช่น {"en": "dldss-471-review", "th": "dldss-471-sub-thai"}
-func (s *ArticleServiceImpl) getTranslations(ctx context.Context, videoID uuid.UUID, currentLanguage string) map[string]string {
+// บทความจาก generator ไม่ได้ผูกกับ video เดียว จึงไม่มีคู่แปล
+func (s *ArticleServiceImpl) getTranslations(ctx context.Context, current *models.Article) map[string]string {
 	translations := make(map[string]string)
+	if current.Origin == models.ArticleOriginGenerator {
+		return translations
+	}
 	languages := []string{"th", "en"}
 
 	for _, lang := range languages {
-		if lang == currentLanguage {
+		if lang == current.Language {
 			continue // skip current language
 		}
-		article, err := s.articleRepo.GetPublishedByVideoIDAndLanguage(ctx, videoID, lang)
+		article, err := s.articleRepo.GetPublishedByVideoIDAndLanguage(ctx, current.VideoID, lang)
 		if err == nil && article != nil {
 			translations[lang] = article.Slug
 		}
@@ -556,17 +660,29 @@ func (s *ArticleServiceImpl) getTranslations(ctx context.Context, videoID uuid.U
 
 // findArticleBySlugFallback - หา article จาก slug อื่นที่มี videoId เดียวกัน
 // ใช้เมื่อ slug ไม่ตรงกับภาษาที่ต้องการ (เช่น EN slug แต่ขอ TH)
-func (s *ArticleServiceImpl) findArticleBySlugFallback(ctx context.Context, articleType string, slug string, targetLanguage string) (*models.Article, error) {
+// รวมถึง slug เก่าของภาษาอื่นที่ถูกเปลี่ยนไปแล้ว
+func (s *ArticleServiceImpl) findArticleBySlugFallback(ctx context.Context, articleType string, slug string, targetLanguage string) (*dto.ArticleRedirectTarget, error) {
 	// 1. หา article จาก slug โดยไม่สน language
 	sourceArticle, err := s.articleRepo.GetPublishedByTypeSlugAndLanguage(ctx, articleType, slug, "")
 	if err != nil {
-		return nil, err
+		// ไม่มี article ที่ใช้ slug นี้อยู่ ลองหาจาก slug เก่าของทุกภาษา
+		if s.redirectRepo == nil {
+			return nil, err
+		}
+		redirect, redirectErr := s.redirectRepo.FindBySource(ctx, articleType, slug, "")
+		if redirectErr != nil {
+			return nil, err
+		}
+		return s.publishedRedirectTarget(ctx, redirect.ArticleID, targetLanguage)
 	}
 
 	// 2. ถ้าภาษาตรงกันอยู่แล้ว ไม่ต้อง fallback
 	if sourceArticle.Language == targetLanguage {
 		return nil, errors.New("same language")
 	}
+	if sourceArticle.Origin == models.ArticleOriginGenerator {
+		return nil, errors.New("article not found")
+	}
 
 	// 3. หา article ที่มี videoId เดียวกัน แต่ภาษาที่ต้องการ
 	targetArticle, err := s.articleRepo.GetPublishedByVideoIDAndLanguage(ctx, sourceArticle.VideoID, targetLanguage)
@@ -574,7 +690,63 @@ func (s *ArticleServiceImpl) findArticleBySlugFallback(ctx context.Context, arti
 		return nil, err
 	}
 
-	return targetArticle, nil
+	return newRedirectTarget(targetArticle), nil
+}
+
+// findArticleRedirect หา redirect จาก slug เก่า แล้ว resolve เป็นบทความ published ปัจจุบัน
+// language ว่าง = ไม่สนภาษา
+func (s *ArticleServiceImpl) findArticleRedirect(ctx context.Context, articleType string, slug string, language string) *dto.ArticleRedirectTarget {
+	if s.redirectRepo == nil {
+		return nil
+	}
+
+	redirect, err := s.redirectRepo.FindBySource(ctx, articleType, slug, language)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.WarnContext(ctx, "Failed to find article redirect", "type", articleType, "slug", slug, "language", language, "error", err)
+		}
+		return nil
+	}
+
+	target, err := s.publishedRedirectTarget(ctx, redirect.ArticleID, language)
+	if err != nil {
+		return nil
+	}
+	return target
+}
+
+// publishedRedirectTarget returns ปลายทางของ redirect ถ้าบทความยัง published อยู่
+// ถ้าบทความปลายทางเป็นคนละภาษากับที่ขอ จะไปหาบทความภาษาที่ขอของ video เดียวกันแทน
+func (s *ArticleServiceImpl) publishedRedirectTarget(ctx context.Context, articleID uuid.UUID, language string) (*dto.ArticleRedirectTarget, error) {
+	article, err := s.articleRepo.GetByID(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if language != "" && article.Language != language {
+		if article.Origin == models.ArticleOriginGenerator {
+			return nil, errors.New("article not found")
+		}
+		article, err = s.articleRepo.GetPublishedByVideoIDAndLanguage(ctx, article.VideoID, language)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if article.Status != models.ArticleStatusPublished {
+		return nil, errors.New("article not found")
+	}
+	return newRedirectTarget(article), nil
+}
+
+func newRedirectTarget(article *models.Article) *dto.ArticleRedirectTarget {
+	return &dto.ArticleRedirectTarget{
+		Type:       string(article.Type),
+		Slug:       article.Slug,
+		Language:   article.Language,
+		Path:       seo.ArticlePath(article.Language, string(article.Type), article.Slug),
+		StatusCode: http.StatusMovedPermanently,
+	}
 }
 
 // ========================================
@@ -663,6 +835,135 @@ func (s *ArticleServiceImpl) ListArticlesByMaker(ctx context.Context, makerSlug
 	return s.mapToPublicSummaries(articles), total, nil
 }
 
+// SearchPublishedArticles ค้นหาแบบ full-text (title, meta description, เนื้อหาใน content)
+func (s *ArticleServiceImpl) SearchPublishedArticles(ctx context.Context, params *dto.ArticleSearchParams) (*dto.ArticleSearchResponse, error) {
+	params.SetDefaults()
+
+	result, err := s.articleRepo.SearchPublished(ctx, repositories.ArticleSearchParams{
+		Query:       strings.TrimSpace(params.Q),
+		Language:    params.Lang,
+		ArticleType: params.Type,
+		Limit:       params.Limit,
+		Offset:      (params.Page - 1) * params.Limit,
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to search articles", "query", params.Q, "lang", params.Lang, "error", err)
+		return nil, err
+	}
+
+	articles := make([]repositories.PublishedArticleWithVideo, len(result.Hits))
+	for i := range result.Hits {
+		articles[i] = result.Hits[i].PublishedArticleWithVideo
+	}
+	summaries := s.mapToPublicSummaries(articles)
+
+	hits := make([]dto.ArticleSearchHit, len(result.Hits))
+	for i, h := range result.Hits {
+		hits[i] = dto.ArticleSearchHit{
+			PublicArticleSummary: summaries[i],
+			Rank:                 h.Rank,
+			TitleHighlight:       h.TitleHighlight,
+			Snippet:              h.Snippet,
+		}
+	}
+
+	facets := make([]dto.ArticleSearchFacet, 0, len(result.TypeFacets))
+	for t, count := range result.TypeFacets {
+		facets = append(facets, dto.ArticleSearchFacet{Type: t, Count: count})
+	}
+	sort.Slice(facets, func(i, j int) bool {
+		if facets[i].Count != facets[j].Count {
+			return facets[i].Count > facets[j].Count
+		}
+		return facets[i].Type < facets[j].Type
+	})
+
+	totalPages := int((result.Total + int64(params.Limit) - 1) / int64(params.Limit))
+	return &dto.ArticleSearchResponse{
+		Query:      params.Q,
+		Hits:       hits,
+		Facets:     facets,
+		Total:      result.Total,
+		Page:       params.Page,
+		Limit:      params.Limit,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// refreshSearchIndex อัปเดต search_vector หลัง title/meta/content เปลี่ยน (ไม่ fail ทั้ง request)
+func (s *ArticleServiceImpl) refreshSearchIndex(ctx context.Context, article *models.Article) {
+	if err := s.articleRepo.RefreshSearchVector(ctx, article.ID); err != nil {
+		logger.WarnContext(ctx, "Failed to refresh article search vector", "article_id", article.ID, "error", err)
+	}
+}
+
+// relatedArticleWeights - cast ตรงกันบ่งบอกความเกี่ยวข้องมากที่สุด, auto-tag น้อยที่สุด
+var relatedArticleWeights = repositories.RelatedArticleWeights{
+	Cast:    3,
+	Maker:   2,
+	Tag:     1,
+	AutoTag: 0.5,
+}
+
+// GetRelatedArticles บทความ "อ่านต่อ" ของบทความ type/slug (ภาษาเดียวกัน)
+func (s *ArticleServiceImpl) GetRelatedArticles(ctx context.Context, articleType string, slug string, params *dto.RelatedArticleParams) ([]dto.RelatedArticleSummary, error) {
+	params.SetDefaults()
+	if !models.IsValidArticleType(articleType) {
+		return nil, errors.New("invalid article type")
+	}
+
+	source, err := s.articleRepo.GetPublishedByTypeSlugAndLanguage(ctx, articleType, slug, params.Lang)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("article not found")
+		}
+		logger.ErrorContext(ctx, "Failed to get source article for related", "type", articleType, "slug", slug, "error", err)
+		return nil, err
+	}
+
+	cacheKey := cache.ArticleRelatedKey(source.ID.String(), params.Limit)
+	if s.cache != nil {
+		var cached []dto.RelatedArticleSummary
+		if err := s.cache.Get(ctx, cacheKey, &cached); err == nil {
+			return cached, nil
+		}
+	}
+
+	related, err := s.articleRepo.ListRelatedPublished(ctx, source, relatedArticleWeights, params.Limit)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list related articles", "article_id", source.ID, "error", err)
+		return nil, err
+	}
+
+	articles := make([]repositories.PublishedArticleWithVideo, len(related))
+	for i := range related {
+		articles[i] = related[i].PublishedArticleWithVideo
+	}
+	summaries := s.mapToPublicSummaries(articles)
+
+	result := make([]dto.RelatedArticleSummary, len(related))
+	for i := range related {
+		result[i] = dto.RelatedArticleSummary{
+			PublicArticleSummary: summaries[i],
+			Score:                related[i].Score,
+		}
+	}
+
+	if s.cache != nil {
+		if err := s.cache.Set(ctx, cacheKey, result, cache.ArticleRelatedCacheTTL); err != nil {
+			logger.WarnContext(ctx, "Failed to cache related articles", "cache_key", cacheKey, "error", err)
+		}
+		// จำไว้ว่าบทความปลายทางแต่ละตัวถูกอ้างจาก source นี้ เพื่อล้าง cache เมื่อปลายทางเปลี่ยน
+		for _, r := range related {
+			if err := s.cache.SetAdd(ctx, cache.ArticleRelatedRefsKey(r.ID.String()), cache.ArticleRelatedCacheTTL, source.ID.String()); err != nil {
+				logger.WarnContext(ctx, "Failed to record related article ref", "article_id", r.ID, "error", err)
+			}
+		}
+	}
+
+	return result, nil
+}
+
 // mapToPublicSummaries แปลง repository result เป็น DTO
 func (s *ArticleServiceImpl) mapToPublicSummaries(articles []repositories.PublishedArticleWithVideo) []dto.PublicArticleSummary {
 	result := make([]dto.PublicArticleSummary, len(articles))
@@ -709,6 +1010,542 @@ func (s *ArticleServiceImpl) ClearArticleCache(ctx context.Context, articleType
 	return nil
 }
 
+// ========================================
+// Admin Edit & Revisions
+// ========================================
+
+func (s *ArticleServiceImpl) UpdateArticle(ctx context.Context, id uuid.UUID, userID uuid.UUID, req *dto.UpdateArticleRequest) (*dto.ArticleDetailResponse, error) {
+	article, err := s.articleRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("article not found")
+		}
+		logger.ErrorContext(ctx, "Failed to get article for update", "article_id", id, "error", err)
+		return nil, err
+	}
+
+	if len(req.Content) > 0 {
+		var content map[string]interface{}
+		if err := json.Unmarshal(req.Content, &content); err != nil {
+			return nil, errors.New("invalid content")
+		}
+	}
+
+	// content หรือ type ที่เปลี่ยนต้องตรง schema ของ type เหมือนตอน ingest
+	if len(req.Content) > 0 || req.Type != nil {
+		articleType, content := article.Type, []byte(article.Content)
+		if req.Type != nil {
+			articleType = models.ArticleType(*req.Type)
+		}
+		if len(req.Content) > 0 {
+			content = req.Content
+		}
+		if err := schema.ValidateArticleContent(string(articleType), content); err != nil {
+			logger.WarnContext(ctx, "Edited article content does not match schema", "article_id", id, "type", articleType, "error", err)
+			return nil, err
+		}
+	}
+
+	if req.Slug != nil && *req.Slug != article.Slug {
+		exists, err := s.articleRepo.SlugExists(ctx, *req.Slug, article.Language, article.ID)
+		if err != nil {
+			logger.ErrorContext(ctx, "Failed to check slug", "slug", *req.Slug, "error", err)
+			return nil, err
+		}
+		if exists {
+			return nil, errors.New("slug already exists")
+		}
+	}
+
+	s.ensureBaselineRevision(ctx, article)
+	oldType, oldSlug := article.Type, article.Slug
+
+	if req.Type != nil {
+		article.Type = models.ArticleType(*req.Type)
+	}
+	if req.Title != nil {
+		article.Title = *req.Title
+	}
+	if req.MetaTitle != nil {
+		article.MetaTitle = *req.MetaTitle
+	}
+	if req.MetaDescription != nil {
+		article.MetaDescription = *req.MetaDescription
+	}
+	if req.Slug != nil {
+		article.Slug = *req.Slug
+	}
+	if len(req.Content) > 0 {
+		article.Content = req.Content
+	}
+	if req.QualityScore != nil {
+		article.QualityScore = *req.QualityScore
+	}
+	if req.ReadingTime != nil {
+		article.ReadingTime = *req.ReadingTime
+	}
+
+	if err := s.articleRepo.Update(ctx, article); err != nil {
+		logger.ErrorContext(ctx, "Failed to update article", "article_id", id, "error", err)
+		return nil, err
+	}
+
+	s.recordRevision(ctx, article, models.RevisionSourceAdmin, &userID, nil)
+	s.refreshSearchIndex(ctx, article)
+	s.trackSlugChange(ctx, article, oldType, oldSlug)
+	s.invalidateContentCaches(ctx, article, oldType, oldSlug)
+
+	logger.InfoContext(ctx, "Article edited by admin", "article_id", id, "user_id", userID)
+
+	video, _ := s.videoRepo.GetByID(ctx, article.VideoID)
+	return s.mapToDetailResponse(article, video), nil
+}
+
+func (s *ArticleServiceImpl) ListRevisions(ctx context.Context, articleID uuid.UUID, params *dto.ArticleRevisionListParams) ([]dto.ArticleRevisionSummary, int64, error) {
+	params.SetDefaults()
+
+	if _, err := s.articleRepo.GetByID(ctx, articleID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, 0, errors.New("article not found")
+		}
+		logger.ErrorContext(ctx, "Failed to get article for revisions", "article_id", articleID, "error", err)
+		return nil, 0, err
+	}
+
+	revisions, total, err := s.revisionRepo.ListByArticle(ctx, articleID, (params.Page-1)*params.Limit, params.Limit)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list article revisions", "article_id", articleID, "error", err)
+		return nil, 0, err
+	}
+
+	result := make([]dto.ArticleRevisionSummary, len(revisions))
+	for i := range revisions {
+		result[i] = mapToRevisionSummary(&revisions[i])
+	}
+	return result, total, nil
+}
+
+func (s *ArticleServiceImpl) GetRevision(ctx context.Context, articleID uuid.UUID, revision int) (*dto.ArticleRevisionResponse, error) {
+	rev, err := s.getRevision(ctx, articleID, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	var content map[string]interface{}
+	if rev.Content != nil {
+		json.Unmarshal(rev.Content, &content)
+	}
+
+	return &dto.ArticleRevisionResponse{
+		ArticleRevisionSummary: mapToRevisionSummary(rev),
+		ArticleID:              rev.ArticleID.String(),
+		MetaTitle:              rev.MetaTitle,
+		MetaDescription:        rev.MetaDescription,
+		Content:                content,
+		ReadingTime:            rev.ReadingTime,
+	}, nil
+}
+
+// DiffRevisions เทียบ 2 revisions ทีละ field และไล่ลึกเข้าไปใน content JSON
+// to = 0 คือเทียบกับ revision ล่าสุด
+func (s *ArticleServiceImpl) DiffRevisions(ctx context.Context, articleID uuid.UUID, from int, to int) (*dto.ArticleRevisionDiffResponse, error) {
+	fromRev, err := s.getRevision(ctx, articleID, from)
+	if err != nil {
+		return nil, err
+	}
+
+	var toRev *models.ArticleRevision
+	if to == 0 {
+		toRev, err = s.revisionRepo.GetLatest(ctx, articleID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errors.New("revision not found")
+			}
+			logger.ErrorContext(ctx, "Failed to get latest revision", "article_id", articleID, "error", err)
+			return nil, err
+		}
+	} else {
+		toRev, err = s.getRevision(ctx, articleID, to)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	changes := utils.DiffJSON("", revisionDiffDocument(fromRev), revisionDiffDocument(toRev))
+	result := make([]dto.ArticleRevisionChange, len(changes))
+	for i, c := range changes {
+		result[i] = dto.ArticleRevisionChange{
+			Path: c.Path,
+			Op:   c.Op,
+			From: c.From,
+			To:   c.To,
+		}
+	}
+
+	return &dto.ArticleRevisionDiffResponse{
+		ArticleID: articleID.String(),
+		From:      fromRev.Revision,
+		To:        toRev.Revision,
+		Changes:   result,
+	}, nil
+}
+
+// RestoreRevision นำ snapshot ของ revision เก่ากลับมาเป็นเนื้อหาปัจจุบัน
+// และบันทึกเป็น revision ใหม่ (ไม่ลบประวัติที่อยู่หลัง revision นั้น)
+func (s *ArticleServiceImpl) RestoreRevision(ctx context.Context, articleID uuid.UUID, revision int, userID uuid.UUID) (*dto.ArticleDetailResponse, error) {
+	article, err := s.articleRepo.GetByID(ctx, articleID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("article not found")
+		}
+		logger.ErrorContext(ctx, "Failed to get article for restore", "article_id", articleID, "error", err)
+		return nil, err
+	}
+
+	rev, err := s.getRevision(ctx, articleID, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	// revision เก่าอาจบันทึกก่อนมี schema ของ type นั้น
+	if err := schema.ValidateArticleContent(string(rev.Type), rev.Content); err != nil {
+		logger.WarnContext(ctx, "Revision content does not match schema", "article_id", articleID, "revision", revision, "type", rev.Type, "error", err)
+		return nil, err
+	}
+
+	if rev.Slug != article.Slug {
+		exists, err := s.articleRepo.SlugExists(ctx, rev.Slug, article.Language, article.ID)
+		if err != nil {
+			logger.ErrorContext(ctx, "Failed to check slug", "slug", rev.Slug, "error", err)
+			return nil, err
+		}
+		if exists {
+			return nil, errors.New("slug already exists")
+		}
+	}
+
+	oldType, oldSlug := article.Type, article.Slug
+
+	article.Type = rev.Type
+	article.Slug = rev.Slug
+	article.Title = rev.Title
+	article.MetaTitle = rev.MetaTitle
+	article.MetaDescription = rev.MetaDescription
+	article.Content = rev.Content
+	article.QualityScore = rev.QualityScore
+	article.ReadingTime = rev.ReadingTime
+
+	if err := s.articleRepo.Update(ctx, article); err != nil {
+		logger.ErrorContext(ctx, "Failed to restore article revision", "article_id", articleID, "revision", revision, "error", err)
+		return nil, err
+	}
+
+	s.recordRevision(ctx, article, models.RevisionSourceRestore, &userID, &rev.Revision)
+	s.refreshSearchIndex(ctx, article)
+	s.trackSlugChange(ctx, article, oldType, oldSlug)
+	s.invalidateContentCaches(ctx, article, oldType, oldSlug)
+
+	logger.InfoContext(ctx, "Article revision restored", "article_id", articleID, "revision", revision, "user_id", userID)
+
+	video, _ := s.videoRepo.GetByID(ctx, article.VideoID)
+	return s.mapToDetailResponse(article, video), nil
+}
+
+func (s *ArticleServiceImpl) getRevision(ctx context.Context, articleID uuid.UUID, revision int) (*models.ArticleRevision, error) {
+	rev, err := s.revisionRepo.GetByNumber(ctx, articleID, revision)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("revision not found")
+		}
+		logger.ErrorContext(ctx, "Failed to get article revision", "article_id", articleID, "revision", revision, "error", err)
+		return nil, err
+	}
+	return rev, nil
+}
+
+// recordRevision บันทึก snapshot ของบทความหลังเปลี่ยนแปลง
+// ไม่ return error เพราะไม่ควรทำให้ ingest/edit ล้มเหลวเพียงเพราะเก็บประวัติไม่ได้
+func (s *ArticleServiceImpl) recordRevision(ctx context.Context, article *models.Article, source models.RevisionSource, userID *uuid.UUID, restoredFrom *int) {
+	if s.revisionRepo == nil {
+		return
+	}
+
+	rev := &models.ArticleRevision{
+		ArticleID:       article.ID,
+		Source:          source,
+		RestoredFrom:    restoredFrom,
+		CreatedBy:       userID,
+		Type:            article.Type,
+		Slug:            article.Slug,
+		Title:           article.Title,
+		MetaTitle:       article.MetaTitle,
+		MetaDescription: article.MetaDescription,
+		Content:         article.Content,
+		QualityScore:    article.QualityScore,
+		ReadingTime:     article.ReadingTime,
+	}
+	if err := s.revisionRepo.Create(ctx, rev); err != nil {
+		logger.WarnContext(ctx, "Failed to record article revision", "article_id", article.ID, "source", source, "error", err)
+	}
+}
+
+// ensureBaselineRevision บันทึกสถานะปัจจุบันเป็น baseline ถ้าบทความยังไม่มี revision เลย
+func (s *ArticleServiceImpl) ensureBaselineRevision(ctx context.Context, article *models.Article) {
+	if s.revisionRepo == nil {
+		return
+	}
+
+	count, err := s.revisionRepo.CountByArticle(ctx, article.ID)
+	if err != nil || count > 0 {
+		return
+	}
+	s.recordRevision(ctx, article, models.RevisionSourceBaseline, nil, nil)
+}
+
+func mapToRevisionSummary(rev *models.ArticleRevision) dto.ArticleRevisionSummary {
+	summary := dto.ArticleRevisionSummary{
+		Revision:     rev.Revision,
+		Source:       string(rev.Source),
+		RestoredFrom: rev.RestoredFrom,
+		Type:         string(rev.Type),
+		Slug:         rev.Slug,
+		Title:        rev.Title,
+		QualityScore: rev.QualityScore,
+		CreatedAt:    rev.CreatedAt.Format(time.RFC3339),
+	}
+	if rev.CreatedBy != nil {
+		createdBy := rev.CreatedBy.String()
+		summary.CreatedBy = &createdBy
+	}
+	return summary
+}
+
+// revisionDiffDocument แปลง revision เป็น document เดียวสำหรับ DiffJSON
+// ใช้ key เดียวกับ JSON response เพื่อให้ path ใน diff ตรงกับที่ frontend เห็น
+func revisionDiffDocument(rev *models.ArticleRevision) map[string]interface{} {
+	var content interface{}
+	if rev.Content != nil {
+		json.Unmarshal(rev.Content, &content)
+	}
+
+	return map[string]interface{}{
+		"type":            string(rev.Type),
+		"slug":            rev.Slug,
+		"title":           rev.Title,
+		"metaTitle":       rev.MetaTitle,
+		"metaDescription": rev.MetaDescription,
+		"qualityScore":    rev.QualityScore,
+		"readingTime":     rev.ReadingTime,
+		"content":         content,
+	}
+}
+
+// ========================================
+// Slug Redirects
+// ========================================
+
+func (s *ArticleServiceImpl) ListRedirects(ctx context.Context, params *dto.ArticleRedirectListParams) ([]dto.ArticleRedirectResponse, int64, error) {
+	params.SetDefaults()
+
+	redirects, total, err := s.redirectRepo.List(ctx, repositories.ArticleRedirectListParams{
+		Limit:    params.Limit,
+		Offset:   (params.Page - 1) * params.Limit,
+		Language: params.Language,
+		Search:   params.Search,
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list article redirects", "error", err)
+		return nil, 0, err
+	}
+
+	result := make([]dto.ArticleRedirectResponse, len(redirects))
+	for i := range redirects {
+		result[i] = mapToRedirectResponse(&redirects[i])
+	}
+	return result, total, nil
+}
+
+// CreateRedirect เพิ่ม redirect เอง (เช่น URL เก่าจากเว็บเดิม) ไปยังบทความที่มีอยู่
+func (s *ArticleServiceImpl) CreateRedirect(ctx context.Context, userID uuid.UUID, req *dto.CreateArticleRedirectRequest) (*dto.ArticleRedirectResponse, error) {
+	articleID, err := uuid.Parse(req.ArticleID)
+	if err != nil {
+		return nil, errors.New("article not found")
+	}
+
+	article, err := s.articleRepo.GetByID(ctx, articleID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("article not found")
+		}
+		logger.ErrorContext(ctx, "Failed to get article for redirect", "article_id", articleID, "error", err)
+		return nil, err
+	}
+
+	language := req.Language
+	if language == "" {
+		language = article.Language
+	}
+
+	// slug ที่ยังมีบทความใช้อยู่ redirect จะไม่มีวันถูกใช้ (เจอบทความจริงก่อน)
+	inUse, err := s.articleRepo.SlugExists(ctx, req.FromSlug, language, uuid.Nil)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to check slug", "slug", req.FromSlug, "error", err)
+		return nil, err
+	}
+	if inUse {
+		return nil, errors.New("slug is in use")
+	}
+
+	redirect := &models.ArticleRedirect{
+		Language:  language,
+		FromType:  models.ArticleType(req.FromType),
+		FromSlug:  req.FromSlug,
+		ArticleID: article.ID,
+		Source:    models.RedirectSourceManual,
+		CreatedBy: &userID,
+	}
+	if err := s.redirectRepo.Upsert(ctx, redirect); err != nil {
+		logger.ErrorContext(ctx, "Failed to create article redirect", "from_slug", req.FromSlug, "error", err)
+		return nil, err
+	}
+
+	logger.InfoContext(ctx, "Article redirect created",
+		"from", seo.ArticlePath(language, req.FromType, req.FromSlug),
+		"article_id", article.ID,
+		"user_id", userID,
+	)
+
+	redirect.Article = article
+	result := mapToRedirectResponse(redirect)
+	return &result, nil
+}
+
+func (s *ArticleServiceImpl) DeleteRedirect(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.redirectRepo.GetByID(ctx, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("redirect not found")
+		}
+		logger.ErrorContext(ctx, "Failed to get article redirect", "redirect_id", id, "error", err)
+		return err
+	}
+
+	if err := s.redirectRepo.Delete(ctx, id); err != nil {
+		logger.ErrorContext(ctx, "Failed to delete article redirect", "redirect_id", id, "error", err)
+		return err
+	}
+
+	logger.InfoContext(ctx, "Article redirect deleted", "redirect_id", id)
+	return nil
+}
+
+// trackSlugChange เก็บ URL เดิมเป็น redirect เมื่อ type/slug ของบทความเปลี่ยน
+// และลบ redirect ที่ source ตรงกับ URL ใหม่ เพราะ URL นั้นกลับมาเป็นบทความจริงแล้ว
+func (s *ArticleServiceImpl) trackSlugChange(ctx context.Context, article *models.Article, oldType models.ArticleType, oldSlug string) {
+	if s.redirectRepo == nil || (oldType == article.Type && oldSlug == article.Slug) {
+		return
+	}
+
+	if err := s.redirectRepo.DeleteBySource(ctx, string(article.Type), article.Slug, article.Language); err != nil {
+		logger.WarnContext(ctx, "Failed to clear redirect for new slug", "article_id", article.ID, "slug", article.Slug, "error", err)
+	}
+
+	redirect := &models.ArticleRedirect{
+		Language:  article.Language,
+		FromType:  oldType,
+		FromSlug:  oldSlug,
+		ArticleID: article.ID,
+		Source:    models.RedirectSourceAuto,
+	}
+	if err := s.redirectRepo.Upsert(ctx, redirect); err != nil {
+		logger.WarnContext(ctx, "Failed to record slug redirect", "article_id", article.ID, "old_slug", oldSlug, "error", err)
+		return
+	}
+
+	logger.InfoContext(ctx, "Slug redirect recorded",
+		"article_id", article.ID,
+		"from", seo.ArticlePath(article.Language, string(oldType), oldSlug),
+		"to", seo.ArticlePath(article.Language, string(article.Type), article.Slug),
+	)
+}
+
+func mapToRedirectResponse(redirect *models.ArticleRedirect) dto.ArticleRedirectResponse {
+	resp := dto.ArticleRedirectResponse{
+		ID:        redirect.ID.String(),
+		Language:  redirect.Language,
+		FromType:  string(redirect.FromType),
+		FromSlug:  redirect.FromSlug,
+		ArticleID: redirect.ArticleID.String(),
+		Source:    string(redirect.Source),
+		CreatedAt: redirect.CreatedAt.Format(time.RFC3339),
+	}
+	if redirect.Article != nil {
+		resp.ToType = string(redirect.Article.Type)
+		resp.ToSlug = redirect.Article.Slug
+	}
+	return resp
+}
+
+// ========================================
+// Content Validation
+// ========================================
+
+// contentCheckBatchSize - จำนวนบทความต่อรอบที่ดึงมาตรวจ schema
+const contentCheckBatchSize = 500
+
+// ValidateStoredContent ตรวจ content ของบทความที่เก็บไว้แล้วกับ schema ปัจจุบันของแต่ละ type
+// ใช้หลังเปลี่ยน schema เพื่อหาบทความเก่าที่ไม่ตรงแล้ว (ไม่แก้ข้อมูล แค่รายงาน)
+func (s *ArticleServiceImpl) ValidateStoredContent(ctx context.Context, req *dto.ArticleContentCheckRequest) (*dto.ArticleContentCheckResponse, error) {
+	result := &dto.ArticleContentCheckResponse{Violations: []dto.ArticleContentViolation{}}
+
+	afterID := uuid.Nil
+	for {
+		articles, err := s.articleRepo.ListForContentCheck(ctx, afterID, contentCheckBatchSize, req.Type, req.Status)
+		if err != nil {
+			logger.ErrorContext(ctx, "Failed to list articles for content check", "after_id", afterID, "error", err)
+			return nil, err
+		}
+		if len(articles) == 0 {
+			break
+		}
+
+		for _, a := range articles {
+			result.Checked++
+
+			err := schema.ValidateArticleContent(string(a.Type), a.Content)
+			if err == nil {
+				continue
+			}
+
+			var schemaErr *schema.ValidationError
+			if !errors.As(err, &schemaErr) {
+				return nil, err
+			}
+			result.Invalid++
+			result.Violations = append(result.Violations, dto.ArticleContentViolation{
+				ID:       a.ID.String(),
+				Slug:     a.Slug,
+				Type:     string(a.Type),
+				Language: a.Language,
+				Status:   string(a.Status),
+				Errors:   schemaErr.Errors,
+			})
+		}
+
+		afterID = articles[len(articles)-1].ID
+		if len(articles) < contentCheckBatchSize {
+			break
+		}
+	}
+
+	logger.InfoContext(ctx, "Stored article content validated",
+		"type", req.Type,
+		"status", req.Status,
+		"checked", result.Checked,
+		"invalid", result.Invalid,
+	)
+	return result, nil
+}
+
 // Helper to map article to detail response
 func (s *ArticleServiceImpl) mapToDetailResponse(article *models.Article, video *models.Video) *dto.ArticleDetailResponse {
 	var content map[string]interface{}
@@ -750,10 +1587,39 @@ func (s *ArticleServiceImpl) mapToDetailResponse(article *models.Article, video
 		t := article.IndexedAt.Format(time.RFC3339)
 		resp.IndexedAt = &t
 	}
+	if article.ReviewerID != nil {
+		reviewer := article.ReviewerID.String()
+		resp.ReviewerID = &reviewer
+	}
+	resp.ReviewNotes = article.ReviewNotes
+	if article.ReviewedAt != nil {
+		t := article.ReviewedAt.Format(time.RFC3339)
+		resp.ReviewedAt = &t
+	}
 
 	return resp
 }
 
+// invalidateContentCaches ล้าง cache หลังเนื้อหาบทความเปลี่ยน (ingest, admin edit, restore)
+// published -> ล้างทุกหน้าที่เกี่ยวข้อง, อื่นๆ -> ล้างแค่ detail
+// ถ้า type/slug เปลี่ยน ต้องล้าง key เดิมด้วย ไม่งั้น URL เดิมยังเสิร์ฟเนื้อหาเก่า
+func (s *ArticleServiceImpl) invalidateContentCaches(ctx context.Context, article *models.Article, oldType models.ArticleType, oldSlug string) {
+	// Invalidate all related caches when published article is updated
+	// This ensures cast/tag/maker pages show updated content
+	if article.Status == models.ArticleStatusPublished {
+		s.invalidateRelatedCaches(ctx, article)
+	} else if s.cache != nil {
+		// For non-published articles, just invalidate article detail cache
+		cacheKey := cache.ArticleKeyWithLang(string(article.Type), article.Slug, article.Language)
+		_ = s.cache.Delete(ctx, cacheKey)
+		s.invalidateRelatedArticleCaches(ctx, article.ID)
+	}
+
+	if s.cache != nil && (oldType != article.Type || oldSlug != article.Slug) {
+		_ = s.cache.Delete(ctx, cache.ArticleKeyWithLang(string(oldType), oldSlug, article.Language))
+	}
+}
+
 // invalidateRelatedCaches invalidates all caches related to article publication
 // This ensures homepage, cast pages, tag pages, and maker pages show the new article immediately
 func (s *ArticleServiceImpl) invalidateRelatedCaches(ctx context.Context, article *models.Article) {
@@ -761,16 +1627,10 @@ func (s *ArticleServiceImpl) invalidateRelatedCaches(ctx context.Context, articl
 		return
 	}
 
-	// 1. Invalidate article detail cache
-	cacheKey := cache.ArticleKeyWithLang(string(article.Type), article.Slug, article.Language)
-	if err := s.cache.Delete(ctx, cacheKey); err == nil {
-		logger.InfoContext(ctx, "Article cache invalidated", "cache_key", cacheKey)
-	}
-
-	// 1.5. Invalidate sibling articles (same videoID, different language) to refresh translations
+	// Invalidate sibling articles (same videoID, different language) to refresh translations
 	languages := []string{"th", "en"}
 	for _, lang := range languages {
-		if lang == article.Language {
+		if lang == article.Language || article.Origin == models.ArticleOriginGenerator {
 			continue // skip current article
 		}
 		// Find sibling article
@@ -783,41 +1643,21 @@ func (s *ArticleServiceImpl) invalidateRelatedCaches(ctx context.Context, articl
 		}
 	}
 
-	// 2. Invalidate article list caches (all pages)
-	if deleted, err := s.cache.DeleteByPattern(ctx, cache.ArticleListPattern()); err == nil && deleted > 0 {
-		logger.InfoContext(ctx, "Article list cache invalidated", "deleted_keys", deleted)
-	}
-
-	// 3. Get video with relations to invalidate cast/tag/maker caches
+	// Get video with relations to invalidate cast/tag/maker caches
 	video, err := s.videoRepo.GetWithRelations(ctx, article.VideoID)
 	if err != nil {
 		logger.WarnContext(ctx, "Failed to get video relations for cache invalidation", "video_id", article.VideoID, "error", err)
-		return
+		video = nil
 	}
 
-	// 4. Invalidate cast pages cache
-	for _, cast := range video.Casts {
-		pattern := cache.ArticleByCastPattern(cast.Slug)
-		if deleted, err := s.cache.DeleteByPattern(ctx, pattern); err == nil && deleted > 0 {
-			logger.InfoContext(ctx, "Cast articles cache invalidated", "cast_slug", cast.Slug, "deleted_keys", deleted)
-		}
-	}
-
-	// 5. Invalidate tag pages cache
-	for _, tag := range video.Tags {
-		pattern := cache.ArticleByTagPattern(tag.Slug)
-		if deleted, err := s.cache.DeleteByPattern(ctx, pattern); err == nil && deleted > 0 {
-			logger.InfoContext(ctx, "Tag articles cache invalidated", "tag_slug", tag.Slug, "deleted_keys", deleted)
-		}
-	}
+	// Detail, related, list, feeds, cast/tag/maker pages
+	invalidateArticlePages(ctx, s.cache, []models.Article{*article}, video)
+}
 
-	// 6. Invalidate maker page cache
-	if video.Maker != nil {
-		pattern := cache.ArticleByMakerPattern(video.Maker.Slug)
-		if deleted, err := s.cache.DeleteByPattern(ctx, pattern); err == nil && deleted > 0 {
-			logger.InfoContext(ctx, "Maker articles cache invalidated", "maker_slug", video.Maker.Slug, "deleted_keys", deleted)
-		}
-	}
+// invalidateRelatedArticleCaches ล้าง related cache ของบทความนี้
+// และของทุกบทความที่ related list มีบทความนี้อยู่ (ตาม refs set)
+func (s *ArticleServiceImpl) invalidateRelatedArticleCaches(ctx context.Context, articleID uuid.UUID) {
+	invalidateRelatedArticles(ctx, s.cache, articleID)
 }
 
 // extractThumbnailFromContent ดึง thumbnailUrl จาก article content JSON
@@ -18,11 +18,13 @@ import (
 
 type CastServiceImpl struct {
 	castRepo repositories.CastRepository
+	jsonld   jsonldBuilder
 }
 
-func NewCastService(castRepo repositories.CastRepository) services.CastService {
+func NewCastService(castRepo repositories.CastRepository, siteURL string) services.CastService {
 	return &CastServiceImpl{
 		castRepo: castRepo,
+		jsonld:   newJSONLDBuilder(siteURL, nil),
 	}
 }
 
@@ -177,7 +179,10 @@ func (s *CastServiceImpl) GetCastBySlug(ctx context.Context, slug string, lang s
 		return nil, err
 	}
 
-	return s.toCastDetailResponse(cast, lang), nil
+	// หน้า cast (public) ใช้ slug -> แนบ Person JSON-LD
+	response := s.toCastDetailResponse(cast, lang)
+	response.JSONLD = s.jsonld.CastGraph(cast, lang)
+	return response, nil
 }
 
 func (s *CastServiceImpl) ListCasts(ctx context.Context, req *dto.CastListRequest) ([]dto.CastResponse, int64, error) {
@@ -189,6 +194,7 @@ func (s *CastServiceImpl) ListCasts(ctx context.Context, req *dto.CastListReques
 		SortBy:      req.SortBy,
 		Order:       req.Order,
 		HasArticles: req.HasArticles,
+		MissingLang: req.MissingLang,
 	}
 
 	// Parse IDs if provided (batch fetch mode)
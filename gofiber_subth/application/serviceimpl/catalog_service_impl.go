@@ -0,0 +1,264 @@
+package serviceimpl
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/repositories"
+	"gofiber-template/domain/services"
+	"gofiber-template/pkg/logger"
+)
+
+// CatalogFlushEvery - flush ไปหา client ทุกกี่แถว (ให้ปลายทางเห็นข้อมูลระหว่าง export ยาวๆ)
+const CatalogFlushEvery = 1000
+
+// catalogLangs - ภาษาที่เป็นคอลัมน์ title_*/article_* ใน CSV
+var catalogLangs = []string{"en", "th", "ja"}
+
+type catalogServiceImpl struct {
+	catalogRepo repositories.CatalogRepository
+}
+
+func NewCatalogService(catalogRepo repositories.CatalogRepository) services.CatalogService {
+	return &catalogServiceImpl{
+		catalogRepo: catalogRepo,
+	}
+}
+
+func (s *catalogServiceImpl) Validate(req *dto.CatalogExportRequest) error {
+	_, err := catalogParams(req)
+	return err
+}
+
+func (s *catalogServiceImpl) Filename(req *dto.CatalogExportRequest) (string, string) {
+	req.SetDefaults()
+
+	filename := "catalog"
+	if req.Since != "" {
+		filename += "_incremental"
+	}
+	filename += "_" + time.Now().UTC().Format("20060102T150405Z") + "." + req.Format
+
+	if req.Gzip {
+		return filename + ".gz", "application/gzip"
+	}
+	if req.Format == dto.CatalogFormatCSV {
+		return filename, "text/csv; charset=utf-8"
+	}
+	return filename, "application/x-ndjson; charset=utf-8"
+}
+
+func (s *catalogServiceImpl) Export(ctx context.Context, req *dto.CatalogExportRequest, w io.Writer) (*dto.CatalogExportResult, error) {
+	req.SetDefaults()
+
+	params, err := catalogParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// flush จากชั้นในออกไปชั้นนอก: csv → gzip → w
+	var gz *gzip.Writer
+	out := w
+	if req.Gzip {
+		gz = gzip.NewWriter(w)
+		defer gz.Close()
+		out = gz
+	}
+	flushOut := func() error { return nil }
+	if f, ok := w.(interface{ Flush() error }); ok {
+		flushOut = f.Flush
+	}
+
+	var write func(item *dto.CatalogItem) error
+	var flushFormat func() error
+	if req.Format == dto.CatalogFormatCSV {
+		cw := csv.NewWriter(out)
+		if err := cw.Write(catalogCSVHeader()); err != nil {
+			return nil, err
+		}
+		write = func(item *dto.CatalogItem) error {
+			return cw.Write(catalogCSVRecord(item))
+		}
+		flushFormat = func() error {
+			cw.Flush()
+			return cw.Error()
+		}
+	} else {
+		enc := json.NewEncoder(out)
+		enc.SetEscapeHTML(false)
+		write = func(item *dto.CatalogItem) error {
+			return enc.Encode(item)
+		}
+		flushFormat = func() error { return nil }
+	}
+	flush := func() error {
+		if err := flushFormat(); err != nil {
+			return err
+		}
+		if gz != nil {
+			if err := gz.Flush(); err != nil {
+				return err
+			}
+		}
+		return flushOut()
+	}
+
+	result := &dto.CatalogExportResult{}
+	err = s.catalogRepo.Stream(ctx, params, func(row *repositories.CatalogRow) error {
+		item := catalogItem(row)
+		if err := write(item); err != nil {
+			return err
+		}
+		result.Count++
+		result.UpdatedAt = item.UpdatedAt
+		if result.Count%CatalogFlushEvery == 0 {
+			return flush()
+		}
+		return nil
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to export catalog", "format", req.Format, "exported", result.Count, "error", err)
+		return nil, err
+	}
+
+	// ปิด gzip (เขียน footer) ก่อน flush ชั้นนอกครั้งสุดท้าย
+	if err := flushFormat(); err != nil {
+		return nil, err
+	}
+	if gz != nil {
+		if err := gz.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := flushOut(); err != nil {
+		return nil, err
+	}
+
+	logger.InfoContext(ctx, "Catalog exported",
+		"format", req.Format,
+		"gzip", req.Gzip,
+		"since", req.Since,
+		"count", result.Count,
+	)
+
+	return result, nil
+}
+
+// catalogParams แปลง request เป็น filter ของ repository
+func catalogParams(req *dto.CatalogExportRequest) (repositories.CatalogExportParams, error) {
+	params := repositories.CatalogExportParams{
+		MakerID:   parseOptionalUUID(req.MakerID),
+		CastID:    parseOptionalUUID(req.CastID),
+		TagID:     parseOptionalUUID(req.TagID),
+		Category:  strings.TrimSpace(req.Category),
+		SEOStatus: req.SEOStatus,
+	}
+
+	if req.Since != "" {
+		since, err := time.Parse(time.RFC3339, req.Since)
+		if err != nil {
+			return params, errors.New("invalid since")
+		}
+		params.UpdatedSince = &since
+	}
+	if req.HasReel != "" {
+		hasReel := req.HasReel == "true"
+		params.HasReel = &hasReel
+	}
+	for _, raw := range []string{req.MakerID, req.CastID, req.TagID} {
+		if raw != "" {
+			if _, err := uuid.Parse(raw); err != nil {
+				return params, errors.New("invalid filter ID")
+			}
+		}
+	}
+
+	return params, nil
+}
+
+func catalogItem(row *repositories.CatalogRow) *dto.CatalogItem {
+	item := &dto.CatalogItem{
+		ID:         row.ID.String(),
+		Code:       row.Code,
+		Titles:     map[string]string{},
+		Thumbnail:  row.Thumbnail,
+		EmbedURL:   row.EmbedURL,
+		Views:      row.Views,
+		Casts:      nonNilStrings(row.Casts),
+		Tags:       nonNilStrings(row.Tags),
+		Categories: nonNilStrings(row.Categories),
+		AutoTags:   nonNilStrings(row.AutoTags),
+		SEOStatus:  row.SEOStatus,
+		HasReel:    row.HasReel,
+		Reels:      row.Reels,
+		Articles:   map[string]string{},
+		CreatedAt:  row.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  row.UpdatedAt.Format(time.RFC3339Nano),
+		Deleted:    row.Deleted,
+	}
+	if row.MergedInto != nil {
+		item.MergedInto = row.MergedInto.String()
+	}
+	if row.ReleaseDate != nil {
+		item.ReleaseDate = row.ReleaseDate.Format("2006-01-02")
+	}
+	if row.MakerSlug != "" {
+		item.Maker = &dto.CatalogRef{Slug: row.MakerSlug, Name: row.MakerName}
+	}
+	// JSON มาจาก json_object_agg จึง parse ไม่ผ่านไม่ได้ ถ้าผิดก็ปล่อยเป็น map ว่าง
+	_ = json.Unmarshal([]byte(row.Titles), &item.Titles)
+	_ = json.Unmarshal([]byte(row.Articles), &item.Articles)
+	return item
+}
+
+func catalogCSVHeader() []string {
+	header := []string{"id", "code"}
+	for _, lang := range catalogLangs {
+		header = append(header, "title_"+lang)
+	}
+	header = append(header, "thumbnail", "embed_url", "release_date", "views", "maker", "casts", "tags", "categories", "auto_tags", "seo_status", "has_reel", "reels")
+	for _, lang := range catalogLangs {
+		header = append(header, "article_"+lang)
+	}
+	return append(header, "created_at", "updated_at", "deleted", "merged_into")
+}
+
+// catalogCSVRecord - list คั่นด้วย | (slug ไม่มี | อยู่แล้ว)
+func catalogCSVRecord(item *dto.CatalogItem) []string {
+	record := []string{item.ID, item.Code}
+	for _, lang := range catalogLangs {
+		record = append(record, item.Titles[lang])
+	}
+	maker := ""
+	if item.Maker != nil {
+		maker = item.Maker.Slug
+	}
+	record = append(record,
+		item.Thumbnail,
+		item.EmbedURL,
+		item.ReleaseDate,
+		strconv.Itoa(item.Views),
+		maker,
+		strings.Join(item.Casts, "|"),
+		strings.Join(item.Tags, "|"),
+		strings.Join(item.Categories, "|"),
+		strings.Join(item.AutoTags, "|"),
+		item.SEOStatus,
+		strconv.FormatBool(item.HasReel),
+		strconv.Itoa(item.Reels),
+	)
+	for _, lang := range catalogLangs {
+		record = append(record, item.Articles[lang])
+	}
+	return append(record, item.CreatedAt, item.UpdatedAt, strconv.FormatBool(item.Deleted), item.MergedInto)
+}
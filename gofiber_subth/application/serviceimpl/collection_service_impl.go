@@ -0,0 +1,361 @@
+package serviceimpl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gosimple/slug"
+	"gorm.io/gorm"
+
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/repositories"
+	"gofiber-template/domain/services"
+	"gofiber-template/pkg/logger"
+)
+
+type CollectionServiceImpl struct {
+	collectionRepo repositories.CollectionRepository
+}
+
+func NewCollectionService(collectionRepo repositories.CollectionRepository) services.CollectionService {
+	return &CollectionServiceImpl{
+		collectionRepo: collectionRepo,
+	}
+}
+
+func (s *CollectionServiceImpl) CreateCollection(ctx context.Context, req *dto.CreateCollectionRequest) (*dto.CollectionDetailResponse, error) {
+	if err := checkGroupTranslations(req.Translations); err != nil {
+		return nil, err
+	}
+
+	// ตรวจสอบว่ามี collection ชื่อ/slug นี้แล้วหรือไม่
+	collectionSlug := slug.Make(req.Name)
+	if existing, _ := s.collectionRepo.GetByName(ctx, req.Name); existing != nil {
+		logger.WarnContext(ctx, "Collection already exists", "name", req.Name)
+		return nil, errors.New("collection already exists")
+	}
+	if existing, _ := s.collectionRepo.GetBySlug(ctx, collectionSlug); existing != nil {
+		logger.WarnContext(ctx, "Collection slug already exists", "slug", collectionSlug)
+		return nil, errors.New("collection already exists")
+	}
+
+	collection := &models.Collection{
+		Name:        req.Name,
+		Slug:        collectionSlug,
+		Description: req.Description,
+		CoverImage:  req.CoverImage,
+		IsPublic:    req.IsPublic,
+		ShowOnHome:  req.ShowOnHome,
+	}
+	if err := s.collectionRepo.Create(ctx, collection); err != nil {
+		logger.ErrorContext(ctx, "Failed to create collection", "name", req.Name, "error", err)
+		return nil, err
+	}
+
+	if len(req.Translations) > 0 {
+		if err := s.collectionRepo.ReplaceTranslations(ctx, collection.ID, collectionTranslations(req.Translations)); err != nil {
+			logger.ErrorContext(ctx, "Failed to create collection translations", "collection_id", collection.ID, "error", err)
+			return nil, err
+		}
+	}
+
+	logger.InfoContext(ctx, "Collection created", "collection_id", collection.ID, "name", collection.Name)
+
+	return s.GetCollection(ctx, collection.ID, "en")
+}
+
+func (s *CollectionServiceImpl) GetCollection(ctx context.Context, id uuid.UUID, lang string) (*dto.CollectionDetailResponse, error) {
+	collection, err := s.getCollection(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toCollectionDetailResponse(collection, lang), nil
+}
+
+func (s *CollectionServiceImpl) GetCollectionBySlug(ctx context.Context, collectionSlug string, lang string) (*dto.CollectionDetailResponse, error) {
+	collection, err := s.getPublicCollection(ctx, collectionSlug)
+	if err != nil {
+		return nil, err
+	}
+	return toCollectionDetailResponse(collection, lang), nil
+}
+
+func (s *CollectionServiceImpl) UpdateCollection(ctx context.Context, id uuid.UUID, req *dto.UpdateCollectionRequest) (*dto.CollectionDetailResponse, error) {
+	if err := checkGroupTranslations(req.Translations); err != nil {
+		return nil, err
+	}
+
+	collection, err := s.getCollection(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update name ถ้ามีส่งมา (slug เปลี่ยนตาม)
+	if req.Name != nil && *req.Name != collection.Name {
+		collectionSlug := slug.Make(*req.Name)
+		if existing, _ := s.collectionRepo.GetByName(ctx, *req.Name); existing != nil && existing.ID != id {
+			logger.WarnContext(ctx, "Collection name already exists", "name", *req.Name)
+			return nil, errors.New("collection already exists")
+		}
+		if existing, _ := s.collectionRepo.GetBySlug(ctx, collectionSlug); existing != nil && existing.ID != id {
+			logger.WarnContext(ctx, "Collection slug already exists", "slug", collectionSlug)
+			return nil, errors.New("collection already exists")
+		}
+		collection.Name = *req.Name
+		collection.Slug = collectionSlug
+	}
+	if req.Description != nil {
+		collection.Description = *req.Description
+	}
+	if req.CoverImage != nil {
+		collection.CoverImage = *req.CoverImage
+	}
+	if req.IsPublic != nil {
+		collection.IsPublic = *req.IsPublic
+	}
+	if req.ShowOnHome != nil {
+		collection.ShowOnHome = *req.ShowOnHome
+	}
+
+	if err := s.collectionRepo.Update(ctx, collection); err != nil {
+		logger.ErrorContext(ctx, "Failed to update collection", "collection_id", id, "error", err)
+		return nil, err
+	}
+
+	// Update translations ถ้ามีส่งมา (แทนที่ทั้งหมด)
+	if req.Translations != nil {
+		if err := s.collectionRepo.ReplaceTranslations(ctx, id, collectionTranslations(req.Translations)); err != nil {
+			logger.ErrorContext(ctx, "Failed to replace collection translations", "collection_id", id, "error", err)
+			return nil, err
+		}
+	}
+
+	logger.InfoContext(ctx, "Collection updated", "collection_id", id)
+
+	return s.GetCollection(ctx, id, "en")
+}
+
+func (s *CollectionServiceImpl) DeleteCollection(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.getCollection(ctx, id); err != nil {
+		return err
+	}
+
+	// ลบเฉพาะ collection + สมาชิก, video ยังอยู่
+	if err := s.collectionRepo.Delete(ctx, id); err != nil {
+		logger.ErrorContext(ctx, "Failed to delete collection", "collection_id", id, "error", err)
+		return err
+	}
+
+	logger.InfoContext(ctx, "Collection deleted", "collection_id", id)
+	return nil
+}
+
+func (s *CollectionServiceImpl) ReorderCollections(ctx context.Context, req *dto.ReorderCollectionsRequest) error {
+	if err := s.collectionRepo.Reorder(ctx, req.CollectionIDs); err != nil {
+		logger.ErrorContext(ctx, "Failed to reorder collections", "error", err)
+		return err
+	}
+
+	logger.InfoContext(ctx, "Collections reordered", "count", len(req.CollectionIDs))
+	return nil
+}
+
+func (s *CollectionServiceImpl) ListCollections(ctx context.Context, req *dto.CollectionListRequest, publicOnly bool) ([]dto.CollectionResponse, int64, error) {
+	params := repositories.CollectionListParams{
+		Limit:      req.Limit,
+		Offset:     (req.Page - 1) * req.Limit,
+		Search:     req.Search,
+		PublicOnly: publicOnly,
+	}
+
+	collections, total, err := s.collectionRepo.List(ctx, params)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list collections", "error", err)
+		return nil, 0, err
+	}
+
+	result := make([]dto.CollectionResponse, 0, len(collections))
+	for i := range collections {
+		result = append(result, toCollectionResponse(&collections[i], req.Lang))
+	}
+	return result, total, nil
+}
+
+func (s *CollectionServiceImpl) ListCollectionVideos(ctx context.Context, id uuid.UUID, lang string, page int, limit int) ([]dto.VideoListItemResponse, int64, error) {
+	collection, err := s.getCollection(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	return s.listVideos(ctx, collection, lang, page, limit)
+}
+
+func (s *CollectionServiceImpl) ListCollectionVideosBySlug(ctx context.Context, collectionSlug string, lang string, page int, limit int) ([]dto.VideoListItemResponse, int64, error) {
+	collection, err := s.getPublicCollection(ctx, collectionSlug)
+	if err != nil {
+		return nil, 0, err
+	}
+	return s.listVideos(ctx, collection, lang, page, limit)
+}
+
+func (s *CollectionServiceImpl) SetCollectionVideos(ctx context.Context, id uuid.UUID, req *dto.SetGroupVideosRequest) (*dto.CollectionDetailResponse, error) {
+	if err := checkGroupVideoIDs(req.VideoIDs); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getCollection(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err := s.collectionRepo.SetVideos(ctx, id, req.VideoIDs); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("video not found")
+		}
+		logger.ErrorContext(ctx, "Failed to set collection videos", "collection_id", id, "error", err)
+		return nil, err
+	}
+
+	logger.InfoContext(ctx, "Collection videos updated", "collection_id", id, "videos", len(req.VideoIDs))
+
+	return s.GetCollection(ctx, id, "en")
+}
+
+func (s *CollectionServiceImpl) GetHomeSections(ctx context.Context, req *dto.CollectionsHomeRequest) ([]dto.CollectionWithVideosResponse, error) {
+	// Set defaults
+	limitPerCollection := req.LimitPerCollection
+	if limitPerCollection <= 0 {
+		limitPerCollection = 4
+	}
+	lang := req.Lang
+	if lang == "" {
+		lang = "th"
+	}
+
+	// collection ที่ public + show_on_home เรียงตาม sort_order (0 = ทั้งหมด)
+	collections, err := s.collectionRepo.ListHome(ctx, req.CollectionCount)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to get home collections", "error", err)
+		return nil, err
+	}
+
+	result := make([]dto.CollectionWithVideosResponse, 0, len(collections))
+	for i := range collections {
+		collection := &collections[i]
+		// Skip collections with no videos
+		if collection.VideoCount == 0 {
+			continue
+		}
+
+		videos, err := s.collectionRepo.ListVideos(ctx, collection.ID, limitPerCollection, 0)
+		if err != nil {
+			logger.WarnContext(ctx, "Failed to get videos for collection", "collection", collection.Slug, "error", err)
+			continue
+		}
+
+		result = append(result, dto.CollectionWithVideosResponse{
+			Collection: toCollectionResponse(collection, lang),
+			Videos:     toVideoListItemResponses(videos, lang),
+		})
+	}
+
+	logger.InfoContext(ctx, "Got home collections", "collections", len(result), "limit_per_collection", limitPerCollection)
+
+	return result, nil
+}
+
+// Helper functions
+
+func (s *CollectionServiceImpl) getCollection(ctx context.Context, id uuid.UUID) (*models.Collection, error) {
+	collection, err := s.collectionRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("collection not found")
+		}
+		logger.ErrorContext(ctx, "Failed to get collection", "collection_id", id, "error", err)
+		return nil, err
+	}
+	return collection, nil
+}
+
+// getPublicCollection - draft ตอบเหมือนไม่มีอยู่ (ไม่เปิดเผยว่ามี collection นี้)
+func (s *CollectionServiceImpl) getPublicCollection(ctx context.Context, collectionSlug string) (*models.Collection, error) {
+	collection, err := s.collectionRepo.GetBySlug(ctx, collectionSlug)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("collection not found")
+		}
+		logger.ErrorContext(ctx, "Failed to get collection by slug", "slug", collectionSlug, "error", err)
+		return nil, err
+	}
+	if !collection.IsPublic {
+		return nil, errors.New("collection not found")
+	}
+	return collection, nil
+}
+
+func (s *CollectionServiceImpl) listVideos(ctx context.Context, collection *models.Collection, lang string, page int, limit int) ([]dto.VideoListItemResponse, int64, error) {
+	offset := (page - 1) * limit
+	videos, err := s.collectionRepo.ListVideos(ctx, collection.ID, limit, offset)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to get collection videos", "collection_id", collection.ID, "error", err)
+		return nil, 0, err
+	}
+
+	return toVideoListItemResponses(videos, lang), int64(collection.VideoCount), nil
+}
+
+func translatedCollection(collection *models.Collection, lang string) (string, string) {
+	for _, t := range collection.Translations {
+		if t.Lang == lang {
+			return translatedGroupText(collection.Name, collection.Description, t.Name, t.Description)
+		}
+	}
+	return collection.Name, collection.Description
+}
+
+func collectionTranslations(in map[string]dto.GroupTranslation) []models.CollectionTranslation {
+	translations := make([]models.CollectionTranslation, 0, len(in))
+	for lang, t := range in {
+		translations = append(translations, models.CollectionTranslation{Lang: lang, Name: t.Name, Description: t.Description})
+	}
+	return translations
+}
+
+func toCollectionResponse(collection *models.Collection, lang string) dto.CollectionResponse {
+	name, description := translatedCollection(collection, lang)
+	return dto.CollectionResponse{
+		ID:          collection.ID,
+		Name:        name,
+		Slug:        collection.Slug,
+		Description: description,
+		CoverImage:  collection.CoverImage,
+		IsPublic:    collection.IsPublic,
+		ShowOnHome:  collection.ShowOnHome,
+		SortOrder:   collection.SortOrder,
+		VideoCount:  collection.VideoCount,
+	}
+}
+
+func toCollectionDetailResponse(collection *models.Collection, lang string) *dto.CollectionDetailResponse {
+	translations := make(map[string]dto.GroupTranslation, len(collection.Translations))
+	for _, t := range collection.Translations {
+		translations[t.Lang] = dto.GroupTranslation{Name: t.Name, Description: t.Description}
+	}
+
+	name, description := translatedCollection(collection, lang)
+	return &dto.CollectionDetailResponse{
+		ID:           collection.ID,
+		Name:         name,
+		Slug:         collection.Slug,
+		Description:  description,
+		CoverImage:   collection.CoverImage,
+		IsPublic:     collection.IsPublic,
+		ShowOnHome:   collection.ShowOnHome,
+		SortOrder:    collection.SortOrder,
+		VideoCount:   collection.VideoCount,
+		Translations: translations,
+		CreatedAt:    collection.CreatedAt,
+		UpdatedAt:    collection.UpdatedAt,
+	}
+}
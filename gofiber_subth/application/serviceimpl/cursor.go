@@ -0,0 +1,47 @@
+package serviceimpl
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"gofiber-template/domain/repositories"
+	"gofiber-template/pkg/utils"
+)
+
+// sort ที่ฝังใน cursor (endpoint ที่เรียงแบบเดียวกันใช้ค่าเดียวกัน)
+// feed และ reels เรียง reel ใหม่ก่อนเหมือนกัน จึงใช้ cursor ร่วมกันได้
+const (
+	feedCursorSort     = "reels:created_at:desc"
+	commentCursorSort  = "comments:created_at:desc"
+	activityCursorSort = "activity:created_at:desc"
+)
+
+// keysetFromCursor แปลง cursor จาก client เป็น keyset ของ repository (ว่าง = หน้าแรก)
+func keysetFromCursor(cursor, sort string) (*repositories.Keyset, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	decoded, err := utils.DecodeCursor(cursor, sort)
+	if err != nil {
+		return nil, err
+	}
+
+	keyset := &repositories.Keyset{ID: decoded.ID}
+	if decoded.Time != nil {
+		keyset.Value = *decoded.Time
+	} else {
+		keyset.Value = *decoded.Int
+	}
+	return keyset, nil
+}
+
+// timeCursor / intCursor สร้าง cursor ของหน้าถัดไปจากแถวสุดท้ายของหน้านี้
+func timeCursor(sort string, value time.Time, id uuid.UUID) string {
+	return utils.EncodeCursor(utils.Cursor{Sort: sort, Time: &value, ID: id})
+}
+
+func intCursor(sort string, value int64, id uuid.UUID) string {
+	return utils.EncodeCursor(utils.Cursor{Sort: sort, Int: &value, ID: id})
+}
@@ -5,9 +5,11 @@ import (
 
 	"github.com/google/uuid"
 	"gofiber-template/domain/dto"
+	"gofiber-template/domain/models"
 	"gofiber-template/domain/repositories"
 	"gofiber-template/domain/services"
 	"gofiber-template/pkg/logger"
+	"gofiber-template/pkg/utils"
 )
 
 type FeedServiceImpl struct {
@@ -38,6 +40,70 @@ func (s *FeedServiceImpl) GetFeed(ctx context.Context, page int, limit int, lang
 		return nil, 0, err
 	}
 
+	return s.toFeedItems(ctx, reels, lang, userID), total, nil
+}
+
+// GetReels returns reels for the reels page (video player)
+func (s *FeedServiceImpl) GetReels(ctx context.Context, page int, limit int, lang string, userID *uuid.UUID) ([]dto.ReelItemResponse, int64, error) {
+	offset := (page - 1) * limit
+
+	reels, total, err := s.reelRepo.ListWithVideo(ctx, limit, offset, true)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to get reels", "error", err)
+		return nil, 0, err
+	}
+
+	return s.toReelItems(ctx, reels, lang, userID), total, nil
+}
+
+// GetFeedAfter - GetFeed แบบ cursor สำหรับ infinite scroll (คืน nextCursor ว่างเมื่อหมดแล้ว)
+func (s *FeedServiceImpl) GetFeedAfter(ctx context.Context, cursor string, limit int, lang string, userID *uuid.UUID) ([]dto.FeedItemResponse, string, error) {
+	reels, nextCursor, err := s.listReelsAfter(ctx, cursor, limit)
+	if err != nil {
+		if err != utils.ErrInvalidCursor {
+			logger.ErrorContext(ctx, "Failed to get feed", "error", err)
+		}
+		return nil, "", err
+	}
+
+	return s.toFeedItems(ctx, reels, lang, userID), nextCursor, nil
+}
+
+// GetReelsAfter - GetReels แบบ cursor สำหรับ infinite scroll (คืน nextCursor ว่างเมื่อหมดแล้ว)
+func (s *FeedServiceImpl) GetReelsAfter(ctx context.Context, cursor string, limit int, lang string, userID *uuid.UUID) ([]dto.ReelItemResponse, string, error) {
+	reels, nextCursor, err := s.listReelsAfter(ctx, cursor, limit)
+	if err != nil {
+		if err != utils.ErrInvalidCursor {
+			logger.ErrorContext(ctx, "Failed to get reels", "error", err)
+		}
+		return nil, "", err
+	}
+
+	return s.toReelItems(ctx, reels, lang, userID), nextCursor, nil
+}
+
+// listReelsAfter ดึงเกิน limit 1 แถวเพื่อรู้ว่ายังมีหน้าถัดไปหรือไม่
+func (s *FeedServiceImpl) listReelsAfter(ctx context.Context, cursor string, limit int) ([]models.Reel, string, error) {
+	after, err := keysetFromCursor(cursor, feedCursorSort)
+	if err != nil {
+		return nil, "", err
+	}
+
+	reels, err := s.reelRepo.ListWithVideoAfter(ctx, limit+1, after, true)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextCursor := ""
+	if len(reels) > limit {
+		reels = reels[:limit]
+		last := reels[limit-1]
+		nextCursor = timeCursor(feedCursorSort, last.CreatedAt, last.ID)
+	}
+	return reels, nextCursor, nil
+}
+
+func (s *FeedServiceImpl) toFeedItems(ctx context.Context, reels []models.Reel, lang string, userID *uuid.UUID) []dto.FeedItemResponse {
 	// Batch check like status if user is authenticated
 	var likedMap map[uuid.UUID]bool
 	if userID != nil {
@@ -104,19 +170,10 @@ func (s *FeedServiceImpl) GetFeed(ctx context.Context, page int, limit int, lang
 		})
 	}
 
-	return items, total, nil
+	return items
 }
 
-// GetReels returns reels for the reels page (video player)
-func (s *FeedServiceImpl) GetReels(ctx context.Context, page int, limit int, lang string, userID *uuid.UUID) ([]dto.ReelItemResponse, int64, error) {
-	offset := (page - 1) * limit
-
-	reels, total, err := s.reelRepo.ListWithVideo(ctx, limit, offset, true)
-	if err != nil {
-		logger.ErrorContext(ctx, "Failed to get reels", "error", err)
-		return nil, 0, err
-	}
-
+func (s *FeedServiceImpl) toReelItems(ctx context.Context, reels []models.Reel, lang string, userID *uuid.UUID) []dto.ReelItemResponse {
 	// Batch check like status if user is authenticated
 	var likedMap map[uuid.UUID]bool
 	if userID != nil {
@@ -184,5 +241,5 @@ func (s *FeedServiceImpl) GetReels(ctx context.Context, page int, limit int, lan
 		})
 	}
 
-	return items, total, nil
+	return items
 }
@@ -0,0 +1,284 @@
+package serviceimpl
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/ports"
+	"gofiber-template/pkg/seo"
+)
+
+// ========================================
+// Schema.org JSON-LD builder
+// ========================================
+// review (worker)        -> Review + itemReviewed VideoObject (reviewRating + aggregateRating)
+// มี rankingItems        -> Article + ItemList (ลิงก์ไปหน้ารีวิวของแต่ละ video)
+// guide / news / อื่นๆ    -> Article (+ VideoObject ถ้าผูกกับ video)
+// หน้า video             -> VideoObject
+// หน้า cast              -> Person
+
+// jsonldBuilder - สร้าง JSON-LD จาก model (ใช้ร่วมกันระหว่าง article/video/cast service)
+type jsonldBuilder struct {
+	siteURL string
+	storage ports.Storage // แปลง thumbnail path ของ video เป็น URL (nil = ใช้ path ตามเดิม)
+}
+
+func newJSONLDBuilder(siteURL string, storage ports.Storage) jsonldBuilder {
+	return jsonldBuilder{siteURL: siteURL, storage: storage}
+}
+
+// jsonldContent - ส่วนของ article content ที่ใช้สร้าง JSON-LD
+type jsonldContent struct {
+	ThumbnailUrl string `json:"thumbnailUrl"`
+	Facts        struct {
+		DurationMinutes int      `json:"durationMinutes"`
+		Genre           []string `json:"genre"`
+	} `json:"facts"`
+	RankingItems []dto.GeneratedRankingItem `json:"rankingItems"`
+}
+
+func parseJSONLDContent(content []byte) jsonldContent {
+	var data jsonldContent
+	if len(content) > 0 {
+		_ = json.Unmarshal(content, &data)
+	}
+	return data
+}
+
+// ArticleGraph - JSON-LD ของหน้าบทความ (video อาจเป็น nil)
+func (b jsonldBuilder) ArticleGraph(article *models.Article, video *models.Video) *seo.JSONLDGraph {
+	pageURL := seo.ArticleURL(b.siteURL, article.Language, string(article.Type), article.Slug)
+	content := parseJSONLDContent(article.Content)
+	site := seo.SiteOrganization(b.siteURL)
+
+	rating := extractRatingFromContent(article.Content)
+
+	published := seo.ISODate(article.PublishedAt)
+	modified := seo.ISODate(&article.UpdatedAt)
+	if published == "" {
+		published = seo.ISODate(&article.CreatedAt)
+	}
+
+	var videoObject *seo.JSONLDVideoObject
+	if video != nil && article.Origin != models.ArticleOriginGenerator {
+		videoObject = b.videoObject(video, article.Language, article.MetaDescription, content)
+		if rating > 0 {
+			videoObject.AggregateRating = seo.NewAggregateRating(rating, 1)
+		}
+	}
+
+	// รีวิว video เดียว -> Review
+	if article.Type == models.ArticleTypeReview && videoObject != nil {
+		review := &seo.JSONLDReview{
+			Type:          "Review",
+			ID:            pageURL + "#review",
+			Name:          article.Title,
+			URL:           pageURL,
+			Description:   article.MetaDescription,
+			InLanguage:    article.Language,
+			DatePublished: published,
+			DateModified:  modified,
+			Author:        site,
+			Publisher:     site,
+			ItemReviewed:  videoObject,
+		}
+		if rating > 0 {
+			review.ReviewRating = seo.NewRating(rating)
+		}
+		return seo.NewJSONLDGraph(review)
+	}
+
+	node := &seo.JSONLDArticle{
+		Type:          "Article",
+		ID:            pageURL + "#article",
+		Headline:      article.Title,
+		URL:           pageURL,
+		Description:   article.MetaDescription,
+		Image:         content.ThumbnailUrl,
+		InLanguage:    article.Language,
+		DatePublished: published,
+		DateModified:  modified,
+		Author:        site,
+		Publisher:     site,
+	}
+
+	// รายการ video (ranking / best-of / guide จาก generator)
+	if len(content.RankingItems) > 0 {
+		list := b.itemList(article, pageURL, content.RankingItems)
+		node.MainEntity = &seo.JSONLDRef{ID: list.ID}
+		return seo.NewJSONLDGraph(node, list)
+	}
+
+	if videoObject != nil {
+		return seo.NewJSONLDGraph(node, videoObject)
+	}
+	return seo.NewJSONLDGraph(node)
+}
+
+// VideoGraph - JSON-LD ของหน้า video
+// review = บทความรีวิวที่ published ของภาษานี้ (nil = ไม่มี rating/description)
+func (b jsonldBuilder) VideoGraph(video *models.Video, lang string, review *models.Article) *seo.JSONLDGraph {
+	var content jsonldContent
+	description := ""
+	if review != nil {
+		content = parseJSONLDContent(review.Content)
+		description = review.MetaDescription
+	}
+
+	videoObject := b.videoObject(video, lang, description, content)
+	videoObject.ID = seo.PageURL(b.siteURL, seo.VideoPath(lang, video.ID.String())) + "#video"
+	videoObject.URL = seo.PageURL(b.siteURL, seo.VideoPath(lang, video.ID.String()))
+	if review != nil {
+		if rating := extractRatingFromContent(review.Content); rating > 0 {
+			videoObject.AggregateRating = seo.NewAggregateRating(rating, 1)
+		}
+	}
+	return seo.NewJSONLDGraph(videoObject)
+}
+
+// CastGraph - JSON-LD ของหน้า cast
+func (b jsonldBuilder) CastGraph(cast *models.Cast, lang string) *seo.JSONLDGraph {
+	person := b.castPerson(*cast, lang)
+	return seo.NewJSONLDGraph(&person)
+}
+
+func (b jsonldBuilder) videoObject(video *models.Video, lang, description string, content jsonldContent) *seo.JSONLDVideoObject {
+	name := localizedVideoTitle(video, lang)
+	if video.Code != "" && !strings.Contains(name, video.Code) {
+		name = strings.TrimSpace(video.Code + " " + name)
+	}
+
+	thumbnail := content.ThumbnailUrl
+	if thumbnail == "" {
+		thumbnail = b.assetURL(video.Thumbnail)
+	}
+
+	uploadDate := seo.ISODate(video.ReleaseDate)
+	if uploadDate == "" {
+		uploadDate = seo.ISODate(&video.CreatedAt)
+	}
+
+	genre := content.Facts.Genre
+	if len(genre) == 0 {
+		for _, t := range video.Tags {
+			genre = append(genre, localizedTagName(t, lang))
+		}
+	}
+
+	obj := &seo.JSONLDVideoObject{
+		Type:         "VideoObject",
+		Name:         name,
+		Description:  description,
+		ThumbnailURL: thumbnail,
+		UploadDate:   uploadDate,
+		Duration:     seo.ISODuration(content.Facts.DurationMinutes),
+		EmbedURL:     video.EmbedURL,
+		InLanguage:   lang,
+		Genre:        genre,
+	}
+	if obj.Description == "" {
+		obj.Description = name
+	}
+	for _, c := range video.Casts {
+		obj.Actor = append(obj.Actor, b.castPerson(c, lang))
+	}
+	if video.Maker != nil {
+		obj.ProductionCompany = seo.NewOrganization(video.Maker.Name, seo.PageURL(b.siteURL, seo.MakerPath(lang, video.Maker.Slug)))
+	}
+	return obj
+}
+
+func (b jsonldBuilder) itemList(article *models.Article, pageURL string, items []dto.GeneratedRankingItem) *seo.JSONLDItemList {
+	list := &seo.JSONLDItemList{
+		Type:            "ItemList",
+		ID:              pageURL + "#list",
+		Name:            article.Title,
+		URL:             pageURL,
+		Description:     article.MetaDescription,
+		NumberOfItems:   len(items),
+		ItemListOrder:   "https://schema.org/ItemListOrderDescending",
+		ItemListElement: make([]seo.JSONLDListItem, 0, len(items)),
+	}
+	for i, item := range items {
+		position := item.Position
+		if position <= 0 {
+			position = i + 1
+		}
+		entry := seo.JSONLDListItem{
+			Type:     "ListItem",
+			Position: position,
+			Name:     strings.TrimSpace(fmt.Sprintf("%s %s", item.Code, item.Title)),
+			Image:    item.ThumbnailUrl,
+		}
+		if item.ReviewSlug != "" {
+			entry.URL = seo.ArticleURL(b.siteURL, article.Language, string(models.ArticleTypeReview), item.ReviewSlug)
+		}
+		list.ItemListElement = append(list.ItemListElement, entry)
+	}
+	return list
+}
+
+// castPerson - Person ของ cast (@id = หน้า cast ของภาษานั้น, alternateName = ชื่อภาษาอื่น)
+func (b jsonldBuilder) castPerson(cast models.Cast, lang string) seo.JSONLDPerson {
+	name := cast.Name
+	for _, t := range cast.Translations {
+		if t.Lang == lang && t.Name != "" {
+			name = t.Name
+		}
+	}
+
+	var alternates []string
+	seen := map[string]bool{name: true}
+	for _, n := range append([]string{cast.Name}, castTranslationNames(cast)...) {
+		if n == "" || seen[n] {
+			continue
+		}
+		seen[n] = true
+		alternates = append(alternates, n)
+	}
+
+	pageURL := seo.PageURL(b.siteURL, seo.CastPath(lang, cast.Slug))
+	return seo.NewPerson(pageURL+"#person", name, pageURL, alternates)
+}
+
+// assetURL - thumbnail ของ video เก็บเป็น path ใน storage
+func (b jsonldBuilder) assetURL(path string) string {
+	if path == "" || strings.HasPrefix(path, "http") || b.storage == nil {
+		return path
+	}
+	return b.storage.GetURL(path)
+}
+
+func castTranslationNames(cast models.Cast) []string {
+	names := make([]string, 0, len(cast.Translations))
+	for _, t := range cast.Translations {
+		names = append(names, t.Name)
+	}
+	return names
+}
+
+// localizedVideoTitle - ชื่อ video ตามภาษา (fallback en แล้วภาษาแรกที่มี)
+func localizedVideoTitle(video *models.Video, lang string) string {
+	fallback := ""
+	for _, t := range video.Translations {
+		if t.Lang == lang {
+			return t.Title
+		}
+		if t.Lang == "en" || fallback == "" {
+			fallback = t.Title
+		}
+	}
+	return fallback
+}
+
+func localizedTagName(tag models.Tag, lang string) string {
+	for _, t := range tag.Translations {
+		if t.Lang == lang && t.Name != "" {
+			return t.Name
+		}
+	}
+	return tag.Name
+}
@@ -141,6 +141,29 @@ func (s *reelCommentServiceImpl) ListByReel(ctx context.Context, reelID uuid.UUI
 	return dto.ToCommentResponseList(comments), total, nil
 }
 
+func (s *reelCommentServiceImpl) ListByReelAfter(ctx context.Context, reelID uuid.UUID, cursor string, limit int) ([]dto.CommentResponse, string, error) {
+	after, err := keysetFromCursor(cursor, commentCursorSort)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// ดึงเกิน 1 แถวเพื่อรู้ว่ามีหน้าถัดไปหรือไม่
+	comments, err := s.commentRepo.ListByReelAfter(ctx, reelID, limit+1, after)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list comments", "error", err)
+		return nil, "", err
+	}
+
+	nextCursor := ""
+	if len(comments) > limit {
+		comments = comments[:limit]
+		last := comments[limit-1]
+		nextCursor = timeCursor(commentCursorSort, last.CreatedAt, last.ID)
+	}
+
+	return dto.ToCommentResponseList(comments), nextCursor, nil
+}
+
 func (s *reelCommentServiceImpl) ListReplies(ctx context.Context, parentID uuid.UUID, page, limit int) ([]dto.CommentResponse, int64, error) {
 	offset := (page - 1) * limit
 	replies, total, err := s.commentRepo.ListReplies(ctx, parentID, limit, offset)
@@ -108,7 +108,7 @@ func (s *ReelServiceImpl) Delete(ctx context.Context, id uuid.UUID) error {
 		return err
 	}
 
-	logger.InfoContext(ctx, "Reel deleted", "reel_id", id)
+	logger.InfoContext(ctx, "Reel moved to trash", "reel_id", id)
 	return nil
 }
 
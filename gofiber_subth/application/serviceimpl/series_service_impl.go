@@ -0,0 +1,343 @@
+package serviceimpl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/gosimple/slug"
+	"gorm.io/gorm"
+
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/repositories"
+	"gofiber-template/domain/services"
+	"gofiber-template/pkg/logger"
+)
+
+type SeriesServiceImpl struct {
+	seriesRepo repositories.SeriesRepository
+}
+
+func NewSeriesService(seriesRepo repositories.SeriesRepository) services.SeriesService {
+	return &SeriesServiceImpl{
+		seriesRepo: seriesRepo,
+	}
+}
+
+func (s *SeriesServiceImpl) CreateSeries(ctx context.Context, req *dto.CreateSeriesRequest) (*dto.SeriesDetailResponse, error) {
+	if err := checkGroupTranslations(req.Translations); err != nil {
+		return nil, err
+	}
+
+	// ตรวจสอบว่ามี series ชื่อ/slug นี้แล้วหรือไม่
+	seriesSlug := slug.Make(req.Name)
+	if existing, _ := s.seriesRepo.GetByName(ctx, req.Name); existing != nil {
+		logger.WarnContext(ctx, "Series already exists", "name", req.Name)
+		return nil, errors.New("series already exists")
+	}
+	if existing, _ := s.seriesRepo.GetBySlug(ctx, seriesSlug); existing != nil {
+		logger.WarnContext(ctx, "Series slug already exists", "slug", seriesSlug)
+		return nil, errors.New("series already exists")
+	}
+
+	series := &models.Series{
+		Name:        req.Name,
+		Slug:        seriesSlug,
+		Description: req.Description,
+		CoverImage:  req.CoverImage,
+	}
+	if err := s.seriesRepo.Create(ctx, series); err != nil {
+		logger.ErrorContext(ctx, "Failed to create series", "name", req.Name, "error", err)
+		return nil, err
+	}
+
+	if len(req.Translations) > 0 {
+		if err := s.seriesRepo.ReplaceTranslations(ctx, series.ID, seriesTranslations(req.Translations)); err != nil {
+			logger.ErrorContext(ctx, "Failed to create series translations", "series_id", series.ID, "error", err)
+			return nil, err
+		}
+	}
+
+	logger.InfoContext(ctx, "Series created", "series_id", series.ID, "name", series.Name)
+
+	return s.GetSeries(ctx, series.ID, "en")
+}
+
+func (s *SeriesServiceImpl) GetSeries(ctx context.Context, id uuid.UUID, lang string) (*dto.SeriesDetailResponse, error) {
+	series, err := s.seriesRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("series not found")
+		}
+		logger.ErrorContext(ctx, "Failed to get series", "series_id", id, "error", err)
+		return nil, err
+	}
+	return s.toSeriesDetailResponse(ctx, series, lang)
+}
+
+func (s *SeriesServiceImpl) GetSeriesBySlug(ctx context.Context, seriesSlug string, lang string) (*dto.SeriesDetailResponse, error) {
+	series, err := s.seriesRepo.GetBySlug(ctx, seriesSlug)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("series not found")
+		}
+		logger.ErrorContext(ctx, "Failed to get series by slug", "slug", seriesSlug, "error", err)
+		return nil, err
+	}
+	return s.toSeriesDetailResponse(ctx, series, lang)
+}
+
+func (s *SeriesServiceImpl) UpdateSeries(ctx context.Context, id uuid.UUID, req *dto.UpdateSeriesRequest) (*dto.SeriesDetailResponse, error) {
+	if err := checkGroupTranslations(req.Translations); err != nil {
+		return nil, err
+	}
+
+	series, err := s.seriesRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("series not found")
+		}
+		logger.ErrorContext(ctx, "Failed to get series for update", "series_id", id, "error", err)
+		return nil, err
+	}
+
+	// Update name ถ้ามีส่งมา (slug เปลี่ยนตาม)
+	if req.Name != nil && *req.Name != series.Name {
+		seriesSlug := slug.Make(*req.Name)
+		if existing, _ := s.seriesRepo.GetByName(ctx, *req.Name); existing != nil && existing.ID != id {
+			logger.WarnContext(ctx, "Series name already exists", "name", *req.Name)
+			return nil, errors.New("series already exists")
+		}
+		if existing, _ := s.seriesRepo.GetBySlug(ctx, seriesSlug); existing != nil && existing.ID != id {
+			logger.WarnContext(ctx, "Series slug already exists", "slug", seriesSlug)
+			return nil, errors.New("series already exists")
+		}
+		series.Name = *req.Name
+		series.Slug = seriesSlug
+	}
+	if req.Description != nil {
+		series.Description = *req.Description
+	}
+	if req.CoverImage != nil {
+		series.CoverImage = *req.CoverImage
+	}
+
+	if err := s.seriesRepo.Update(ctx, series); err != nil {
+		logger.ErrorContext(ctx, "Failed to update series", "series_id", id, "error", err)
+		return nil, err
+	}
+
+	// Update translations ถ้ามีส่งมา (แทนที่ทั้งหมด)
+	if req.Translations != nil {
+		if err := s.seriesRepo.ReplaceTranslations(ctx, id, seriesTranslations(req.Translations)); err != nil {
+			logger.ErrorContext(ctx, "Failed to replace series translations", "series_id", id, "error", err)
+			return nil, err
+		}
+	}
+
+	logger.InfoContext(ctx, "Series updated", "series_id", id)
+
+	return s.GetSeries(ctx, id, "en")
+}
+
+func (s *SeriesServiceImpl) DeleteSeries(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.seriesRepo.GetByID(ctx, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("series not found")
+		}
+		logger.ErrorContext(ctx, "Failed to get series for delete", "series_id", id, "error", err)
+		return err
+	}
+
+	// ลบเฉพาะ series + สมาชิก, video ยังอยู่
+	if err := s.seriesRepo.Delete(ctx, id); err != nil {
+		logger.ErrorContext(ctx, "Failed to delete series", "series_id", id, "error", err)
+		return err
+	}
+
+	logger.InfoContext(ctx, "Series deleted", "series_id", id)
+	return nil
+}
+
+func (s *SeriesServiceImpl) ListSeries(ctx context.Context, req *dto.SeriesListRequest) ([]dto.SeriesResponse, int64, error) {
+	params := repositories.SeriesListParams{
+		Limit:  req.Limit,
+		Offset: (req.Page - 1) * req.Limit,
+		Search: req.Search,
+	}
+
+	seriesList, total, err := s.seriesRepo.List(ctx, params)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list series", "error", err)
+		return nil, 0, err
+	}
+
+	result := make([]dto.SeriesResponse, 0, len(seriesList))
+	for _, series := range seriesList {
+		name, description := translatedSeries(&series, req.Lang)
+		result = append(result, dto.SeriesResponse{
+			ID:          series.ID,
+			Name:        name,
+			Slug:        series.Slug,
+			Description: description,
+			CoverImage:  series.CoverImage,
+			VideoCount:  series.VideoCount,
+		})
+	}
+	return result, total, nil
+}
+
+func (s *SeriesServiceImpl) SetSeriesVideos(ctx context.Context, id uuid.UUID, req *dto.SetGroupVideosRequest) (*dto.SeriesDetailResponse, error) {
+	if err := checkGroupVideoIDs(req.VideoIDs); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.seriesRepo.GetByID(ctx, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("series not found")
+		}
+		logger.ErrorContext(ctx, "Failed to get series for set videos", "series_id", id, "error", err)
+		return nil, err
+	}
+
+	// video อยู่ได้ series เดียว - ต้องเอาออกจาก series เดิมก่อน
+	current, err := s.seriesRepo.GetSeriesIDsByVideoIDs(ctx, req.VideoIDs)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to get series of videos", "series_id", id, "error", err)
+		return nil, err
+	}
+	for videoID, seriesID := range current {
+		if seriesID != id {
+			logger.WarnContext(ctx, "Video already in another series", "video_id", videoID, "series_id", seriesID)
+			return nil, errors.New("video already in another series")
+		}
+	}
+
+	if err := s.seriesRepo.SetVideos(ctx, id, req.VideoIDs); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("video not found")
+		}
+		logger.ErrorContext(ctx, "Failed to set series videos", "series_id", id, "error", err)
+		return nil, err
+	}
+
+	logger.InfoContext(ctx, "Series videos updated", "series_id", id, "videos", len(req.VideoIDs))
+
+	return s.GetSeries(ctx, id, "en")
+}
+
+// Helper functions
+
+func (s *SeriesServiceImpl) toSeriesDetailResponse(ctx context.Context, series *models.Series, lang string) (*dto.SeriesDetailResponse, error) {
+	videos, err := s.seriesRepo.ListVideos(ctx, series.ID)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to get series videos", "series_id", series.ID, "error", err)
+		return nil, err
+	}
+
+	translations := make(map[string]dto.GroupTranslation, len(series.Translations))
+	for _, t := range series.Translations {
+		translations[t.Lang] = dto.GroupTranslation{Name: t.Name, Description: t.Description}
+	}
+
+	name, description := translatedSeries(series, lang)
+	return &dto.SeriesDetailResponse{
+		ID:           series.ID,
+		Name:         name,
+		Slug:         series.Slug,
+		Description:  description,
+		CoverImage:   series.CoverImage,
+		VideoCount:   series.VideoCount,
+		Translations: translations,
+		Videos:       toVideoListItemResponses(videos, lang),
+		CreatedAt:    series.CreatedAt,
+		UpdatedAt:    series.UpdatedAt,
+	}, nil
+}
+
+// videoSeries - series ของ video พร้อมตอนอื่น ๆ สำหรับหน้า video detail (nil ถ้าไม่อยู่ใน series)
+func videoSeries(ctx context.Context, seriesRepo repositories.SeriesRepository, videoID uuid.UUID, lang string) *dto.VideoSeriesResponse {
+	series, err := seriesRepo.GetByVideoID(ctx, videoID)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.WarnContext(ctx, "Failed to get series of video", "video_id", videoID, "error", err)
+		}
+		return nil
+	}
+
+	videos, err := seriesRepo.ListVideos(ctx, series.ID)
+	if err != nil {
+		logger.WarnContext(ctx, "Failed to get series videos", "series_id", series.ID, "error", err)
+		return nil
+	}
+
+	// ตอนที่นับจาก video ที่แสดงได้จริง (ตอนที่อยู่ในถังขยะไม่นับ)
+	position := 0
+	others := make([]models.Video, 0, len(videos))
+	for i, v := range videos {
+		if v.ID == videoID {
+			position = i + 1
+			continue
+		}
+		others = append(others, v)
+	}
+
+	name, _ := translatedSeries(series, lang)
+	return &dto.VideoSeriesResponse{
+		ID:       series.ID,
+		Name:     name,
+		Slug:     series.Slug,
+		Position: position,
+		Total:    len(videos),
+		Episodes: toVideoListItemResponses(others, lang),
+	}
+}
+
+func translatedSeries(series *models.Series, lang string) (string, string) {
+	for _, t := range series.Translations {
+		if t.Lang == lang {
+			return translatedGroupText(series.Name, series.Description, t.Name, t.Description)
+		}
+	}
+	return series.Name, series.Description
+}
+
+func seriesTranslations(in map[string]dto.GroupTranslation) []models.SeriesTranslation {
+	translations := make([]models.SeriesTranslation, 0, len(in))
+	for lang, t := range in {
+		translations = append(translations, models.SeriesTranslation{Lang: lang, Name: t.Name, Description: t.Description})
+	}
+	return translations
+}
+
+// translatedGroupText - ใช้ค่าที่แปลแล้ว, ช่องที่ยังไม่ได้แปลใช้ค่าหลัก
+func translatedGroupText(name, description, translatedName, translatedDescription string) (string, string) {
+	if translatedName != "" {
+		name = translatedName
+	}
+	if translatedDescription != "" {
+		description = translatedDescription
+	}
+	return name, description
+}
+
+func checkGroupTranslations(translations map[string]dto.GroupTranslation) error {
+	for lang := range translations {
+		if !translationLangs[lang] {
+			return errors.New("invalid language")
+		}
+	}
+	return nil
+}
+
+func checkGroupVideoIDs(videoIDs []uuid.UUID) error {
+	seen := make(map[uuid.UUID]bool, len(videoIDs))
+	for _, id := range videoIDs {
+		if seen[id] {
+			return errors.New("duplicate video")
+		}
+		seen[id] = true
+	}
+	return nil
+}
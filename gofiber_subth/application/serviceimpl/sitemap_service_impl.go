@@ -0,0 +1,434 @@
+package serviceimpl
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/ports"
+	"gofiber-template/domain/repositories"
+	"gofiber-template/domain/services"
+	"gofiber-template/infrastructure/redis"
+	"gofiber-template/pkg/cache"
+	"gofiber-template/pkg/logger"
+	"gofiber-template/pkg/seo"
+)
+
+const (
+	// SitemapIndexName - ชื่อไฟล์ sitemap index
+	SitemapIndexName = "index.xml"
+
+	sitemapStoragePrefix = "sitemaps/"
+	sitemapContentType   = "application/xml; charset=utf-8"
+	sitemapArticleBatch  = 5000
+)
+
+// sitemapLanguages - ทุกภาษาที่มีหน้าเว็บ (ลำดับ = ลำดับ hreflang)
+var sitemapLanguages = []string{"th", "en"}
+
+// sitemapStaticPages - หน้าคงที่ของ frontend (ไม่รวม prefix ภาษา)
+var sitemapStaticPages = []struct {
+	path       string
+	changeFreq string
+}{
+	{"", "daily"},
+	{"/articles", "daily"},
+	{"/casts", "weekly"},
+	{"/tags", "weekly"},
+	{"/makers", "weekly"},
+	{"/about", "monthly"},
+	{"/contact", "monthly"},
+	{"/privacy-policy", "yearly"},
+	{"/terms-of-service", "yearly"},
+}
+
+// sitemapNamePattern - index.xml, static.xml, articles-1.xml, ...
+var sitemapNamePattern = regexp.MustCompile(`^[a-z]+(-[0-9]+)?\.xml$`)
+
+type SitemapServiceImpl struct {
+	sitemapRepo repositories.SitemapRepository
+	storage     ports.Storage
+	cache       *redis.RedisClient
+	siteURL     string
+	baseURL     string // URL prefix ของไฟล์ sitemap ที่อ้างใน index
+}
+
+func NewSitemapService(
+	sitemapRepo repositories.SitemapRepository,
+	storage ports.Storage,
+	cache *redis.RedisClient,
+	siteURL string,
+	baseURL string,
+) services.SitemapService {
+	siteURL = strings.TrimRight(siteURL, "/")
+	if baseURL == "" {
+		baseURL = siteURL + "/sitemap"
+	}
+	return &SitemapServiceImpl{
+		sitemapRepo: sitemapRepo,
+		storage:     storage,
+		cache:       cache,
+		siteURL:     siteURL,
+		baseURL:     strings.TrimRight(baseURL, "/"),
+	}
+}
+
+// sitemapFile - ไฟล์ที่ render แล้วในรอบ build
+type sitemapFile struct {
+	name     string
+	urlCount int
+	lastMod  string // lastmod ล่าสุดของ URL ในไฟล์ (ว่าง = ไม่มีข้อมูล)
+	data     []byte
+}
+
+// Rebuild สร้าง sitemap ทั้งชุด -> อัปโหลด storage -> เก็บ cache
+// อัปโหลดหรือ cache ไม่สำเร็จแค่ log ไว้ ไฟล์ที่เหลือยังใช้งานได้
+func (s *SitemapServiceImpl) Rebuild(ctx context.Context) (*dto.SitemapBuildResponse, error) {
+	files, err := s.build(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.SitemapBuildResponse{
+		Index:       s.fileURL(SitemapIndexName),
+		Files:       make([]dto.SitemapFile, 0, len(files)),
+		GeneratedAt: time.Now(),
+	}
+
+	for _, f := range files {
+		item := dto.SitemapFile{Name: f.name, URLCount: f.urlCount}
+
+		if s.storage != nil {
+			storageURL, err := s.storage.Upload(ctx, sitemapStoragePrefix+f.name, bytes.NewReader(f.data), sitemapContentType)
+			if err != nil {
+				logger.WarnContext(ctx, "Failed to upload sitemap", "name", f.name, "error", err)
+			} else {
+				item.StorageURL = storageURL
+			}
+		}
+
+		if s.cache != nil {
+			if err := s.cache.Set(ctx, cache.SitemapFileKey(f.name), string(f.data), cache.SitemapCacheTTL); err != nil {
+				logger.WarnContext(ctx, "Failed to cache sitemap", "name", f.name, "error", err)
+			}
+		}
+
+		if f.name != SitemapIndexName {
+			response.TotalURLs += f.urlCount
+		}
+		response.Files = append(response.Files, item)
+	}
+
+	logger.InfoContext(ctx, "Sitemaps rebuilt", "files", len(files), "urls", response.TotalURLs)
+	return response, nil
+}
+
+// GetFile อ่านจาก cache ก่อน ถ้าไม่มี (เช่น Redis เพิ่งถูก flush) ใช้ไฟล์ที่อัปโหลดไว้ใน storage แล้วเติม cache กลับ
+// ไม่ build ตอน request เพราะเป็น endpoint สาธารณะ (กัน traffic ทำให้ rebuild ทั้งชุดซ้อนกันหลายรอบ)
+func (s *SitemapServiceImpl) GetFile(ctx context.Context, name string) ([]byte, error) {
+	if !sitemapNamePattern.MatchString(name) {
+		return nil, errors.New("sitemap not found")
+	}
+
+	if s.cache != nil {
+		var cached string
+		if err := s.cache.Get(ctx, cache.SitemapFileKey(name), &cached); err == nil && cached != "" {
+			return []byte(cached), nil
+		}
+	}
+
+	data, err := s.readStoredFile(ctx, name)
+	if err != nil {
+		logger.WarnContext(ctx, "Sitemap not in cache or storage, waiting for scheduled rebuild", "name", name, "error", err)
+		return nil, errors.New("sitemap not found")
+	}
+
+	if s.cache != nil {
+		if err := s.cache.Set(ctx, cache.SitemapFileKey(name), string(data), cache.SitemapCacheTTL); err != nil {
+			logger.WarnContext(ctx, "Failed to cache sitemap", "name", name, "error", err)
+		}
+	}
+	return data, nil
+}
+
+// HasIndex - มี index ใน cache หรือ storage แล้ว (ใช้ตัดสินว่าต้อง build ตอน start หรือไม่)
+func (s *SitemapServiceImpl) HasIndex(ctx context.Context) bool {
+	_, err := s.GetFile(ctx, SitemapIndexName)
+	return err == nil
+}
+
+// readStoredFile อ่านไฟล์ที่ Rebuild อัปโหลดไว้
+func (s *SitemapServiceImpl) readStoredFile(ctx context.Context, name string) ([]byte, error) {
+	if s.storage == nil {
+		return nil, errors.New("storage not configured")
+	}
+
+	body, err := s.storage.GetFile(ctx, sitemapStoragePrefix+name)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return io.ReadAll(body)
+}
+
+// build render ทุกไฟล์ โดย index อยู่ท้ายสุด
+func (s *SitemapServiceImpl) build(ctx context.Context) ([]sitemapFile, error) {
+	articles, err := s.loadArticles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load articles for sitemap: %w", err)
+	}
+	casts, err := s.sitemapRepo.ListCasts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load casts for sitemap: %w", err)
+	}
+	tags, err := s.sitemapRepo.ListTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tags for sitemap: %w", err)
+	}
+	makers, err := s.sitemapRepo.ListMakers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load makers for sitemap: %w", err)
+	}
+
+	var files []sitemapFile
+	add := func(group string, urls []seo.SitemapURL) error {
+		chunks, err := renderSitemapChunks(group, urls)
+		if err != nil {
+			return err
+		}
+		files = append(files, chunks...)
+		return nil
+	}
+
+	if err := add("static", s.staticURLs()); err != nil {
+		return nil, err
+	}
+	if err := add("articles", s.articleURLs(articles)); err != nil {
+		return nil, err
+	}
+	if err := add("videos", s.videoURLs(articles)); err != nil {
+		return nil, err
+	}
+	if err := add("casts", s.entityURLs(casts, seo.CastPath)); err != nil {
+		return nil, err
+	}
+	if err := add("tags", s.entityURLs(tags, seo.TagPath)); err != nil {
+		return nil, err
+	}
+	if err := add("makers", s.entityURLs(makers, seo.MakerPath)); err != nil {
+		return nil, err
+	}
+
+	now := seo.FormatLastMod(time.Now())
+	refs := make([]seo.SitemapRef, 0, len(files))
+	for _, f := range files {
+		lastMod := f.lastMod
+		if lastMod == "" {
+			lastMod = now
+		}
+		refs = append(refs, seo.SitemapRef{Loc: s.fileURL(f.name), LastMod: lastMod})
+	}
+	index, err := seo.EncodeSitemapIndex(refs)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(files, sitemapFile{name: SitemapIndexName, urlCount: len(refs), data: index}), nil
+}
+
+// loadArticles ดึงบทความ published ทั้งหมดทีละ batch (keyset ตาม id)
+func (s *SitemapServiceImpl) loadArticles(ctx context.Context) ([]repositories.SitemapArticle, error) {
+	var all []repositories.SitemapArticle
+	afterID := uuid.Nil
+	for {
+		batch, err := s.sitemapRepo.ListPublishedArticles(ctx, afterID, sitemapArticleBatch)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, batch...)
+		if len(batch) < sitemapArticleBatch {
+			return all, nil
+		}
+		afterID = batch[len(batch)-1].ID
+	}
+}
+
+func (s *SitemapServiceImpl) staticURLs() []seo.SitemapURL {
+	urls := make([]seo.SitemapURL, 0, len(sitemapStaticPages)*len(sitemapLanguages))
+	for _, page := range sitemapStaticPages {
+		paths := make(map[string]string, len(sitemapLanguages))
+		for _, lang := range sitemapLanguages {
+			paths[lang] = seo.LangPrefix(lang) + page.path
+		}
+		for _, lang := range sitemapLanguages {
+			urls = append(urls, seo.SitemapURL{
+				Loc:        s.pageURL(paths[lang]),
+				ChangeFreq: page.changeFreq,
+				Alternates: s.alternates(paths),
+			})
+		}
+	}
+	return urls
+}
+
+// articleURLs - บทความทุกภาษา พร้อม hreflang ไปยังบทความของ video เดียวกันในภาษาอื่น
+// (ความสัมพันธ์เดียวกับ getTranslations ของ ArticleService, บทความจาก generator ไม่มีคู่แปล)
+func (s *SitemapServiceImpl) articleURLs(articles []repositories.SitemapArticle) []seo.SitemapURL {
+	byVideo := make(map[uuid.UUID]map[string]string)
+	for _, a := range articles {
+		if a.Origin == string(models.ArticleOriginGenerator) {
+			continue
+		}
+		if byVideo[a.VideoID] == nil {
+			byVideo[a.VideoID] = make(map[string]string)
+		}
+		byVideo[a.VideoID][a.Language] = seo.ArticlePath(a.Language, a.Type, a.Slug)
+	}
+
+	urls := make([]seo.SitemapURL, 0, len(articles))
+	for _, a := range articles {
+		u := seo.SitemapURL{
+			Loc:     s.pageURL(seo.ArticlePath(a.Language, a.Type, a.Slug)),
+			LastMod: seo.FormatLastMod(articleLastMod(a)),
+		}
+		if paths := byVideo[a.VideoID]; len(paths) > 1 && a.Origin != string(models.ArticleOriginGenerator) {
+			u.Alternates = s.alternates(paths)
+		}
+		urls = append(urls, u)
+	}
+	return urls
+}
+
+// videoURLs - video sitemap ชี้ไปที่หน้าบทความที่ฝัง player
+// หน้า /member/videos ต้อง login จึงไม่ใส่ใน sitemap
+func (s *SitemapServiceImpl) videoURLs(articles []repositories.SitemapArticle) []seo.SitemapURL {
+	var urls []seo.SitemapURL
+	for _, a := range articles {
+		// บทความจาก generator รวมหลาย video ไม่ใช่หน้าของ video ใด video หนึ่ง
+		if a.EmbedURL == "" || a.ThumbnailURL == "" || a.Origin == string(models.ArticleOriginGenerator) {
+			continue
+		}
+
+		video := seo.SitemapVideo{
+			ThumbnailLoc: a.ThumbnailURL,
+			Title:        a.Title,
+			Description:  a.MetaDescription,
+			PlayerLoc:    a.EmbedURL,
+			Duration:     a.DurationMinutes * 60,
+		}
+		if a.PublishedAt != nil {
+			video.PublicationDate = seo.FormatLastMod(*a.PublishedAt)
+		}
+
+		urls = append(urls, seo.SitemapURL{
+			Loc:     s.pageURL(seo.ArticlePath(a.Language, a.Type, a.Slug)),
+			LastMod: seo.FormatLastMod(articleLastMod(a)),
+			Videos:  []seo.SitemapVideo{video},
+		})
+	}
+	return urls
+}
+
+// entityURLs - หน้ารวมบทความของ cast/tag/maker ทุกภาษา
+func (s *SitemapServiceImpl) entityURLs(entities []repositories.SitemapEntity, pathFn func(lang, slug string) string) []seo.SitemapURL {
+	urls := make([]seo.SitemapURL, 0, len(entities)*len(sitemapLanguages))
+	for _, e := range entities {
+		paths := make(map[string]string, len(sitemapLanguages))
+		for _, lang := range sitemapLanguages {
+			paths[lang] = pathFn(lang, e.Slug)
+		}
+		for _, lang := range sitemapLanguages {
+			urls = append(urls, seo.SitemapURL{
+				Loc:        s.pageURL(paths[lang]),
+				LastMod:    seo.FormatLastMod(e.LastMod),
+				ChangeFreq: "weekly",
+				Alternates: s.alternates(paths),
+			})
+		}
+	}
+	return urls
+}
+
+// alternates สร้าง hreflang ของทุกภาษาที่มี + x-default (ภาษาหลัก)
+func (s *SitemapServiceImpl) alternates(paths map[string]string) []seo.SitemapAlternate {
+	alts := make([]seo.SitemapAlternate, 0, len(paths)+1)
+	for _, lang := range sitemapLanguages {
+		path, ok := paths[lang]
+		if !ok {
+			continue
+		}
+		alts = append(alts, seo.SitemapAlternate{Rel: "alternate", Hreflang: lang, Href: s.pageURL(path)})
+	}
+	if path, ok := paths[seo.DefaultLanguage]; ok {
+		alts = append(alts, seo.SitemapAlternate{Rel: "alternate", Hreflang: "x-default", Href: s.pageURL(path)})
+	}
+	return alts
+}
+
+func (s *SitemapServiceImpl) pageURL(path string) string {
+	if path == "" {
+		path = "/"
+	}
+	return seo.PageURL(s.siteURL, path)
+}
+
+func (s *SitemapServiceImpl) fileURL(name string) string {
+	return s.baseURL + "/" + name
+}
+
+// renderSitemapChunks แบ่ง URL เป็นไฟล์ละไม่เกิน seo.MaxSitemapURLs
+// ชื่อไฟล์: {group}-{n}.xml ยกเว้น static ที่มีไฟล์เดียว
+func renderSitemapChunks(group string, urls []seo.SitemapURL) ([]sitemapFile, error) {
+	if len(urls) == 0 {
+		return nil, nil
+	}
+
+	var files []sitemapFile
+	for start, page := 0, 1; start < len(urls); start, page = start+seo.MaxSitemapURLs, page+1 {
+		end := start + seo.MaxSitemapURLs
+		if end > len(urls) {
+			end = len(urls)
+		}
+
+		data, err := seo.EncodeURLSet(urls[start:end])
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s sitemap: %w", group, err)
+		}
+
+		// lastmod เป็น RFC3339 UTC เทียบแบบ string ได้
+		lastMod := ""
+		for _, u := range urls[start:end] {
+			if u.LastMod > lastMod {
+				lastMod = u.LastMod
+			}
+		}
+
+		name := fmt.Sprintf("%s-%d.xml", group, page)
+		if group == "static" {
+			name = "static.xml"
+		}
+		files = append(files, sitemapFile{name: name, urlCount: end - start, lastMod: lastMod, data: data})
+	}
+	return files, nil
+}
+
+// articleLastMod - UpdatedAt ก่อน ถ้าไม่มีใช้ PublishedAt
+func articleLastMod(a repositories.SitemapArticle) time.Time {
+	if !a.UpdatedAt.IsZero() {
+		return a.UpdatedAt
+	}
+	if a.PublishedAt != nil {
+		return *a.PublishedAt
+	}
+	return time.Time{}
+}
@@ -191,6 +191,7 @@ func (s *TagServiceImpl) ListTags(ctx context.Context, req *dto.TagListRequest)
 		SortBy:      req.SortBy,
 		Order:       req.Order,
 		HasArticles: req.HasArticles,
+		MissingLang: req.MissingLang,
 	}
 
 	// Parse IDs if provided (batch fetch mode)
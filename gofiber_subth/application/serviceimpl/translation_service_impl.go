@@ -0,0 +1,378 @@
+package serviceimpl
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/repositories"
+	"gofiber-template/domain/services"
+	"gofiber-template/pkg/logger"
+)
+
+const (
+	// TranslationImportMaxRows - จำนวนแถวสูงสุดต่อไฟล์ import
+	TranslationImportMaxRows = 50000
+)
+
+// utf8BOM - BOM ให้ Excel เปิดภาษาไทย/ญี่ปุ่นได้ถูกต้อง
+const utf8BOM = "\ufeff"
+
+// translationColumns - ลำดับคอลัมน์ของไฟล์ CSV
+var translationColumns = []string{"type", "id", "key", "lang", "source", "text"}
+
+var translationLangs = map[string]bool{"en": true, "th": true, "ja": true}
+
+// translationMaxLength - ความยาวสูงสุดตาม column ของแต่ละ table (0 = ไม่จำกัด)
+var translationMaxLength = map[string]int{
+	repositories.TranslationTypeVideo:    0,
+	repositories.TranslationTypeCast:     255,
+	repositories.TranslationTypeTag:      255,
+	repositories.TranslationTypeCategory: 100,
+}
+
+type translationServiceImpl struct {
+	translationRepo repositories.TranslationRepository
+}
+
+func NewTranslationService(translationRepo repositories.TranslationRepository) services.TranslationService {
+	return &translationServiceImpl{
+		translationRepo: translationRepo,
+	}
+}
+
+func (s *translationServiceImpl) Export(ctx context.Context, req *dto.TranslationExportRequest) (*dto.TranslationExportResponse, error) {
+	req.SetDefaults()
+
+	rows, err := s.translationRepo.List(ctx, repositories.TranslationListParams{
+		EntityType:  req.Type,
+		Lang:        req.Lang,
+		MissingOnly: req.Missing,
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list translations", "type", req.Type, "lang", req.Lang, "error", err)
+		return nil, err
+	}
+
+	entries := make([]dto.TranslationEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = dto.TranslationEntry{
+			Type:   req.Type,
+			ID:     row.ID.String(),
+			Key:    row.Key,
+			Lang:   req.Lang,
+			Source: row.Source,
+			Text:   row.Text,
+		}
+	}
+
+	data, err := encodeTranslations(req.Format, entries)
+	if err != nil {
+		return nil, err
+	}
+
+	filename := fmt.Sprintf("translations_%s_%s", req.Type, req.Lang)
+	if req.Missing {
+		filename += "_missing"
+	}
+
+	logger.InfoContext(ctx, "Translations exported", "type", req.Type, "lang", req.Lang, "missing", req.Missing, "count", len(entries))
+
+	return &dto.TranslationExportResponse{
+		Format:      req.Format,
+		Filename:    filename + "." + req.Format,
+		ContentType: translationContentType(req.Format),
+		Data:        data,
+		Count:       len(entries),
+	}, nil
+}
+
+// translationLine - entry พร้อมเลขบรรทัดในไฟล์ (ใช้รายงาน error/diff)
+type translationLine struct {
+	line  int
+	entry dto.TranslationEntry
+	id    uuid.UUID
+}
+
+func (s *translationServiceImpl) Import(ctx context.Context, req *dto.TranslationImportRequest, r io.Reader) (*dto.TranslationImportResult, error) {
+	req.SetDefaults()
+
+	lines, err := decodeTranslations(req.Format, r)
+	if err != nil {
+		logger.WarnContext(ctx, "Invalid translation file", "format", req.Format, "error", err)
+		return nil, err
+	}
+
+	result := &dto.TranslationImportResult{
+		DryRun:  req.DryRun,
+		Total:   len(lines),
+		Changes: []dto.TranslationChange{},
+		Errors:  []dto.TranslationImportError{},
+	}
+	invalid := func(line int, message string) {
+		result.Invalid++
+		result.Errors = append(result.Errors, dto.TranslationImportError{Line: line, Message: message})
+	}
+
+	// 1. ตรวจรูปแบบทีละแถว + รวม id ตาม (type, lang) เพื่อดึงค่าปัจจุบันทีเดียว
+	valid := make([]translationLine, 0, len(lines))
+	seen := make(map[string]int)
+	idsByGroup := make(map[[2]string][]uuid.UUID)
+	for _, l := range lines {
+		e := &l.entry
+		e.Type = strings.TrimSpace(e.Type)
+		e.Lang = strings.TrimSpace(e.Lang)
+		e.Text = strings.TrimSpace(e.Text)
+
+		maxLength, ok := translationMaxLength[e.Type]
+		if !ok {
+			invalid(l.line, fmt.Sprintf("invalid type %q", e.Type))
+			continue
+		}
+		if !translationLangs[e.Lang] {
+			invalid(l.line, fmt.Sprintf("invalid lang %q", e.Lang))
+			continue
+		}
+		id, err := uuid.Parse(strings.TrimSpace(e.ID))
+		if err != nil {
+			invalid(l.line, fmt.Sprintf("invalid id %q", e.ID))
+			continue
+		}
+		if e.Text == "" {
+			result.Skipped++
+			continue
+		}
+		if maxLength > 0 && utf8.RuneCountInString(e.Text) > maxLength {
+			invalid(l.line, fmt.Sprintf("text exceeds %d characters", maxLength))
+			continue
+		}
+
+		dedupKey := e.Type + ":" + id.String() + ":" + e.Lang
+		if first, ok := seen[dedupKey]; ok {
+			invalid(l.line, fmt.Sprintf("duplicate of line %d", first))
+			continue
+		}
+		seen[dedupKey] = l.line
+
+		l.id = id
+		valid = append(valid, l)
+		group := [2]string{e.Type, e.Lang}
+		idsByGroup[group] = append(idsByGroup[group], id)
+	}
+
+	// 2. ดึงคำแปลปัจจุบัน (id ที่ไม่อยู่ในผล = ไม่มี entity นี้)
+	current := make(map[string]repositories.TranslationRow)
+	for group, ids := range idsByGroup {
+		rows, err := s.translationRepo.GetByIDs(ctx, group[0], group[1], ids)
+		if err != nil {
+			logger.ErrorContext(ctx, "Failed to load current translations", "type", group[0], "lang", group[1], "error", err)
+			return nil, err
+		}
+		for _, row := range rows {
+			current[group[0]+":"+row.ID.String()+":"+group[1]] = row
+		}
+	}
+
+	// 3. diff
+	upserts := make([]repositories.TranslationUpsert, 0, len(valid))
+	for _, l := range valid {
+		row, ok := current[l.entry.Type+":"+l.id.String()+":"+l.entry.Lang]
+		if !ok {
+			invalid(l.line, fmt.Sprintf("%s %s not found", l.entry.Type, l.id))
+			continue
+		}
+		if row.Text == l.entry.Text {
+			result.Unchanged++
+			continue
+		}
+
+		change := dto.TranslationChange{
+			Line: l.line,
+			Type: l.entry.Type,
+			ID:   l.id.String(),
+			Key:  row.Key,
+			Lang: l.entry.Lang,
+			From: row.Text,
+			To:   l.entry.Text,
+		}
+		if row.Text == "" {
+			change.Op = "create"
+			result.Created++
+		} else {
+			change.Op = "update"
+			result.Updated++
+		}
+		result.Changes = append(result.Changes, change)
+		upserts = append(upserts, repositories.TranslationUpsert{
+			EntityType: l.entry.Type,
+			ID:         l.id,
+			Lang:       l.entry.Lang,
+			Text:       l.entry.Text,
+		})
+	}
+
+	if req.DryRun || result.Invalid > 0 || len(upserts) == 0 {
+		logger.InfoContext(ctx, "Translation import not applied",
+			"dry_run", req.DryRun,
+			"total", result.Total,
+			"changes", len(upserts),
+			"invalid", result.Invalid,
+		)
+		return result, nil
+	}
+
+	// 4. เขียนทั้งชุดใน transaction เดียว
+	if err := s.translationRepo.Upsert(ctx, upserts); err != nil {
+		logger.ErrorContext(ctx, "Failed to upsert translations", "count", len(upserts), "error", err)
+		return nil, err
+	}
+	result.Applied = true
+
+	logger.InfoContext(ctx, "Translations imported",
+		"total", result.Total,
+		"created", result.Created,
+		"updated", result.Updated,
+		"unchanged", result.Unchanged,
+		"skipped", result.Skipped,
+	)
+
+	return result, nil
+}
+
+func translationContentType(format string) string {
+	if format == dto.TranslationFormatJSONL {
+		return "application/x-ndjson; charset=utf-8"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+func encodeTranslations(format string, entries []dto.TranslationEntry) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if format == dto.TranslationFormatJSONL {
+		enc := json.NewEncoder(&buf)
+		enc.SetEscapeHTML(false)
+		for _, e := range entries {
+			if err := enc.Encode(e); err != nil {
+				return nil, err
+			}
+		}
+		return buf.Bytes(), nil
+	}
+
+	buf.WriteString(utf8BOM)
+	w := csv.NewWriter(&buf)
+	if err := w.Write(translationColumns); err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		if err := w.Write([]string{e.Type, e.ID, e.Key, e.Lang, e.Source, e.Text}); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeTranslations(format string, r io.Reader) ([]translationLine, error) {
+	if format == dto.TranslationFormatJSONL {
+		return decodeTranslationsJSONL(r)
+	}
+	return decodeTranslationsCSV(r)
+}
+
+func decodeTranslationsCSV(r io.Reader) ([]translationLine, error) {
+	br := bufio.NewReader(r)
+	if bom, err := br.Peek(3); err == nil && string(bom) == utf8BOM {
+		br.Discard(3)
+	}
+
+	cr := csv.NewReader(br)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, errors.New("invalid translation file")
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"type", "id", "lang", "text"} {
+		if _, ok := columns[required]; !ok {
+			return nil, errors.New("invalid translation file")
+		}
+	}
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return record[i]
+	}
+
+	var lines []translationLine
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.New("invalid translation file")
+		}
+		if len(lines) >= TranslationImportMaxRows {
+			return nil, errors.New("too many translation rows")
+		}
+		line, _ := cr.FieldPos(0)
+		lines = append(lines, translationLine{
+			line: line,
+			entry: dto.TranslationEntry{
+				Type:   field(record, "type"),
+				ID:     field(record, "id"),
+				Key:    field(record, "key"),
+				Lang:   field(record, "lang"),
+				Source: field(record, "source"),
+				Text:   field(record, "text"),
+			},
+		})
+	}
+	return lines, nil
+}
+
+func decodeTranslationsJSONL(r io.Reader) ([]translationLine, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	var lines []translationLine
+	for n := 1; scanner.Scan(); n++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		if len(lines) >= TranslationImportMaxRows {
+			return nil, errors.New("too many translation rows")
+		}
+		var entry dto.TranslationEntry
+		if err := json.Unmarshal([]byte(text), &entry); err != nil {
+			return nil, errors.New("invalid translation file")
+		}
+		lines = append(lines, translationLine{line: n, entry: entry})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.New("invalid translation file")
+	}
+	return lines, nil
+}
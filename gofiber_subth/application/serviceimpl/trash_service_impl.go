@@ -0,0 +1,307 @@
+package serviceimpl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/ports"
+	"gofiber-template/domain/repositories"
+	"gofiber-template/domain/services"
+	"gofiber-template/infrastructure/redis"
+	"gofiber-template/pkg/logger"
+)
+
+const (
+	// TrashRetention - ระยะเวลาเก็บในถังขยะก่อนถูก purge อัตโนมัติ
+	TrashRetention = 30 * 24 * time.Hour
+	// TrashPurgeBatchSize - จำนวนสูงสุดที่ purge ต่อรอบ (ที่เหลือไปรอบถัดไป)
+	TrashPurgeBatchSize = 200
+)
+
+type trashServiceImpl struct {
+	trashRepo repositories.TrashRepository
+	videoRepo repositories.VideoRepository
+	storage   ports.Storage
+	cache     *redis.RedisClient
+}
+
+func NewTrashService(trashRepo repositories.TrashRepository, videoRepo repositories.VideoRepository, storage ports.Storage, cache *redis.RedisClient) services.TrashService {
+	return &trashServiceImpl{
+		trashRepo: trashRepo,
+		videoRepo: videoRepo,
+		storage:   storage,
+		cache:     cache,
+	}
+}
+
+func (s *trashServiceImpl) ListTrash(ctx context.Context, req *dto.TrashListRequest) ([]dto.TrashItemResponse, int64, error) {
+	req.SetDefaults()
+
+	rows, total, err := s.trashRepo.List(ctx, repositories.TrashListParams{
+		Type:   req.Type,
+		Offset: (req.Page - 1) * req.Limit,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list trash", "error", err)
+		return nil, 0, err
+	}
+
+	items := make([]dto.TrashItemResponse, len(rows))
+	for i, row := range rows {
+		item := dto.TrashItemResponse{
+			Type:      row.Type,
+			ID:        row.ID.String(),
+			Title:     row.Title,
+			VideoCode: row.VideoCode,
+			Thumbnail: row.Thumbnail,
+			DeletedAt: row.DeletedAt.Format(time.RFC3339),
+			PurgeAt:   row.DeletedAt.Add(TrashRetention).Format(time.RFC3339),
+		}
+		if row.VideoID != nil {
+			videoID := row.VideoID.String()
+			item.VideoID = &videoID
+		}
+		items[i] = item
+	}
+
+	return items, total, nil
+}
+
+func (s *trashServiceImpl) Restore(ctx context.Context, itemType string, id uuid.UUID) error {
+	item, err := s.getTrashed(ctx, itemType, id)
+	if err != nil {
+		return err
+	}
+
+	// บทความ/reel ของ video ที่ยังอยู่ในถังขยะต้อง restore video ก่อน
+	if itemType != repositories.TrashTypeVideo && item.VideoID != nil {
+		if _, err := s.trashRepo.Get(ctx, repositories.TrashTypeVideo, *item.VideoID); err == nil {
+			return errors.New("video is in trash")
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+	}
+
+	if itemType != repositories.TrashTypeReel {
+		conflict, err := s.trashRepo.RestoreConflict(ctx, itemType, id)
+		if err != nil {
+			return err
+		}
+		if conflict {
+			return errors.New("article slug conflict")
+		}
+	}
+
+	articles := s.affectedArticles(ctx, item)
+
+	switch itemType {
+	case repositories.TrashTypeVideo:
+		err = s.trashRepo.RestoreVideo(ctx, id)
+	case repositories.TrashTypeArticle:
+		err = s.trashRepo.RestoreArticle(ctx, id)
+	default:
+		err = s.trashRepo.RestoreReel(ctx, id)
+	}
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to restore from trash", "type", itemType, "id", id, "error", err)
+		return err
+	}
+
+	// บทความที่กลับมาต้องโผล่ใน list/feed/หน้า cast-tag-maker ทันที
+	s.invalidateArticleCaches(ctx, item, articles)
+
+	logger.InfoContext(ctx, "Restored from trash", "type", itemType, "id", id)
+	return nil
+}
+
+func (s *trashServiceImpl) Purge(ctx context.Context, itemType string, id uuid.UUID) error {
+	item, err := s.getTrashed(ctx, itemType, id)
+	if err != nil {
+		return err
+	}
+	return s.purge(ctx, item)
+}
+
+func (s *trashServiceImpl) PurgeExpired(ctx context.Context) (*dto.TrashPurgeResult, error) {
+	result := &dto.TrashPurgeResult{}
+
+	rows, err := s.trashRepo.ListExpired(ctx, time.Now().Add(-TrashRetention), TrashPurgeBatchSize)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list expired trash", "error", err)
+		return nil, err
+	}
+
+	purgedVideos := make(map[uuid.UUID]bool)
+	for i := range rows {
+		item := &rows[i]
+		// บทความ/reel ที่ถูกลบพร้อม video ถูก purge ไปกับ video แล้ว
+		if item.VideoID != nil && purgedVideos[*item.VideoID] && item.Type != repositories.TrashTypeVideo {
+			continue
+		}
+
+		if err := s.purge(ctx, item); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+			logger.WarnContext(ctx, "Failed to purge trash item", "type", item.Type, "id", item.ID, "error", err)
+			result.Failed++
+			continue
+		}
+
+		switch item.Type {
+		case repositories.TrashTypeVideo:
+			purgedVideos[item.ID] = true
+			result.Videos++
+		case repositories.TrashTypeArticle:
+			result.Articles++
+		default:
+			result.Reels++
+		}
+	}
+
+	return result, nil
+}
+
+func (s *trashServiceImpl) getTrashed(ctx context.Context, itemType string, id uuid.UUID) (*repositories.TrashItemRow, error) {
+	switch itemType {
+	case repositories.TrashTypeVideo, repositories.TrashTypeArticle, repositories.TrashTypeReel:
+	default:
+		return nil, errors.New("invalid trash type")
+	}
+
+	item, err := s.trashRepo.Get(ctx, itemType, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("not found in trash")
+		}
+		logger.ErrorContext(ctx, "Failed to get trash item", "type", itemType, "id", id, "error", err)
+		return nil, err
+	}
+	return item, nil
+}
+
+// purge ลบถาวร แล้วค่อยล้างไฟล์ (ถ้าลบ DB ไม่สำเร็จ ไฟล์ยังอยู่ให้ restore ได้)
+func (s *trashServiceImpl) purge(ctx context.Context, item *repositories.TrashItemRow) error {
+	articles := s.affectedArticles(ctx, item)
+
+	var err error
+	switch item.Type {
+	case repositories.TrashTypeVideo:
+		err = s.trashRepo.PurgeVideo(ctx, item.ID)
+	case repositories.TrashTypeArticle:
+		err = s.trashRepo.PurgeArticle(ctx, item.ID)
+	default:
+		err = s.trashRepo.PurgeReel(ctx, item.ID)
+	}
+	if err != nil {
+		return err
+	}
+
+	switch item.Type {
+	case repositories.TrashTypeVideo:
+		s.deleteVideoFiles(ctx, item)
+	case repositories.TrashTypeArticle:
+		s.deleteArticleFiles(ctx, item)
+	}
+
+	s.invalidateArticleCaches(ctx, item, articles)
+
+	logger.InfoContext(ctx, "Purged from trash", "type", item.Type, "id", item.ID, "deleted_at", item.DeletedAt)
+	return nil
+}
+
+// affectedArticles - บทความของรายการนี้ (ดึงก่อน restore/purge เพราะหลัง purge จะไม่เหลือข้อมูล)
+func (s *trashServiceImpl) affectedArticles(ctx context.Context, item *repositories.TrashItemRow) []models.Article {
+	if s.cache == nil {
+		return nil
+	}
+	articles, err := s.trashRepo.ListArticles(ctx, item.Type, item.ID)
+	if err != nil {
+		logger.WarnContext(ctx, "Failed to get trash item articles for cache invalidation", "type", item.Type, "id", item.ID, "error", err)
+	}
+	return articles
+}
+
+// invalidateArticleCaches ล้าง cache หน้าสาธารณะของบทความที่ restore/purge
+// หน้า cast/tag/maker ล้างได้เฉพาะเมื่อ video ยังใช้งานอยู่ (purge video ไปแล้วไม่มี relation ให้ดู)
+func (s *trashServiceImpl) invalidateArticleCaches(ctx context.Context, item *repositories.TrashItemRow, articles []models.Article) {
+	if len(articles) == 0 {
+		return
+	}
+
+	videoID := item.ID
+	if item.VideoID != nil {
+		videoID = *item.VideoID
+	}
+	video, err := s.videoRepo.GetWithRelations(ctx, videoID)
+	if err != nil {
+		video = nil
+	}
+
+	invalidateArticlePages(ctx, s.cache, articles, video)
+}
+
+// deleteVideoFiles ลบ thumbnail + ไฟล์บทความของ video (บทความถูก purge ไปพร้อม video แล้ว)
+func (s *trashServiceImpl) deleteVideoFiles(ctx context.Context, item *repositories.TrashItemRow) {
+	if s.storage == nil {
+		return
+	}
+
+	if item.Thumbnail != "" {
+		thumbnailPath := strings.TrimPrefix(item.Thumbnail, "/")
+		if err := s.storage.Delete(ctx, thumbnailPath); err != nil {
+			logger.WarnContext(ctx, "Failed to delete thumbnail from storage", "video_id", item.ID, "path", thumbnailPath, "error", err)
+		}
+	}
+
+	s.deleteArticlePrefixes(ctx, item.ID, item.VideoCode)
+}
+
+// deleteArticleFiles - ไฟล์บทความเก็บตาม video code (ใช้ร่วมกันทุกภาษา)
+// จึงลบเมื่อ video นั้นไม่เหลือบทความแล้วเท่านั้น
+func (s *trashServiceImpl) deleteArticleFiles(ctx context.Context, item *repositories.TrashItemRow) {
+	if s.storage == nil || item.VideoID == nil || item.VideoCode == "" {
+		return
+	}
+
+	remaining, err := s.trashRepo.CountVideoArticles(ctx, *item.VideoID)
+	if err != nil {
+		logger.WarnContext(ctx, "Failed to count remaining articles", "video_id", *item.VideoID, "error", err)
+		return
+	}
+	if remaining > 0 {
+		return
+	}
+
+	s.deleteArticlePrefixes(ctx, item.ID, item.VideoCode)
+}
+
+// deleteArticlePrefixes ลบไฟล์ที่ SEO Worker เก็บไว้:
+// - articles/{videoCode}/ (cover, images)
+// - audio/articles/{videoCode}/ (TTS audio)
+func (s *trashServiceImpl) deleteArticlePrefixes(ctx context.Context, id uuid.UUID, videoCode string) {
+	if videoCode == "" {
+		return
+	}
+
+	for _, prefix := range []string{
+		fmt.Sprintf("articles/%s/", videoCode),
+		fmt.Sprintf("audio/articles/%s/", videoCode),
+	} {
+		deletedCount, err := s.storage.DeleteByPrefix(ctx, prefix)
+		if err != nil {
+			logger.WarnContext(ctx, "Failed to delete R2 files", "id", id, "prefix", prefix, "error", err)
+		} else if deletedCount > 0 {
+			logger.InfoContext(ctx, "R2 files deleted", "id", id, "prefix", prefix, "count", deletedCount)
+		}
+	}
+}
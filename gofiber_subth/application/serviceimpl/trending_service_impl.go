@@ -0,0 +1,155 @@
+package serviceimpl
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/repositories"
+	"gofiber-template/domain/services"
+	"gofiber-template/infrastructure/redis"
+	"gofiber-template/pkg/logger"
+)
+
+const (
+	// TrendingSetSize - จำนวน member สูงสุดที่เก็บต่อ sorted set
+	TrendingSetSize = 500
+	// TrendingTTL - ถ้า worker หยุดทำงาน อันดับเก่าจะหมดอายุเอง
+	TrendingTTL = 6 * time.Hour
+)
+
+// trendingWindow - ช่วงเวลาที่นับ event + half-life ของ decay
+type trendingWindow struct {
+	Name     string
+	Period   time.Duration
+	HalfLife time.Duration
+}
+
+var trendingWindows = []trendingWindow{
+	{Name: "24h", Period: 24 * time.Hour, HalfLife: 6 * time.Hour},
+	{Name: "7d", Period: 7 * 24 * time.Hour, HalfLife: 36 * time.Hour},
+	{Name: "30d", Period: 30 * 24 * time.Hour, HalfLife: 7 * 24 * time.Hour},
+}
+
+var (
+	trendingKinds     = []string{repositories.TrendingKindVideo, repositories.TrendingKindCast, repositories.TrendingKindTag, repositories.TrendingKindMaker}
+	trendingLanguages = []string{"en", "th", "ja"}
+)
+
+type trendingServiceImpl struct {
+	repo  repositories.TrendingRepository
+	store *redis.TrendingStore
+}
+
+func NewTrendingService(repo repositories.TrendingRepository, store *redis.TrendingStore) services.TrendingService {
+	return &trendingServiceImpl{
+		repo:  repo,
+		store: store,
+	}
+}
+
+func (s *trendingServiceImpl) GetTrending(ctx context.Context, req *dto.TrendingRequest) (*dto.TrendingResponse, error) {
+	req.SetDefaults()
+
+	offset := (req.Page - 1) * req.Limit
+	entries, total, err := s.store.Top(ctx, s.store.GetKey(req.Kind, req.Window, req.Lang), offset, req.Limit)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to read trending set", "kind", req.Kind, "window", req.Window, "lang", req.Lang, "error", err)
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(entries))
+	for i, e := range entries {
+		ids[i] = e.ID
+	}
+	rows, err := s.repo.Items(ctx, req.Kind, req.Lang, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]repositories.TrendingItemRow, len(rows))
+	for _, row := range rows {
+		byID[row.ID] = row
+	}
+
+	// เรียงตาม Redis, item ที่ถูกลบไปแล้วหลังคำนวณจะถูกข้าม (rank ยังนับตามอันดับเดิม)
+	items := make([]dto.TrendingItemResponse, 0, len(entries))
+	for i, e := range entries {
+		row, ok := byID[e.ID]
+		if !ok {
+			continue
+		}
+		items = append(items, dto.TrendingItemResponse{
+			Rank:      offset + i + 1,
+			ID:        e.ID.String(),
+			Name:      row.Name,
+			Slug:      row.Slug,
+			Thumbnail: row.Thumbnail,
+			Score:     e.Score,
+		})
+	}
+
+	resp := &dto.TrendingResponse{
+		Kind:       req.Kind,
+		Window:     req.Window,
+		Lang:       req.Lang,
+		Items:      items,
+		Total:      total,
+		Page:       req.Page,
+		Limit:      req.Limit,
+		TotalPages: int((total + int64(req.Limit) - 1) / int64(req.Limit)),
+	}
+
+	updatedAt, err := s.store.UpdatedAt(ctx)
+	if err != nil {
+		logger.WarnContext(ctx, "Failed to read trending updated time", "error", err)
+	} else if updatedAt != nil {
+		t := updatedAt.Format(time.RFC3339)
+		resp.UpdatedAt = &t
+	}
+
+	return resp, nil
+}
+
+// Refresh คำนวณทุกชุดด้วยเวลาอ้างอิงเดียวกัน ถ้าชุดไหนล้มเหลวจะหยุดและคงชุดเดิมที่เหลือไว้ใน Redis
+func (s *trendingServiceImpl) Refresh(ctx context.Context) (*dto.TrendingRefreshResult, error) {
+	result := &dto.TrendingRefreshResult{}
+	now := time.Now()
+
+	for _, window := range trendingWindows {
+		for _, kind := range trendingKinds {
+			for _, lang := range trendingLanguages {
+				rows, err := s.repo.Scores(ctx, repositories.TrendingScoreParams{
+					Kind:     kind,
+					Lang:     lang,
+					Since:    now.Add(-window.Period),
+					Now:      now,
+					HalfLife: window.HalfLife,
+					Limit:    TrendingSetSize,
+				})
+				if err != nil {
+					logger.ErrorContext(ctx, "Failed to compute trending scores", "kind", kind, "window", window.Name, "lang", lang, "error", err)
+					return result, err
+				}
+
+				entries := make([]redis.TrendingEntry, len(rows))
+				for i, row := range rows {
+					entries[i] = redis.TrendingEntry{ID: row.ID, Score: row.Score}
+				}
+				if err := s.store.Replace(ctx, s.store.GetKey(kind, window.Name, lang), entries, TrendingTTL); err != nil {
+					return result, err
+				}
+
+				result.Sets++
+				result.Items += len(entries)
+			}
+		}
+	}
+
+	if err := s.store.MarkUpdated(ctx, now, TrendingTTL); err != nil {
+		logger.WarnContext(ctx, "Failed to mark trending updated time", "error", err)
+	}
+
+	return result, nil
+}
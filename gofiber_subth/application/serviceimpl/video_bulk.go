@@ -0,0 +1,561 @@
+package serviceimpl
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/repositories"
+	"gofiber-template/pkg/logger"
+)
+
+// ========================================
+// Bulk Edit
+// ========================================
+
+// BulkVideoEditMaxVideos - จำนวน video สูงสุดต่อ 1 request (filter ที่ตรงมากกว่านี้ต้องแคบลง)
+const BulkVideoEditMaxVideos = 1000
+
+var bulkSEOStatuses = map[string]bool{"pending": true, "draft": true, "published": true}
+
+// bulkVideoRefs - cast/tag/category/maker ที่ operations อ้างถึง (id → slug)
+type bulkVideoRefs struct {
+	casts      map[uuid.UUID]string
+	tags       map[uuid.UUID]string
+	categories map[uuid.UUID]string
+	makers     map[uuid.UUID]string
+}
+
+// bulkVideoOp - operation ที่ parse + ตรวจแล้ว
+type bulkVideoOp struct {
+	op        string
+	ids       []uuid.UUID
+	makerID   *uuid.UUID
+	autoTags  []string
+	seoStatus string
+}
+
+// bulkVideoState - ค่าของ video ที่ bulk edit แก้ได้
+type bulkVideoState struct {
+	casts      map[uuid.UUID]string
+	tags       map[uuid.UUID]string
+	categories map[uuid.UUID]string
+	makerID    *uuid.UUID
+	makerSlug  string
+	autoTags   []string
+	seoStatus  string
+}
+
+// BulkEditVideos เลือก video ด้วย ids/codes/filter แล้วทำ operations ตามลำดับ
+// diff คำนวณจากสถานะสุดท้าย (add แล้ว remove ตัวเดียวกัน = ไม่เปลี่ยน) แล้วเขียนใน transaction เดียว
+func (s *VideoServiceImpl) BulkEditVideos(ctx context.Context, req *dto.BulkVideoEditRequest) (*dto.BulkVideoEditResponse, error) {
+	ops, refs, err := s.resolveBulkOps(ctx, req.Operations)
+	if err != nil {
+		return nil, err
+	}
+
+	videos, notFound, err := s.selectBulkVideos(ctx, req.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.BulkVideoEditResponse{
+		DryRun:   req.DryRun,
+		Matched:  len(videos),
+		NotFound: len(notFound),
+		Items:    make([]dto.BulkVideoItemResult, 0, len(videos)+len(notFound)),
+	}
+
+	var edit repositories.VideoBulkEdit
+	var changed []uuid.UUID
+	var states []*bulkVideoState // ก่อน + หลังแก้ของ video ที่เปลี่ยน (ใช้ล้าง cache หน้า cast/tag/maker)
+	for _, video := range videos {
+		before := newBulkVideoState(&video)
+		after := before.clone()
+		for _, op := range ops {
+			after.apply(op, refs)
+		}
+
+		changes := diffBulkVideoState(video.ID, before, after, &edit)
+		item := dto.BulkVideoItemResult{
+			ID:     video.ID.String(),
+			Code:   video.Code,
+			Status: "unchanged",
+		}
+		if len(changes) > 0 {
+			item.Status = "updated"
+			item.Changes = changes
+			response.Updated++
+			changed = append(changed, video.ID)
+			states = append(states, before, after)
+		} else {
+			response.Unchanged++
+		}
+		response.Items = append(response.Items, item)
+	}
+	response.Items = append(response.Items, notFound...)
+
+	if req.DryRun || response.Updated == 0 {
+		return response, nil
+	}
+
+	// set_* ใช้ค่าของ operation สุดท้าย (ทุก video ได้ค่าเดียวกัน)
+	for _, op := range ops {
+		switch op.op {
+		case dto.BulkVideoOpSetMaker:
+			edit.MakerID = op.makerID
+		case dto.BulkVideoOpReplaceAutoTags:
+			edit.AutoTags = op.autoTags
+		case dto.BulkVideoOpSetSEOStatus:
+			edit.SEOStatus = op.seoStatus
+		}
+	}
+
+	if err := s.videoRepo.BulkEdit(ctx, edit); err != nil {
+		logger.ErrorContext(ctx, "Failed to bulk edit videos", "matched", response.Matched, "error", err)
+		return nil, err
+	}
+
+	s.invalidateBulkEditCaches(ctx, changed, states)
+
+	logger.InfoContext(ctx, "Videos bulk edited",
+		"matched", response.Matched,
+		"updated", response.Updated,
+		"not_found", response.NotFound,
+	)
+
+	return response, nil
+}
+
+// invalidateBulkEditCaches ล้าง cache ของบทความที่ผูกกับ video ที่แก้ (เหมือน DeleteVideo)
+// หน้า cast/tag/maker ล้างทั้งค่าก่อนและหลังแก้ (บทความย้ายออก/เข้าหน้านั้น)
+func (s *VideoServiceImpl) invalidateBulkEditCaches(ctx context.Context, videoIDs []uuid.UUID, states []*bulkVideoState) {
+	if s.cache == nil {
+		return
+	}
+
+	var articles []models.Article
+	for _, id := range videoIDs {
+		list, err := s.articleRepo.ListByVideoID(ctx, id)
+		if err != nil {
+			logger.WarnContext(ctx, "Failed to get video articles for cache invalidation", "video_id", id, "error", err)
+			continue
+		}
+		articles = append(articles, list...)
+	}
+
+	pages := &models.Video{}
+	castSlugs := make(map[string]bool)
+	tagSlugs := make(map[string]bool)
+	makerSlugs := make(map[string]bool)
+	for _, st := range states {
+		for _, slug := range st.casts {
+			if !castSlugs[slug] {
+				castSlugs[slug] = true
+				pages.Casts = append(pages.Casts, models.Cast{Slug: slug})
+			}
+		}
+		for _, slug := range st.tags {
+			if !tagSlugs[slug] {
+				tagSlugs[slug] = true
+				pages.Tags = append(pages.Tags, models.Tag{Slug: slug})
+			}
+		}
+		if st.makerSlug != "" {
+			makerSlugs[st.makerSlug] = true
+		}
+	}
+
+	invalidateArticlePages(ctx, s.cache, articles, pages)
+	for slug := range makerSlugs {
+		invalidateArticlePages(ctx, s.cache, nil, &models.Video{Maker: &models.Maker{Slug: slug}})
+	}
+}
+
+// resolveBulkOps ตรวจ operations + โหลด entity ที่อ้างถึง (ไม่มีอยู่จริง = error ทั้ง request)
+func (s *VideoServiceImpl) resolveBulkOps(ctx context.Context, operations []dto.BulkVideoOperation) ([]bulkVideoOp, *bulkVideoRefs, error) {
+	refs := &bulkVideoRefs{
+		casts:      make(map[uuid.UUID]string),
+		tags:       make(map[uuid.UUID]string),
+		categories: make(map[uuid.UUID]string),
+		makers:     make(map[uuid.UUID]string),
+	}
+
+	ops := make([]bulkVideoOp, 0, len(operations))
+	for _, operation := range operations {
+		op := bulkVideoOp{op: operation.Op}
+
+		switch operation.Op {
+		case dto.BulkVideoOpAddCasts, dto.BulkVideoOpRemoveCasts,
+			dto.BulkVideoOpAddTags, dto.BulkVideoOpRemoveTags,
+			dto.BulkVideoOpAddCategories, dto.BulkVideoOpRemoveCategories:
+			if len(operation.IDs) == 0 {
+				return nil, nil, errors.New("invalid operation")
+			}
+			for _, raw := range operation.IDs {
+				id, err := uuid.Parse(raw)
+				if err != nil {
+					return nil, nil, errors.New("invalid operation")
+				}
+				if err := s.resolveBulkRef(ctx, operation.Op, id, refs); err != nil {
+					return nil, nil, err
+				}
+				op.ids = append(op.ids, id)
+			}
+		case dto.BulkVideoOpSetMaker:
+			if operation.Value != "" {
+				makerID, err := uuid.Parse(operation.Value)
+				if err != nil {
+					return nil, nil, errors.New("invalid operation")
+				}
+				maker, err := s.makerRepo.GetByID(ctx, makerID)
+				if err != nil {
+					return nil, nil, bulkRefError(err, "maker not found")
+				}
+				refs.makers[makerID] = maker.Slug
+				op.makerID = &makerID
+			}
+		case dto.BulkVideoOpReplaceAutoTags:
+			op.autoTags = normalizeAutoTags(operation.Values)
+			if len(op.autoTags) > 0 {
+				labels, err := s.autoTagRepo.GetByKeys(ctx, op.autoTags)
+				if err != nil {
+					return nil, nil, err
+				}
+				if len(labels) != len(op.autoTags) {
+					return nil, nil, errors.New("unknown auto tag")
+				}
+			}
+		case dto.BulkVideoOpSetSEOStatus:
+			if !bulkSEOStatuses[operation.Value] {
+				return nil, nil, errors.New("invalid operation")
+			}
+			op.seoStatus = operation.Value
+		default:
+			return nil, nil, errors.New("invalid operation")
+		}
+
+		ops = append(ops, op)
+	}
+
+	return ops, refs, nil
+}
+
+// resolveBulkRef โหลด cast/tag/category ตามชนิดของ operation เก็บ slug ไว้แสดงใน changes
+func (s *VideoServiceImpl) resolveBulkRef(ctx context.Context, op string, id uuid.UUID, refs *bulkVideoRefs) error {
+	switch op {
+	case dto.BulkVideoOpAddCasts, dto.BulkVideoOpRemoveCasts:
+		if _, ok := refs.casts[id]; ok {
+			return nil
+		}
+		cast, err := s.castRepo.GetByID(ctx, id)
+		if err != nil {
+			return bulkRefError(err, "cast not found")
+		}
+		refs.casts[id] = cast.Slug
+	case dto.BulkVideoOpAddTags, dto.BulkVideoOpRemoveTags:
+		if _, ok := refs.tags[id]; ok {
+			return nil
+		}
+		tag, err := s.tagRepo.GetByID(ctx, id)
+		if err != nil {
+			return bulkRefError(err, "tag not found")
+		}
+		refs.tags[id] = tag.Slug
+	default:
+		if _, ok := refs.categories[id]; ok {
+			return nil
+		}
+		category, err := s.categoryRepo.GetByID(ctx, id)
+		if err != nil {
+			return bulkRefError(err, "category not found")
+		}
+		refs.categories[id] = category.Slug
+	}
+	return nil
+}
+
+func bulkRefError(err error, notFound string) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errors.New(notFound)
+	}
+	return err
+}
+
+// selectBulkVideos คืน video ที่ตรง selector + ผล not_found ของ id/code ที่ไม่มีอยู่
+func (s *VideoServiceImpl) selectBulkVideos(ctx context.Context, selector dto.BulkVideoSelector) ([]models.Video, []dto.BulkVideoItemResult, error) {
+	selected := 0
+	if len(selector.IDs) > 0 {
+		selected++
+	}
+	if len(selector.Codes) > 0 {
+		selected++
+	}
+	if selector.Filter != nil {
+		selected++
+	}
+	if selected != 1 {
+		return nil, nil, errors.New("invalid selector")
+	}
+
+	var notFound []dto.BulkVideoItemResult
+
+	switch {
+	case len(selector.IDs) > 0:
+		ids := make([]uuid.UUID, 0, len(selector.IDs))
+		seen := make(map[uuid.UUID]bool, len(selector.IDs))
+		for _, raw := range selector.IDs {
+			id, err := uuid.Parse(raw)
+			if err != nil {
+				return nil, nil, errors.New("invalid video ID")
+			}
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+		videos, err := s.videoRepo.GetForBulkEdit(ctx, ids, nil)
+		if err != nil {
+			logger.ErrorContext(ctx, "Failed to load videos for bulk edit", "error", err)
+			return nil, nil, err
+		}
+		found := make(map[uuid.UUID]bool, len(videos))
+		for _, video := range videos {
+			found[video.ID] = true
+		}
+		for _, id := range ids {
+			if !found[id] {
+				notFound = append(notFound, dto.BulkVideoItemResult{ID: id.String(), Status: "not_found"})
+			}
+		}
+		return videos, notFound, nil
+
+	case len(selector.Codes) > 0:
+		codes := make([]string, 0, len(selector.Codes))
+		seen := make(map[string]bool, len(selector.Codes))
+		for _, raw := range selector.Codes {
+			code := strings.TrimSpace(raw)
+			if code != "" && !seen[code] {
+				seen[code] = true
+				codes = append(codes, code)
+			}
+		}
+		videos, err := s.videoRepo.GetForBulkEdit(ctx, nil, codes)
+		if err != nil {
+			logger.ErrorContext(ctx, "Failed to load videos for bulk edit", "error", err)
+			return nil, nil, err
+		}
+		found := make(map[string]bool, len(videos))
+		for _, video := range videos {
+			found[video.Code] = true
+		}
+		for _, code := range codes {
+			if !found[code] {
+				notFound = append(notFound, dto.BulkVideoItemResult{Code: code, Status: "not_found"})
+			}
+		}
+		return videos, notFound, nil
+	}
+
+	params, err := bulkFilterParams(selector.Filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	params.Limit = BulkVideoEditMaxVideos + 1
+
+	ids, err := s.videoRepo.ListIDs(ctx, params)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to select videos for bulk edit", "error", err)
+		return nil, nil, err
+	}
+	if len(ids) > BulkVideoEditMaxVideos {
+		return nil, nil, errors.New("too many videos selected")
+	}
+
+	videos, err := s.videoRepo.GetForBulkEdit(ctx, ids, nil)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to load videos for bulk edit", "error", err)
+		return nil, nil, err
+	}
+	return videos, nil, nil
+}
+
+// bulkFilterParams แปลง filter เป็น VideoListParams (filter ว่าง = ทุก video จึงไม่อนุญาต)
+func bulkFilterParams(filter *dto.BulkVideoFilter) (repositories.VideoListParams, error) {
+	params := repositories.VideoListParams{
+		Search:      strings.TrimSpace(filter.Search),
+		Lang:        filter.Lang,
+		AutoTags:    normalizeAutoTags(filter.AutoTags),
+		Category:    strings.TrimSpace(filter.Category),
+		MissingLang: filter.MissingLang,
+		MakerID:     parseOptionalUUID(filter.MakerID),
+		CastID:      parseOptionalUUID(filter.CastID),
+		TagID:       parseOptionalUUID(filter.TagID),
+	}
+
+	if params.Search == "" && len(params.AutoTags) == 0 && params.Category == "" && params.MissingLang == "" &&
+		params.MakerID == nil && params.CastID == nil && params.TagID == nil {
+		return params, errors.New("invalid selector")
+	}
+	return params, nil
+}
+
+// parseOptionalUUID - ว่างหรือไม่ใช่ uuid = nil (ค่าผ่าน validate มาแล้ว)
+func parseOptionalUUID(raw string) *uuid.UUID {
+	if raw == "" {
+		return nil
+	}
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
+
+// normalizeAutoTags - trim, ตัดค่าว่าง/ซ้ำ (คงลำดับเดิม)
+func normalizeAutoTags(values []string) []string {
+	tags := make([]string, 0, len(values))
+	seen := make(map[string]bool, len(values))
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v != "" && !seen[v] {
+			seen[v] = true
+			tags = append(tags, v)
+		}
+	}
+	return tags
+}
+
+func newBulkVideoState(video *models.Video) *bulkVideoState {
+	state := &bulkVideoState{
+		casts:      make(map[uuid.UUID]string, len(video.Casts)),
+		tags:       make(map[uuid.UUID]string, len(video.Tags)),
+		categories: make(map[uuid.UUID]string, len(video.Categories)),
+		makerID:    video.MakerID,
+		autoTags:   video.AutoTags,
+		seoStatus:  video.SEOStatus,
+	}
+	for _, cast := range video.Casts {
+		state.casts[cast.ID] = cast.Slug
+	}
+	for _, tag := range video.Tags {
+		state.tags[tag.ID] = tag.Slug
+	}
+	for _, category := range video.Categories {
+		state.categories[category.ID] = category.Slug
+	}
+	if video.Maker != nil {
+		state.makerSlug = video.Maker.Slug
+	}
+	return state
+}
+
+func (st *bulkVideoState) clone() *bulkVideoState {
+	copySet := func(src map[uuid.UUID]string) map[uuid.UUID]string {
+		dst := make(map[uuid.UUID]string, len(src))
+		for k, v := range src {
+			dst[k] = v
+		}
+		return dst
+	}
+	c := *st
+	c.casts = copySet(st.casts)
+	c.tags = copySet(st.tags)
+	c.categories = copySet(st.categories)
+	return &c
+}
+
+func (st *bulkVideoState) apply(op bulkVideoOp, refs *bulkVideoRefs) {
+	switch op.op {
+	case dto.BulkVideoOpAddCasts:
+		for _, id := range op.ids {
+			st.casts[id] = refs.casts[id]
+		}
+	case dto.BulkVideoOpRemoveCasts:
+		for _, id := range op.ids {
+			delete(st.casts, id)
+		}
+	case dto.BulkVideoOpAddTags:
+		for _, id := range op.ids {
+			st.tags[id] = refs.tags[id]
+		}
+	case dto.BulkVideoOpRemoveTags:
+		for _, id := range op.ids {
+			delete(st.tags, id)
+		}
+	case dto.BulkVideoOpAddCategories:
+		for _, id := range op.ids {
+			st.categories[id] = refs.categories[id]
+		}
+	case dto.BulkVideoOpRemoveCategories:
+		for _, id := range op.ids {
+			delete(st.categories, id)
+		}
+	case dto.BulkVideoOpSetMaker:
+		st.makerID = op.makerID
+		st.makerSlug = ""
+		if op.makerID != nil {
+			st.makerSlug = refs.makers[*op.makerID]
+		}
+	case dto.BulkVideoOpReplaceAutoTags:
+		st.autoTags = op.autoTags
+	case dto.BulkVideoOpSetSEOStatus:
+		st.seoStatus = op.seoStatus
+	}
+}
+
+// diffBulkVideoState เพิ่ม diff ของ video ลงใน edit และคืนรายการเปลี่ยนแปลงที่อ่านได้
+func diffBulkVideoState(videoID uuid.UUID, before, after *bulkVideoState, edit *repositories.VideoBulkEdit) []string {
+	var changes []string
+
+	diffSet := func(label string, from, to map[uuid.UUID]string, add, remove *[]repositories.VideoRelation) {
+		var added, removed []string
+		for id, slug := range to {
+			if _, ok := from[id]; !ok {
+				*add = append(*add, repositories.VideoRelation{VideoID: videoID, RelatedID: id})
+				added = append(added, "+"+label+":"+slug)
+			}
+		}
+		for id, slug := range from {
+			if _, ok := to[id]; !ok {
+				*remove = append(*remove, repositories.VideoRelation{VideoID: videoID, RelatedID: id})
+				removed = append(removed, "-"+label+":"+slug)
+			}
+		}
+		sort.Strings(added)
+		sort.Strings(removed)
+		changes = append(changes, added...)
+		changes = append(changes, removed...)
+	}
+	diffSet("cast", before.casts, after.casts, &edit.AddCasts, &edit.RemoveCasts)
+	diffSet("tag", before.tags, after.tags, &edit.AddTags, &edit.RemoveTags)
+	diffSet("category", before.categories, after.categories, &edit.AddCategories, &edit.RemoveCategories)
+
+	if !sameUUIDPtr(before.makerID, after.makerID) {
+		edit.MakerVideoIDs = append(edit.MakerVideoIDs, videoID)
+		changes = append(changes, "maker:"+before.makerSlug+" → "+after.makerSlug)
+	}
+	if strings.Join(before.autoTags, ",") != strings.Join(after.autoTags, ",") {
+		edit.AutoTagVideoIDs = append(edit.AutoTagVideoIDs, videoID)
+		changes = append(changes, "autoTags:"+strings.Join(before.autoTags, ",")+" → "+strings.Join(after.autoTags, ","))
+	}
+	if before.seoStatus != after.seoStatus {
+		edit.SEOStatusVideoIDs = append(edit.SEOStatusVideoIDs, videoID)
+		changes = append(changes, "seoStatus:"+before.seoStatus+" → "+after.seoStatus)
+	}
+
+	return changes
+}
+
+func sameUUIDPtr(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
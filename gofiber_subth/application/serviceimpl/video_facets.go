@@ -0,0 +1,161 @@
+package serviceimpl
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/repositories"
+	"gofiber-template/pkg/logger"
+	"gofiber-template/pkg/utils"
+)
+
+// ========================================
+// Faceted Video Search
+// ========================================
+
+// FacetedSearch ค้นหา video ด้วย filter หลายค่าต่อมิติ + จำนวนต่อ facet ของผลที่ filter แล้ว
+func (s *VideoServiceImpl) FacetedSearch(ctx context.Context, req *dto.VideoFacetSearchRequest) (*dto.VideoFacetSearchResponse, error) {
+	req.SetDefaults()
+
+	params := repositories.VideoFacetParams{
+		Lang:             req.Lang,
+		Search:           strings.TrimSpace(req.Search),
+		CastMatchAll:     req.CastMode == "and",
+		TagMatchAll:      req.TagMode == "and",
+		CategoryMatchAll: req.CategoryMode == "and",
+		AutoTags:         splitFacetValues(req.AutoTags),
+		AutoTagMatchAll:  req.AutoTagMode == "and",
+		SortBy:           req.SortBy,
+		Order:            req.Order,
+		Limit:            req.Limit,
+		Offset:           (req.Page - 1) * req.Limit,
+		FacetLimit:       req.FacetLimit,
+	}
+	if params.Search != "" && utils.IsVideoCodeQuery(params.Search) {
+		params.Search = utils.NormalizeVideoCode(params.Search)
+	}
+
+	var err error
+	if params.MakerIDs, err = parseFacetIDs(req.Makers); err != nil {
+		return nil, err
+	}
+	if params.CastIDs, err = parseFacetIDs(req.Casts); err != nil {
+		return nil, err
+	}
+	if params.TagIDs, err = parseFacetIDs(req.Tags); err != nil {
+		return nil, err
+	}
+	if params.CategoryIDs, err = parseFacetIDs(req.Categories); err != nil {
+		return nil, err
+	}
+
+	if req.ReleasedFrom != "" {
+		from, _ := time.Parse("2006-01-02", req.ReleasedFrom)
+		params.ReleasedFrom = &from
+	}
+	if req.ReleasedTo != "" {
+		to, _ := time.Parse("2006-01-02", req.ReleasedTo)
+		params.ReleasedTo = &to
+	}
+	if params.ReleasedFrom != nil && params.ReleasedTo != nil && params.ReleasedTo.Before(*params.ReleasedFrom) {
+		return nil, errors.New("invalid release date range")
+	}
+
+	result, err := s.videoRepo.FacetedSearch(ctx, params)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to run faceted video search", "error", err)
+		return nil, err
+	}
+
+	// ค่าที่เลือกใน request (ใช้ติด Selected ใน bucket)
+	selected := map[string]map[string]bool{
+		"maker":    facetValueSet(req.Makers),
+		"cast":     facetValueSet(req.Casts),
+		"tag":      facetValueSet(req.Tags),
+		"category": facetValueSet(req.Categories),
+		"auto_tag": facetValueSet(req.AutoTags),
+	}
+
+	facets := dto.VideoFacetsResponse{
+		Makers:     []dto.VideoFacetBucket{},
+		Casts:      []dto.VideoFacetBucket{},
+		Tags:       []dto.VideoFacetBucket{},
+		Categories: []dto.VideoFacetBucket{},
+		AutoTags:   []dto.VideoFacetBucket{},
+	}
+	for _, row := range result.Facets {
+		bucket := dto.VideoFacetBucket{
+			Value:    row.Value,
+			Name:     row.Name,
+			Slug:     row.Slug,
+			Count:    row.Count,
+			Selected: selected[row.Facet][strings.ToLower(row.Value)],
+		}
+		switch row.Facet {
+		case "maker":
+			facets.Makers = append(facets.Makers, bucket)
+		case "cast":
+			facets.Casts = append(facets.Casts, bucket)
+		case "tag":
+			facets.Tags = append(facets.Tags, bucket)
+		case "category":
+			facets.Categories = append(facets.Categories, bucket)
+		case "auto_tag":
+			bucket.Slug = ""
+			facets.AutoTags = append(facets.AutoTags, bucket)
+		}
+	}
+
+	totalPages := int((result.Total + int64(req.Limit) - 1) / int64(req.Limit))
+	return &dto.VideoFacetSearchResponse{
+		Videos:     toVideoListItemResponses(result.Videos, req.Lang),
+		Facets:     facets,
+		Total:      result.Total,
+		Page:       req.Page,
+		Limit:      req.Limit,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// splitFacetValues "a, b,,c" → [a b c]
+func splitFacetValues(raw string) []string {
+	var values []string
+	for _, v := range strings.Split(raw, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// parseFacetIDs ตัด id ซ้ำออก (โหมด AND เทียบ COUNT(DISTINCT) กับจำนวน id ถ้าซ้ำจะไม่มี video ไหนตรงเลย)
+func parseFacetIDs(raw string) ([]uuid.UUID, error) {
+	values := splitFacetValues(raw)
+	ids := make([]uuid.UUID, 0, len(values))
+	seen := make(map[uuid.UUID]bool, len(values))
+	for _, v := range values {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return nil, errors.New("invalid facet id")
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func facetValueSet(raw string) map[string]bool {
+	set := make(map[string]bool)
+	for _, v := range splitFacetValues(raw) {
+		set[strings.ToLower(v)] = true
+	}
+	return set
+}
@@ -0,0 +1,194 @@
+package serviceimpl
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/repositories"
+	"gofiber-template/pkg/logger"
+)
+
+// ========================================
+// Duplicate Detection / Merge
+// ========================================
+
+// Duplicate match keys
+const (
+	duplicateMatchCode      = "code"
+	duplicateMatchEmbed     = "embed"
+	duplicateMatchThumbnail = "thumbnail"
+)
+
+// FindDuplicates จัดกลุ่ม video ที่ code (normalize แล้ว), embed URL หรือ thumbnail ตรงกัน
+// video ที่ตรงกันคนละเกณฑ์จะถูกรวมเป็นกลุ่มเดียว (A=B ด้วย code, B=C ด้วย embed → A,B,C)
+// จัดกลุ่ม + แบ่งหน้าใน SQL (ListDuplicateGroups) ที่นี่แค่ประกอบ response และเลือก video ที่ควรเก็บไว้
+func (s *VideoServiceImpl) FindDuplicates(ctx context.Context, params *dto.VideoDuplicateListParams) ([]dto.VideoDuplicateGroupResponse, int64, error) {
+	params.SetDefaults()
+
+	rows, total, err := s.videoRepo.ListDuplicateGroups(ctx, params.Match, params.Limit, (params.Page-1)*params.Limit)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list duplicate groups", "error", err)
+		return nil, 0, err
+	}
+
+	// rows เรียงตามกลุ่มมาแล้ว (video ในกลุ่มเดียวกันติดกัน เก่าก่อน)
+	groups := make([]dto.VideoDuplicateGroupResponse, 0, params.Limit)
+	for start := 0; start < len(rows); {
+		end := start
+		for end < len(rows) && rows[end].GroupKey == rows[start].GroupKey {
+			end++
+		}
+		groups = append(groups, duplicateGroupResponse(rows[start:end]))
+		start = end
+	}
+
+	return groups, total, nil
+}
+
+// duplicateGroupResponse ประกอบ 1 กลุ่ม + เลือก video ที่ข้อมูลครบสุดเป็น target
+func duplicateGroupResponse(rows []repositories.VideoDuplicateRow) dto.VideoDuplicateGroupResponse {
+	groupReasons := make(map[string]bool)
+	best := 0
+	videos := make([]dto.VideoDuplicateItemResponse, 0, len(rows))
+	for i, row := range rows {
+		for _, match := range row.Reasons {
+			groupReasons[match] = true
+		}
+		if betterMergeTarget(row, rows[best]) {
+			best = i
+		}
+		videos = append(videos, dto.VideoDuplicateItemResponse{
+			ID:           row.ID.String(),
+			Code:         row.Code,
+			Title:        row.Title,
+			Thumbnail:    row.Thumbnail,
+			EmbedURL:     row.EmbedURL,
+			Views:        row.Views,
+			HasReel:      row.HasReel,
+			Translations: row.Translations,
+			Articles:     row.Articles,
+			CreatedAt:    row.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	reasonList := make([]string, 0, len(groupReasons))
+	for _, match := range []string{duplicateMatchCode, duplicateMatchEmbed, duplicateMatchThumbnail} {
+		if groupReasons[match] {
+			reasonList = append(reasonList, match)
+		}
+	}
+
+	return dto.VideoDuplicateGroupResponse{
+		Reasons:           reasonList,
+		SuggestedTargetID: rows[best].ID.String(),
+		Videos:            videos,
+	}
+}
+
+// betterMergeTarget - video ที่มีบทความ/คำแปล/reel มากกว่าควรถูกเก็บไว้ (เท่ากัน = ยอดวิวมากกว่า)
+func betterMergeTarget(a, b repositories.VideoDuplicateRow) bool {
+	if a.Articles != b.Articles {
+		return a.Articles > b.Articles
+	}
+	if a.Translations != b.Translations {
+		return a.Translations > b.Translations
+	}
+	if a.HasReel != b.HasReel {
+		return a.HasReel
+	}
+	return a.Views > b.Views
+}
+
+// MergeVideos ย้าย translations, casts, tags, categories, reels, บทความ (รวม comment/like) ของ source ไปที่ target
+// แล้วลบ source ทิ้ง ID เดิมจะ redirect ไป target
+// thumbnail ของ source ไม่ถูกลบจาก storage (video อื่นอาจใช้ path เดียวกัน)
+func (s *VideoServiceImpl) MergeVideos(ctx context.Context, actorID uuid.UUID, req *dto.MergeVideosRequest) (*dto.MergeVideosResponse, error) {
+	targetID, err := uuid.Parse(req.TargetID)
+	if err != nil {
+		return nil, errors.New("invalid video ID")
+	}
+
+	seen := map[uuid.UUID]bool{targetID: true}
+	sourceIDs := make([]uuid.UUID, 0, len(req.SourceIDs))
+	for _, raw := range req.SourceIDs {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, errors.New("invalid video ID")
+		}
+		if id == targetID {
+			return nil, errors.New("cannot merge video into itself")
+		}
+		if !seen[id] {
+			seen[id] = true
+			sourceIDs = append(sourceIDs, id)
+		}
+	}
+
+	for _, id := range append([]uuid.UUID{targetID}, sourceIDs...) {
+		if _, err := s.videoRepo.GetByID(ctx, id); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errors.New("video not found")
+			}
+			return nil, err
+		}
+	}
+
+	// บทความ worker มีได้ 1 เรื่องต่อ video + ภาษา → ให้ admin เลือกลบเรื่องที่ไม่ใช้ก่อน
+	conflicts, err := s.videoRepo.ArticleLanguageConflicts(ctx, append([]uuid.UUID{targetID}, sourceIDs...))
+	if err != nil {
+		return nil, err
+	}
+	if len(conflicts) > 0 {
+		logger.WarnContext(ctx, "Video merge blocked by article conflicts", "target_id", targetID, "languages", conflicts)
+		return nil, errors.New("article language conflict")
+	}
+
+	// บทความที่จะย้ายมา target (ดึงก่อน merge เพราะ source จะถูกลบ)
+	var movedArticles []models.Article
+	for _, id := range sourceIDs {
+		articles, err := s.articleRepo.ListByVideoID(ctx, id)
+		if err != nil {
+			logger.WarnContext(ctx, "Failed to get source video articles for cache invalidation", "video_id", id, "error", err)
+			continue
+		}
+		movedArticles = append(movedArticles, articles...)
+	}
+
+	result, err := s.videoRepo.MergeInto(ctx, targetID, sourceIDs, &actorID)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to merge videos", "target_id", targetID, "source_ids", sourceIDs, "error", err)
+		return nil, err
+	}
+
+	// บทความที่ย้ายมาแสดงข้อมูล video ใหม่ → ล้าง detail/list/feed + หน้า cast/tag/maker ของ target
+	if len(movedArticles) > 0 {
+		target, err := s.videoRepo.GetWithRelations(ctx, targetID)
+		if err != nil {
+			target = nil
+		}
+		invalidateArticlePages(ctx, s.cache, movedArticles, target)
+	}
+
+	removed := make([]string, len(sourceIDs))
+	for i, id := range sourceIDs {
+		removed[i] = id.String()
+	}
+	logger.InfoContext(ctx, "Videos merged", "target_id", targetID, "removed", removed, "actor_id", actorID)
+
+	return &dto.MergeVideosResponse{
+		TargetID:     targetID.String(),
+		RemovedIDs:   removed,
+		Translations: result.Translations,
+		Casts:        result.Casts,
+		Tags:         result.Tags,
+		Categories:   result.Categories,
+		Reels:        result.Reels,
+		Articles:     result.Articles,
+	}, nil
+}
@@ -14,7 +14,9 @@ import (
 	"gofiber-template/domain/ports"
 	"gofiber-template/domain/repositories"
 	"gofiber-template/domain/services"
+	"gofiber-template/infrastructure/redis"
 	"gofiber-template/pkg/logger"
+	"gofiber-template/pkg/seo"
 	"gofiber-template/pkg/utils"
 )
 
@@ -25,7 +27,11 @@ type VideoServiceImpl struct {
 	tagRepo      repositories.TagRepository
 	autoTagRepo  repositories.AutoTagLabelRepository
 	categoryRepo repositories.CategoryRepository
+	articleRepo  repositories.ArticleRepository
+	seriesRepo   repositories.SeriesRepository
 	storage      ports.Storage
+	cache        *redis.RedisClient
+	jsonld       jsonldBuilder
 }
 
 func NewVideoService(
@@ -35,7 +41,11 @@ func NewVideoService(
 	tagRepo repositories.TagRepository,
 	autoTagRepo repositories.AutoTagLabelRepository,
 	categoryRepo repositories.CategoryRepository,
+	articleRepo repositories.ArticleRepository,
+	seriesRepo repositories.SeriesRepository,
 	storage ports.Storage,
+	cache *redis.RedisClient,
+	siteURL string,
 ) services.VideoService {
 	return &VideoServiceImpl{
 		videoRepo:    videoRepo,
@@ -44,7 +54,11 @@ func NewVideoService(
 		tagRepo:      tagRepo,
 		autoTagRepo:  autoTagRepo,
 		categoryRepo: categoryRepo,
+		articleRepo:  articleRepo,
+		seriesRepo:   seriesRepo,
 		storage:      storage,
+		cache:        cache,
+		jsonld:       newJSONLDBuilder(siteURL, storage),
 	}
 }
 
@@ -330,6 +344,18 @@ func (s *VideoServiceImpl) createVideoInternal(ctx context.Context, req *dto.Cre
 
 func (s *VideoServiceImpl) GetVideo(ctx context.Context, id uuid.UUID, lang string) (*dto.VideoResponse, error) {
 	video, err := s.videoRepo.GetWithRelations(ctx, id)
+	var redirect *dto.VideoRedirectTarget
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// ID ของ video ที่ถูก merge ไปแล้ว → ส่ง video ปลายทาง + redirect
+		if r, redirectErr := s.videoRepo.GetRedirect(ctx, id); redirectErr == nil {
+			video, err = s.videoRepo.GetWithRelations(ctx, r.ToVideoID)
+			redirect = &dto.VideoRedirectTarget{
+				VideoID:    r.ToVideoID.String(),
+				Path:       seo.VideoPath(lang, r.ToVideoID.String()),
+				StatusCode: 301,
+			}
+		}
+	}
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errors.New("video not found")
@@ -338,7 +364,17 @@ func (s *VideoServiceImpl) GetVideo(ctx context.Context, id uuid.UUID, lang stri
 		return nil, err
 	}
 
-	return s.toVideoResponse(ctx, video, lang), nil
+	response := s.toVideoResponse(ctx, video, lang)
+	response.Redirect = redirect
+
+	// rating/description มาจากรีวิวที่ published ของภาษานี้ (ถ้ามี)
+	review, _ := s.articleRepo.GetPublishedByVideoIDAndLanguage(ctx, video.ID, lang)
+	response.JSONLD = s.jsonld.VideoGraph(video, lang, review)
+
+	// ตอนอื่น ๆ ใน series เดียวกัน
+	response.Series = videoSeries(ctx, s.seriesRepo, video.ID, lang)
+
+	return response, nil
 }
 
 
@@ -447,46 +483,29 @@ func (s *VideoServiceImpl) DeleteVideo(ctx context.Context, id uuid.UUID) error
 		return err
 	}
 
-	// เก็บ IDs ไว้ก่อนลบ associations
-	categoryIDs := make([]uuid.UUID, len(video.Categories))
-	for i, cat := range video.Categories {
-		categoryIDs[i] = cat.ID
-	}
-
-	// ลบ thumbnail จาก R2 (ถ้ามี)
-	logger.InfoContext(ctx, "Checking thumbnail for deletion", "video_id", id, "thumbnail", video.Thumbnail, "storage_nil", s.storage == nil)
-	if video.Thumbnail != "" && s.storage != nil {
-		thumbnailPath := strings.TrimPrefix(video.Thumbnail, "/")
-		logger.InfoContext(ctx, "Deleting thumbnail from storage", "video_id", id, "path", thumbnailPath)
-		if err := s.storage.Delete(ctx, thumbnailPath); err != nil {
-			logger.WarnContext(ctx, "Failed to delete thumbnail from storage", "video_id", id, "path", thumbnailPath, "error", err)
-		} else {
-			logger.InfoContext(ctx, "Thumbnail deleted from storage", "video_id", id, "path", thumbnailPath)
-		}
-	}
-
-	// ลบ translations ก่อน (foreign key constraint)
-	if err := s.videoRepo.DeleteTranslations(ctx, id); err != nil {
-		logger.WarnContext(ctx, "Failed to delete translations", "video_id", id, "error", err)
-	}
-
-	// ลบ many2many associations (video_casts, video_tags, video_categories)
-	if err := s.videoRepo.ClearAssociations(ctx, id); err != nil {
-		logger.WarnContext(ctx, "Failed to clear associations", "video_id", id, "error", err)
+	// บทความที่จะเข้าถังขยะพร้อม video (ต้องล้าง cache หลังลบ)
+	articles, err := s.articleRepo.ListByVideoID(ctx, id)
+	if err != nil {
+		logger.WarnContext(ctx, "Failed to get video articles for cache invalidation", "video_id", id, "error", err)
 	}
 
-	// ลบ video record
+	// ย้ายเข้าถังขยะ (พร้อมบทความ/reels) - translations, associations และ thumbnail ใน R2
+	// ยังเก็บไว้สำหรับ restore จะถูกลบจริงตอน purge (TrashService)
 	if err := s.videoRepo.Delete(ctx, id); err != nil {
 		logger.ErrorContext(ctx, "Failed to delete video", "video_id", id, "error", err)
 		return err
 	}
 
-	// Update counts หลังลบ associations แล้ว
+	if len(articles) > 0 {
+		invalidateArticlePages(ctx, s.cache, articles, video)
+	}
+
+	// Update counts (video ในถังขยะไม่ถูกนับ, restore จะคำนวณใหม่)
 	if video.MakerID != nil {
 		_ = s.makerRepo.DecrementVideoCount(ctx, *video.MakerID)
 	}
-	for _, catID := range categoryIDs {
-		_ = s.categoryRepo.DecrementVideoCount(ctx, catID)
+	for _, cat := range video.Categories {
+		_ = s.categoryRepo.DecrementVideoCount(ctx, cat.ID)
 	}
 	for _, cast := range video.Casts {
 		_ = s.castRepo.DecrementVideoCount(ctx, cast.ID)
@@ -495,11 +514,72 @@ func (s *VideoServiceImpl) DeleteVideo(ctx context.Context, id uuid.UUID) error
 		_ = s.tagRepo.DecrementVideoCount(ctx, tag.ID)
 	}
 
-	logger.InfoContext(ctx, "Video deleted", "video_id", id)
+	logger.InfoContext(ctx, "Video moved to trash", "video_id", id)
 	return nil
 }
 
 func (s *VideoServiceImpl) ListVideos(ctx context.Context, req *dto.VideoListRequest) ([]dto.VideoListItemResponse, int64, error) {
+	params := videoListParams(ctx, req)
+	params.Offset = (req.Page - 1) * req.Limit
+
+	videos, total, err := s.videoRepo.List(ctx, params)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list videos", "error", err)
+		return nil, 0, err
+	}
+
+	return toVideoListItemResponses(videos, req.Lang), total, nil
+}
+
+// ListVideosAfter - filter/sort เดียวกับ ListVideos แต่แบ่งหน้าด้วย req.Cursor แทน page
+func (s *VideoServiceImpl) ListVideosAfter(ctx context.Context, req *dto.VideoListRequest) ([]dto.VideoListItemResponse, string, error) {
+	params := videoListParams(ctx, req)
+	// ดึงเกิน 1 แถวเพื่อรู้ว่ามีหน้าถัดไปหรือไม่
+	params.Limit = req.Limit + 1
+
+	// sort อยู่ใน cursor ด้วย จึงเอา cursor ของ sort หนึ่งไปใช้กับอีก sort ไม่ได้
+	sortBy, order := "created_at", "desc"
+	if params.SortBy == "date" || params.SortBy == "views" {
+		sortBy = params.SortBy
+	}
+	if strings.EqualFold(params.Order, "asc") {
+		order = "asc"
+	}
+	sort := "videos:" + sortBy + ":" + order
+	after, err := keysetFromCursor(req.Cursor, sort)
+	if err != nil {
+		return nil, "", err
+	}
+
+	videos, err := s.videoRepo.ListAfter(ctx, params, after)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list videos", "error", err)
+		return nil, "", err
+	}
+
+	nextCursor := ""
+	if len(videos) > req.Limit {
+		videos = videos[:req.Limit]
+		last := videos[req.Limit-1]
+		switch sortBy {
+		case "date":
+			releaseDate := repositories.VideoNoReleaseDate
+			if last.ReleaseDate != nil {
+				releaseDate = *last.ReleaseDate
+			}
+			nextCursor = timeCursor(sort, releaseDate, last.ID)
+		case "views":
+			nextCursor = intCursor(sort, int64(last.Views), last.ID)
+		default:
+			nextCursor = timeCursor(sort, last.CreatedAt, last.ID)
+		}
+	}
+
+	return toVideoListItemResponses(videos, req.Lang), nextCursor, nil
+}
+
+// videoListParams แปลง filter ของ request (ไม่รวมการแบ่งหน้า)
+func videoListParams(ctx context.Context, req *dto.VideoListRequest) repositories.VideoListParams {
 	// Parse auto_tags
 	var autoTags []string
 	if req.AutoTags != "" {
@@ -523,25 +603,22 @@ func (s *VideoServiceImpl) ListVideos(ctx context.Context, req *dto.VideoListReq
 	}
 
 	params := repositories.VideoListParams{
-		Limit:     req.Limit,
-		Offset:    (req.Page - 1) * req.Limit,
-		Lang:      req.Lang,
-		Search:    searchQuery,
-		Category:  req.Category,
-		MakerID:   makerID,
-		AutoTags:  autoTags,
-		SortBy:    req.SortBy,
-		Order:     req.Order,
-		MissingTh: req.MissingTh,
+		Limit:       req.Limit,
+		Lang:        req.Lang,
+		Search:      searchQuery,
+		Category:    req.Category,
+		MakerID:     makerID,
+		AutoTags:    autoTags,
+		SortBy:      req.SortBy,
+		Order:       req.Order,
+		MissingLang: req.MissingLang,
 	}
-
-	videos, total, err := s.videoRepo.List(ctx, params)
-	if err != nil {
-		logger.ErrorContext(ctx, "Failed to list videos", "error", err)
-		return nil, 0, err
+	// missing_th เดิมยังใช้ได้
+	if params.MissingLang == "" && req.MissingTh {
+		params.MissingLang = "th"
 	}
 
-	return s.toVideoListItemResponses(videos, req.Lang), total, nil
+	return params
 }
 
 func (s *VideoServiceImpl) GetRandomVideos(ctx context.Context, limit int, lang string) ([]dto.VideoListItemResponse, error) {
@@ -551,7 +628,7 @@ func (s *VideoServiceImpl) GetRandomVideos(ctx context.Context, limit int, lang
 		return nil, err
 	}
 
-	return s.toVideoListItemResponses(videos, lang), nil
+	return toVideoListItemResponses(videos, lang), nil
 }
 
 func (s *VideoServiceImpl) SearchVideos(ctx context.Context, query string, lang string, page int, limit int) ([]dto.VideoListItemResponse, int64, error) {
@@ -570,7 +647,7 @@ func (s *VideoServiceImpl) SearchVideos(ctx context.Context, query string, lang
 		return nil, 0, err
 	}
 
-	return s.toVideoListItemResponses(videos, lang), total, nil
+	return toVideoListItemResponses(videos, lang), total, nil
 }
 
 func (s *VideoServiceImpl) GetVideosByMaker(ctx context.Context, makerID uuid.UUID, lang string, page int, limit int) ([]dto.VideoListItemResponse, int64, error) {
@@ -581,7 +658,7 @@ func (s *VideoServiceImpl) GetVideosByMaker(ctx context.Context, makerID uuid.UU
 		return nil, 0, err
 	}
 
-	return s.toVideoListItemResponses(videos, lang), total, nil
+	return toVideoListItemResponses(videos, lang), total, nil
 }
 
 func (s *VideoServiceImpl) GetVideosByCast(ctx context.Context, castID uuid.UUID, lang string, page int, limit int) ([]dto.VideoListItemResponse, int64, error) {
@@ -592,7 +669,7 @@ func (s *VideoServiceImpl) GetVideosByCast(ctx context.Context, castID uuid.UUID
 		return nil, 0, err
 	}
 
-	return s.toVideoListItemResponses(videos, lang), total, nil
+	return toVideoListItemResponses(videos, lang), total, nil
 }
 
 func (s *VideoServiceImpl) GetVideosByTag(ctx context.Context, tagID uuid.UUID, lang string, page int, limit int) ([]dto.VideoListItemResponse, int64, error) {
@@ -603,7 +680,7 @@ func (s *VideoServiceImpl) GetVideosByTag(ctx context.Context, tagID uuid.UUID,
 		return nil, 0, err
 	}
 
-	return s.toVideoListItemResponses(videos, lang), total, nil
+	return toVideoListItemResponses(videos, lang), total, nil
 }
 
 func (s *VideoServiceImpl) GetVideosByAutoTags(ctx context.Context, tags []string, lang string, page int, limit int) ([]dto.VideoListItemResponse, int64, error) {
@@ -614,7 +691,7 @@ func (s *VideoServiceImpl) GetVideosByAutoTags(ctx context.Context, tags []strin
 		return nil, 0, err
 	}
 
-	return s.toVideoListItemResponses(videos, lang), total, nil
+	return toVideoListItemResponses(videos, lang), total, nil
 }
 
 func (s *VideoServiceImpl) GetVideosByCategories(ctx context.Context, req *dto.VideosByCategoriesRequest) ([]dto.CategoryWithVideosResponse, error) {
@@ -630,7 +707,7 @@ func (s *VideoServiceImpl) GetVideosByCategories(ctx context.Context, req *dto.V
 	}
 
 	// Get all categories sorted by sort_order
-	categories, err := s.categoryRepo.List(ctx)
+	categories, err := s.categoryRepo.List(ctx, repositories.CategoryListParams{})
 	if err != nil {
 		logger.ErrorContext(ctx, "Failed to get categories", "error", err)
 		return nil, err
@@ -683,7 +760,7 @@ func (s *VideoServiceImpl) GetVideosByCategories(ctx context.Context, req *dto.V
 
 		result = append(result, dto.CategoryWithVideosResponse{
 			Category: categoryResp,
-			Videos:   s.toVideoListItemResponses(videos, lang),
+			Videos:   toVideoListItemResponses(videos, lang),
 		})
 	}
 
@@ -821,7 +898,7 @@ func (s *VideoServiceImpl) toVideoResponse(ctx context.Context, video *models.Vi
 	}
 }
 
-func (s *VideoServiceImpl) toVideoListItemResponses(videos []models.Video, lang string) []dto.VideoListItemResponse {
+func toVideoListItemResponses(videos []models.Video, lang string) []dto.VideoListItemResponse {
 	result := make([]dto.VideoListItemResponse, 0, len(videos))
 	for _, v := range videos {
 		title := ""
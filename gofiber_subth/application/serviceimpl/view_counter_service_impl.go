@@ -0,0 +1,110 @@
+package serviceimpl
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/repositories"
+	"gofiber-template/domain/services"
+	"gofiber-template/infrastructure/redis"
+	"gofiber-template/pkg/logger"
+)
+
+// ViewDedupWindow - visitor เดิมดูซ้ำภายในช่วงนี้จะไม่ถูกนับเพิ่ม
+const ViewDedupWindow = 30 * time.Minute
+
+type viewCounterServiceImpl struct {
+	articleRepo repositories.ArticleRepository
+	videoRepo   repositories.VideoRepository
+	counter     *redis.ViewCounter
+}
+
+func NewViewCounterService(
+	articleRepo repositories.ArticleRepository,
+	videoRepo repositories.VideoRepository,
+	counter *redis.ViewCounter,
+) services.ViewCounterService {
+	return &viewCounterServiceImpl{
+		articleRepo: articleRepo,
+		videoRepo:   videoRepo,
+		counter:     counter,
+	}
+}
+
+func (s *viewCounterServiceImpl) RecordArticleView(ctx context.Context, articleID uuid.UUID, visitorID string) (*dto.ViewCountResponse, error) {
+	return s.record(ctx, redis.ViewTargetArticle, articleID, visitorID)
+}
+
+func (s *viewCounterServiceImpl) RecordVideoView(ctx context.Context, videoID uuid.UUID, visitorID string) (*dto.ViewCountResponse, error) {
+	return s.record(ctx, redis.ViewTargetVideo, videoID, visitorID)
+}
+
+// record - ไม่แตะ DB เลย (id ที่ไม่มีอยู่จริงจะถูกข้ามตอน flush)
+func (s *viewCounterServiceImpl) record(ctx context.Context, target string, id uuid.UUID, visitorID string) (*dto.ViewCountResponse, error) {
+	dedupKey := s.counter.GetDedupKey(target, id, visitorID)
+	if !s.counter.MarkViewed(ctx, dedupKey, ViewDedupWindow) {
+		logger.DebugContext(ctx, "View deduplicated", "target", target, "id", id)
+		return &dto.ViewCountResponse{Counted: false}, nil
+	}
+
+	if err := s.counter.Increment(ctx, target, id); err != nil {
+		logger.WarnContext(ctx, "Failed to increment view counter", "target", target, "id", id, "error", err)
+		return nil, err
+	}
+
+	return &dto.ViewCountResponse{Counted: true}, nil
+}
+
+// Flush ดึงยอดวิวจาก Redis แล้วบวกเข้า Postgres ทีละ target
+// ถ้าเขียน DB ไม่สำเร็จ ยอดจะค้างอยู่ใน Redis และถูก flush ใหม่รอบถัดไป
+func (s *viewCounterServiceImpl) Flush(ctx context.Context) (*dto.ViewFlushResult, error) {
+	result := &dto.ViewFlushResult{}
+
+	articles, articleViews, err := s.flushTarget(ctx, redis.ViewTargetArticle, s.articleRepo.IncrementViewCounts)
+	if err != nil {
+		return result, err
+	}
+	result.Articles, result.ArticleViews = articles, articleViews
+
+	videos, videoViews, err := s.flushTarget(ctx, redis.ViewTargetVideo, s.videoRepo.IncrementViews)
+	if err != nil {
+		return result, err
+	}
+	result.Videos, result.VideoViews = videos, videoViews
+
+	return result, nil
+}
+
+func (s *viewCounterServiceImpl) flushTarget(
+	ctx context.Context,
+	target string,
+	incrementFn func(context.Context, map[uuid.UUID]int64) error,
+) (int, int64, error) {
+	counts, err := s.counter.Drain(ctx, target)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to drain view counters", "target", target, "error", err)
+		return 0, 0, err
+	}
+	if len(counts) == 0 {
+		return 0, 0, nil
+	}
+
+	if err := incrementFn(ctx, counts); err != nil {
+		logger.ErrorContext(ctx, "Failed to write view counts", "target", target, "count", len(counts), "error", err)
+		return 0, 0, err
+	}
+
+	if err := s.counter.Ack(ctx, target); err != nil {
+		// ยอดลง DB แล้วแต่ลบ hash ไม่ได้ รอบหน้าจะบวกซ้ำ ต้อง log ไว้ตรวจสอบ
+		logger.ErrorContext(ctx, "Failed to ack flushed view counters", "target", target, "error", err)
+		return 0, 0, err
+	}
+
+	var total int64
+	for _, n := range counts {
+		total += n
+	}
+	return len(counts), total, nil
+}
@@ -0,0 +1,71 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"gofiber-template/domain/services"
+	"gofiber-template/infrastructure/redis"
+	"gofiber-template/pkg/logger"
+)
+
+const (
+	// ArticlePublisherJobID - ID ของ system job ใน EventScheduler
+	ArticlePublisherJobID = "system:article-publisher"
+	// ArticlePublisherCron - ตรวจ scheduled articles ทุก 1 นาที
+	ArticlePublisherCron = "* * * * *"
+	// ArticlePublisherLockKey - Redis lock กันไม่ให้หลาย replica รันรอบเดียวกันซ้ำ
+	ArticlePublisherLockKey = "lock:article_publisher"
+	// ArticlePublisherLockTTL - สั้นกว่ารอบ cron เล็กน้อย เพื่อให้ lock หมดก่อนรอบถัดไป
+	ArticlePublisherLockTTL = 50 * time.Second
+)
+
+// ArticlePublisher - system job สำหรับ publish บทความที่ถึงเวลา scheduled_at
+type ArticlePublisher struct {
+	articleService services.ArticleService
+	cache          *redis.RedisClient
+}
+
+func NewArticlePublisher(
+	articleService services.ArticleService,
+	cache *redis.RedisClient,
+) *ArticlePublisher {
+	return &ArticlePublisher{
+		articleService: articleService,
+		cache:          cache,
+	}
+}
+
+// Run - ถูกเรียกโดย EventScheduler ทุกรอบ cron
+func (p *ArticlePublisher) Run() {
+	ctx := context.Background()
+
+	if !p.acquireLock(ctx) {
+		logger.DebugContext(ctx, "Article publisher skipped, another replica holds the lock")
+		return
+	}
+
+	start := time.Now()
+	count, err := p.articleService.PublishScheduledArticles(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "Article publisher run failed", "error", err, "duration", time.Since(start))
+		return
+	}
+
+	logger.InfoContext(ctx, "Article publisher run completed", "published", count, "duration", time.Since(start))
+}
+
+// acquireLock - ใช้ SETNX + TTL ไม่ปล่อย lock เองเพื่อให้แต่ละรอบ cron รันได้แค่ replica เดียว
+// ถ้า Redis ใช้ไม่ได้ก็ยังรันต่อ เพราะ PublishIfScheduled เป็น conditional update อยู่แล้ว
+func (p *ArticlePublisher) acquireLock(ctx context.Context) bool {
+	if p.cache == nil {
+		return true
+	}
+
+	acquired, err := p.cache.SetNX(ctx, ArticlePublisherLockKey, time.Now().Unix(), ArticlePublisherLockTTL)
+	if err != nil {
+		logger.WarnContext(ctx, "Failed to acquire article publisher lock, running without lock", "error", err)
+		return true
+	}
+	return acquired
+}
@@ -0,0 +1,315 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/ports"
+	"gofiber-template/domain/repositories"
+	"gofiber-template/infrastructure/redis"
+	"gofiber-template/pkg/cache"
+	"gofiber-template/pkg/logger"
+	"gofiber-template/pkg/seo"
+)
+
+const (
+	// IndexingWorkerJobID - ID ของ system job ใน EventScheduler
+	IndexingWorkerJobID = "system:indexing-worker"
+	// IndexingWorkerCron - ส่ง URL ทุก 10 นาที
+	IndexingWorkerCron = "*/10 * * * *"
+	// IndexingWorkerLockKey - Redis lock กันหลาย replica ส่ง URL ซ้ำ
+	IndexingWorkerLockKey = "lock:indexing_worker"
+	// IndexingWorkerLockTTL - สั้นกว่ารอบ cron
+	IndexingWorkerLockTTL = 9 * time.Minute
+
+	// indexingMaxAttempts - จำนวนครั้งสูงสุดที่ลองส่ง URL เดิม (รวมครั้งแรก) ภายในรอบเดียว
+	indexingMaxAttempts = 3
+	// indexingRetryBaseDelay - backoff 2s, 4s, ...
+	indexingRetryBaseDelay = 2 * time.Second
+	// indexingMaxRuns - จำนวนรอบ cron ที่ส่งบทความเดิมไม่สำเร็จก่อน mark failed
+	indexingMaxRuns = 5
+	// indexingRunRetryBaseDelay - backoff ระหว่างรอบ 30m, 1h, 2h, 4h
+	indexingRunRetryBaseDelay = 30 * time.Minute
+	// indexingRecheckInterval - ตรวจสถานะ submitted ซ้ำได้ทุก 24 ชั่วโมง
+	indexingRecheckInterval = 24 * time.Hour
+	// indexingQuotaTTL - เก็บ counter ไว้เกิน 1 วันเล็กน้อย
+	indexingQuotaTTL = 48 * time.Hour
+)
+
+// IndexingWorker - system job ส่ง URL บทความที่ publish แล้วให้ search engine
+// และตรวจสถานะบทความที่ submit ไปแล้วว่าถูก index หรือยัง
+type IndexingWorker struct {
+	articleRepo     repositories.ArticleRepository
+	indexers        []ports.SearchIndexer
+	cache           *redis.RedisClient
+	siteURL         string
+	batchSize       int
+	verifyBatchSize int
+}
+
+func NewIndexingWorker(
+	articleRepo repositories.ArticleRepository,
+	indexers []ports.SearchIndexer,
+	cache *redis.RedisClient,
+	siteURL string,
+	batchSize int,
+	verifyBatchSize int,
+) *IndexingWorker {
+	if batchSize <= 0 {
+		batchSize = 50
+	}
+	if verifyBatchSize <= 0 {
+		verifyBatchSize = 20
+	}
+	return &IndexingWorker{
+		articleRepo:     articleRepo,
+		indexers:        indexers,
+		cache:           cache,
+		siteURL:         siteURL,
+		batchSize:       batchSize,
+		verifyBatchSize: verifyBatchSize,
+	}
+}
+
+// Run - ถูกเรียกโดย EventScheduler ทุกรอบ cron
+func (w *IndexingWorker) Run() {
+	ctx := context.Background()
+
+	if len(w.indexers) == 0 {
+		return
+	}
+
+	if !w.acquireLock(ctx) {
+		logger.DebugContext(ctx, "Indexing worker skipped, another replica holds the lock")
+		return
+	}
+
+	start := time.Now()
+	submitted, failed := w.submitPending(ctx)
+	indexed := w.verifySubmitted(ctx)
+
+	logger.InfoContext(ctx, "Indexing worker run completed",
+		"submitted", submitted,
+		"failed", failed,
+		"indexed", indexed,
+		"duration", time.Since(start),
+	)
+}
+
+// submitPending ส่งบทความ pending ไปทุก provider
+// - มี provider รับอย่างน้อย 1 ราย -> submitted
+// - ไม่มีใครรับและมี error -> คง pending ไว้ ส่งใหม่ตาม backoff จนครบ indexingMaxRuns รอบจึง failed
+// - ไม่ได้ส่งเลยเพราะ quota หมด -> คง pending ไว้รอบถัดไป (ไม่นับเป็นครั้งที่ล้มเหลว)
+func (w *IndexingWorker) submitPending(ctx context.Context) (submitted, failed int) {
+	articles, err := w.articleRepo.GetPendingIndexing(ctx, w.batchSize)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to get pending indexing articles", "error", err)
+		return 0, 0
+	}
+	if len(articles) == 0 {
+		return 0, 0
+	}
+
+	urls := make([]string, len(articles))
+	for i, a := range articles {
+		urls[i] = seo.ArticleURL(w.siteURL, a.Language, string(a.Type), a.Slug)
+	}
+
+	accepted := make([]bool, len(articles))
+	lastErr := make([]error, len(articles))
+
+	for _, indexer := range w.indexers {
+		allowed := w.reserveQuota(ctx, indexer, len(urls))
+		if allowed == 0 {
+			logger.WarnContext(ctx, "Indexing quota exhausted", "provider", indexer.Name())
+			continue
+		}
+
+		results := w.submitWithRetry(ctx, indexer, urls[:allowed])
+		for i := 0; i < allowed; i++ {
+			if err := results[urls[i]]; err != nil {
+				lastErr[i] = err
+				logger.WarnContext(ctx, "Failed to submit URL for indexing",
+					"provider", indexer.Name(),
+					"url", urls[i],
+					"error", err,
+				)
+				continue
+			}
+			accepted[i] = true
+		}
+	}
+
+	for i, a := range articles {
+		status := models.IndexingPending
+		switch {
+		case accepted[i]:
+			status = models.IndexingSubmitted
+			submitted++
+		case lastErr[i] != nil:
+			attempts := a.IndexingAttempts + 1
+			if attempts < indexingMaxRuns {
+				w.scheduleRetry(ctx, &a, attempts)
+				continue
+			}
+			status = models.IndexingFailed
+			failed++
+			logger.WarnContext(ctx, "Indexing gave up after max attempts", "article_id", a.ID, "attempts", attempts, "error", lastErr[i])
+		default:
+			continue
+		}
+
+		if err := w.articleRepo.UpdateIndexingStatus(ctx, a.ID, status); err != nil {
+			logger.ErrorContext(ctx, "Failed to update indexing status", "article_id", a.ID, "status", status, "error", err)
+		}
+	}
+
+	return submitted, failed
+}
+
+// scheduleRetry คง pending ไว้และเลื่อนการส่งรอบถัดไปแบบ exponential backoff
+func (w *IndexingWorker) scheduleRetry(ctx context.Context, a *models.Article, attempts int) {
+	retryAt := time.Now().Add(indexingRunRetryBaseDelay * time.Duration(1<<(attempts-1)))
+	if err := w.articleRepo.ScheduleIndexingRetry(ctx, a.ID, attempts, retryAt); err != nil {
+		logger.ErrorContext(ctx, "Failed to schedule indexing retry", "article_id", a.ID, "attempts", attempts, "error", err)
+		return
+	}
+	logger.DebugContext(ctx, "Indexing retry scheduled", "article_id", a.ID, "attempts", attempts, "retry_at", retryAt)
+}
+
+// submitWithRetry ส่ง URL และลองซ้ำเฉพาะ URL ที่ได้ error ชั่วคราว (429/5xx/network) ด้วย exponential backoff
+// returns: error สุดท้ายของแต่ละ URL (nil = สำเร็จ)
+func (w *IndexingWorker) submitWithRetry(ctx context.Context, indexer ports.SearchIndexer, urls []string) map[string]error {
+	results := make(map[string]error, len(urls))
+	pending := urls
+
+	for attempt := 1; attempt <= indexingMaxAttempts && len(pending) > 0; attempt++ {
+		var retry []string
+		for _, r := range indexer.Submit(ctx, pending) {
+			results[r.URL] = r.Err
+			if r.Err != nil && isRetryableIndexingError(r.Err) {
+				retry = append(retry, r.URL)
+			}
+		}
+
+		if len(retry) == 0 || attempt == indexingMaxAttempts {
+			break
+		}
+
+		delay := indexingRetryBaseDelay * time.Duration(1<<(attempt-1))
+		logger.DebugContext(ctx, "Retrying indexing submission",
+			"provider", indexer.Name(),
+			"urls", len(retry),
+			"attempt", attempt+1,
+			"delay", delay,
+		)
+
+		select {
+		case <-ctx.Done():
+			return results
+		case <-time.After(delay):
+		}
+		pending = retry
+	}
+
+	return results
+}
+
+// verifySubmitted ตรวจบทความ submitted กับ provider ที่รองรับการตรวจสถานะ
+func (w *IndexingWorker) verifySubmitted(ctx context.Context) int {
+	var checkers []ports.IndexStatusChecker
+	for _, indexer := range w.indexers {
+		if checker, ok := indexer.(ports.IndexStatusChecker); ok {
+			checkers = append(checkers, checker)
+		}
+	}
+	if len(checkers) == 0 {
+		return 0
+	}
+
+	articles, err := w.articleRepo.GetSubmittedIndexing(ctx, time.Now().Add(-indexingRecheckInterval), w.verifyBatchSize)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to get submitted indexing articles", "error", err)
+		return 0
+	}
+
+	indexed := 0
+	for _, a := range articles {
+		url := seo.ArticleURL(w.siteURL, a.Language, string(a.Type), a.Slug)
+
+		found := false
+		for _, checker := range checkers {
+			ok, err := checker.IsIndexed(ctx, url)
+			if err != nil {
+				logger.WarnContext(ctx, "Failed to check indexing status", "url", url, "error", err)
+				continue
+			}
+			if ok {
+				found = true
+				break
+			}
+		}
+
+		if found {
+			err = w.articleRepo.UpdateIndexingStatus(ctx, a.ID, models.IndexingIndexed)
+			indexed++
+		} else {
+			err = w.articleRepo.MarkIndexingChecked(ctx, a.ID)
+		}
+		if err != nil {
+			logger.ErrorContext(ctx, "Failed to update indexing check", "article_id", a.ID, "error", err)
+		}
+	}
+
+	return indexed
+}
+
+// reserveQuota จอง quota รายวันของ provider ทีละ URL (counter ใน Redis, รีเซ็ตตามวัน UTC)
+// returns: จำนวน URL ที่ส่งได้ในรอบนี้
+func (w *IndexingWorker) reserveQuota(ctx context.Context, indexer ports.SearchIndexer, want int) int {
+	quota := indexer.DailyQuota()
+	if quota <= 0 || w.cache == nil {
+		return want
+	}
+
+	key := cache.IndexingQuotaKey(indexer.Name(), time.Now().UTC().Format("2006-01-02"))
+	for i := 0; i < want; i++ {
+		used, err := w.cache.Increment(ctx, key)
+		if err != nil {
+			// Redis ใช้ไม่ได้ -> ไม่ส่งต่อ ดีกว่าส่งเกิน quota
+			logger.WarnContext(ctx, "Failed to reserve indexing quota", "provider", indexer.Name(), "error", err)
+			return i
+		}
+		if used == 1 {
+			_ = w.cache.Expire(ctx, key, indexingQuotaTTL)
+		}
+		if used > int64(quota) {
+			return i
+		}
+	}
+	return want
+}
+
+func (w *IndexingWorker) acquireLock(ctx context.Context) bool {
+	if w.cache == nil {
+		return true
+	}
+
+	acquired, err := w.cache.SetNX(ctx, IndexingWorkerLockKey, time.Now().Unix(), IndexingWorkerLockTTL)
+	if err != nil {
+		logger.WarnContext(ctx, "Failed to acquire indexing worker lock, running without lock", "error", err)
+		return true
+	}
+	return acquired
+}
+
+// isRetryableIndexingError - error ที่ไม่ใช่ IndexingError (เช่น network timeout) ถือว่าลองซ้ำได้
+func isRetryableIndexingError(err error) bool {
+	var indexingErr *ports.IndexingError
+	if errors.As(err, &indexingErr) {
+		return indexingErr.Retryable()
+	}
+	return true
+}
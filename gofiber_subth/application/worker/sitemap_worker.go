@@ -0,0 +1,82 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"gofiber-template/domain/services"
+	"gofiber-template/infrastructure/redis"
+	"gofiber-template/pkg/logger"
+)
+
+const (
+	// SitemapWorkerJobID - ID ของ system job ใน EventScheduler
+	SitemapWorkerJobID = "system:sitemap-builder"
+	// SitemapWorkerCron - rebuild ทุกชั่วโมง (นาทีที่ 15 เลี่ยงชนกับ job ต้นชั่วโมง)
+	SitemapWorkerCron = "15 * * * *"
+	// SitemapWorkerLockKey - Redis lock กันหลาย replica rebuild พร้อมกัน
+	SitemapWorkerLockKey = "lock:sitemap_worker"
+	// SitemapWorkerLockTTL - สั้นกว่ารอบ cron
+	SitemapWorkerLockTTL = 50 * time.Minute
+)
+
+// SitemapWorker - system job สร้าง sitemap ใหม่ตามรอบ
+type SitemapWorker struct {
+	sitemapService services.SitemapService
+	cache          *redis.RedisClient
+}
+
+func NewSitemapWorker(
+	sitemapService services.SitemapService,
+	cache *redis.RedisClient,
+) *SitemapWorker {
+	return &SitemapWorker{
+		sitemapService: sitemapService,
+		cache:          cache,
+	}
+}
+
+// Run - ถูกเรียกโดย EventScheduler ทุกรอบ cron
+func (w *SitemapWorker) Run() {
+	ctx := context.Background()
+
+	if !w.acquireLock(ctx) {
+		logger.DebugContext(ctx, "Sitemap worker skipped, another replica holds the lock")
+		return
+	}
+
+	start := time.Now()
+	result, err := w.sitemapService.Rebuild(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "Sitemap worker run failed", "error", err, "duration", time.Since(start))
+		return
+	}
+
+	logger.InfoContext(ctx, "Sitemap worker run completed",
+		"files", len(result.Files),
+		"urls", result.TotalURLs,
+		"duration", time.Since(start),
+	)
+}
+
+// RunOnStartup build ทันทีถ้ายังไม่มี sitemap ให้เสิร์ฟ (deploy ครั้งแรก / Redis + storage ว่าง)
+// ไม่งั้น endpoint ตอบ 404 จนถึงรอบ cron ถัดไป - ใช้ lock เดียวกับ Run จึง build แค่ replica เดียว
+func (w *SitemapWorker) RunOnStartup() {
+	if w.sitemapService.HasIndex(context.Background()) {
+		return
+	}
+	w.Run()
+}
+
+func (w *SitemapWorker) acquireLock(ctx context.Context) bool {
+	if w.cache == nil {
+		return true
+	}
+
+	acquired, err := w.cache.SetNX(ctx, SitemapWorkerLockKey, time.Now().Unix(), SitemapWorkerLockTTL)
+	if err != nil {
+		logger.WarnContext(ctx, "Failed to acquire sitemap worker lock, running without lock", "error", err)
+		return true
+	}
+	return acquired
+}
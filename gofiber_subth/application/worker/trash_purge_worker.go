@@ -0,0 +1,79 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"gofiber-template/domain/services"
+	"gofiber-template/infrastructure/redis"
+	"gofiber-template/pkg/logger"
+)
+
+const (
+	// TrashPurgeWorkerJobID - ID ของ system job ใน EventScheduler
+	TrashPurgeWorkerJobID = "system:trash-purge"
+	// TrashPurgeWorkerCron - ลบถาวรรายการที่หมดเวลาเก็บทุกชั่วโมง (นาทีที่ 20)
+	TrashPurgeWorkerCron = "20 * * * *"
+	// TrashPurgeWorkerLockKey - Redis lock กันหลาย replica purge พร้อมกัน
+	TrashPurgeWorkerLockKey = "lock:trash_purge_worker"
+	// TrashPurgeWorkerLockTTL - สั้นกว่ารอบ cron
+	TrashPurgeWorkerLockTTL = 55 * time.Minute
+)
+
+// TrashPurgeWorker - system job ลบถาวร video/article/reel ที่อยู่ในถังขยะเกิน retention + ล้างไฟล์ใน storage
+type TrashPurgeWorker struct {
+	trashService services.TrashService
+	cache        *redis.RedisClient
+}
+
+func NewTrashPurgeWorker(
+	trashService services.TrashService,
+	cache *redis.RedisClient,
+) *TrashPurgeWorker {
+	return &TrashPurgeWorker{
+		trashService: trashService,
+		cache:        cache,
+	}
+}
+
+// Run - ถูกเรียกโดย EventScheduler ทุกรอบ cron
+func (w *TrashPurgeWorker) Run() {
+	ctx := context.Background()
+
+	if !w.acquireLock(ctx) {
+		logger.DebugContext(ctx, "Trash purge worker skipped, another replica holds the lock")
+		return
+	}
+
+	start := time.Now()
+	result, err := w.trashService.PurgeExpired(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "Trash purge failed", "error", err, "duration", time.Since(start))
+		return
+	}
+
+	if result.Videos+result.Articles+result.Reels+result.Failed == 0 {
+		return
+	}
+
+	logger.InfoContext(ctx, "Expired trash purged",
+		"videos", result.Videos,
+		"articles", result.Articles,
+		"reels", result.Reels,
+		"failed", result.Failed,
+		"duration", time.Since(start),
+	)
+}
+
+func (w *TrashPurgeWorker) acquireLock(ctx context.Context) bool {
+	if w.cache == nil {
+		return true
+	}
+
+	acquired, err := w.cache.SetNX(ctx, TrashPurgeWorkerLockKey, time.Now().Unix(), TrashPurgeWorkerLockTTL)
+	if err != nil {
+		logger.WarnContext(ctx, "Failed to acquire trash purge worker lock, running without lock", "error", err)
+		return true
+	}
+	return acquired
+}
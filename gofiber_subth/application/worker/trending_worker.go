@@ -0,0 +1,73 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"gofiber-template/domain/services"
+	"gofiber-template/infrastructure/redis"
+	"gofiber-template/pkg/logger"
+)
+
+const (
+	// TrendingWorkerJobID - ID ของ system job ใน EventScheduler
+	TrendingWorkerJobID = "system:trending-refresh"
+	// TrendingWorkerCron - คำนวณคะแนน trending ใหม่ทุก 15 นาที
+	TrendingWorkerCron = "*/15 * * * *"
+	// TrendingWorkerLockKey - Redis lock กันหลาย replica คำนวณพร้อมกัน
+	TrendingWorkerLockKey = "lock:trending_worker"
+	// TrendingWorkerLockTTL - สั้นกว่ารอบ cron
+	TrendingWorkerLockTTL = 14 * time.Minute
+)
+
+// TrendingWorker - system job คำนวณอันดับ trending จาก activity แล้วเก็บลง Redis
+type TrendingWorker struct {
+	trendingService services.TrendingService
+	cache           *redis.RedisClient
+}
+
+func NewTrendingWorker(
+	trendingService services.TrendingService,
+	cache *redis.RedisClient,
+) *TrendingWorker {
+	return &TrendingWorker{
+		trendingService: trendingService,
+		cache:           cache,
+	}
+}
+
+// Run - ถูกเรียกโดย EventScheduler ทุกรอบ cron
+func (w *TrendingWorker) Run() {
+	ctx := context.Background()
+
+	if !w.acquireLock(ctx) {
+		logger.DebugContext(ctx, "Trending worker skipped, another replica holds the lock")
+		return
+	}
+
+	start := time.Now()
+	result, err := w.trendingService.Refresh(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "Trending refresh failed", "error", err, "duration", time.Since(start))
+		return
+	}
+
+	logger.InfoContext(ctx, "Trending scores refreshed",
+		"sets", result.Sets,
+		"items", result.Items,
+		"duration", time.Since(start),
+	)
+}
+
+func (w *TrendingWorker) acquireLock(ctx context.Context) bool {
+	if w.cache == nil {
+		return true
+	}
+
+	acquired, err := w.cache.SetNX(ctx, TrendingWorkerLockKey, time.Now().Unix(), TrendingWorkerLockTTL)
+	if err != nil {
+		logger.WarnContext(ctx, "Failed to acquire trending worker lock, running without lock", "error", err)
+		return true
+	}
+	return acquired
+}
@@ -0,0 +1,77 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"gofiber-template/domain/services"
+	"gofiber-template/infrastructure/redis"
+	"gofiber-template/pkg/logger"
+)
+
+const (
+	// ViewCounterWorkerJobID - ID ของ system job ใน EventScheduler
+	ViewCounterWorkerJobID = "system:view-counter-flush"
+	// ViewCounterWorkerCron - flush ยอดวิวจาก Redis ลง Postgres ทุกนาที
+	ViewCounterWorkerCron = "* * * * *"
+	// ViewCounterWorkerLockKey - Redis lock กันหลาย replica flush พร้อมกัน
+	ViewCounterWorkerLockKey = "lock:view_counter_worker"
+	// ViewCounterWorkerLockTTL - สั้นกว่ารอบ cron
+	ViewCounterWorkerLockTTL = 50 * time.Second
+)
+
+// ViewCounterWorker - system job flush ยอดวิวที่ buffer ไว้ใน Redis
+type ViewCounterWorker struct {
+	viewService services.ViewCounterService
+	cache       *redis.RedisClient
+}
+
+func NewViewCounterWorker(
+	viewService services.ViewCounterService,
+	cache *redis.RedisClient,
+) *ViewCounterWorker {
+	return &ViewCounterWorker{
+		viewService: viewService,
+		cache:       cache,
+	}
+}
+
+// Run - ถูกเรียกโดย EventScheduler ทุกรอบ cron
+func (w *ViewCounterWorker) Run() {
+	ctx := context.Background()
+
+	if !w.acquireLock(ctx) {
+		logger.DebugContext(ctx, "View counter worker skipped, another replica holds the lock")
+		return
+	}
+
+	start := time.Now()
+	result, err := w.viewService.Flush(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "View counter flush failed", "error", err, "duration", time.Since(start))
+		return
+	}
+
+	if result.Articles > 0 || result.Videos > 0 {
+		logger.InfoContext(ctx, "View counters flushed",
+			"articles", result.Articles,
+			"article_views", result.ArticleViews,
+			"videos", result.Videos,
+			"video_views", result.VideoViews,
+			"duration", time.Since(start),
+		)
+	}
+}
+
+func (w *ViewCounterWorker) acquireLock(ctx context.Context) bool {
+	if w.cache == nil {
+		return true
+	}
+
+	acquired, err := w.cache.SetNX(ctx, ViewCounterWorkerLockKey, time.Now().Unix(), ViewCounterWorkerLockTTL)
+	if err != nil {
+		logger.WarnContext(ctx, "Failed to acquire view counter worker lock, running without lock", "error", err)
+		return true
+	}
+	return acquired
+}
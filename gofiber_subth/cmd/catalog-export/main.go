@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gofiber-template/application/serviceimpl"
+	"gofiber-template/domain/dto"
+	"gofiber-template/infrastructure/postgres"
+	"gofiber-template/pkg/config"
+
+	"gorm.io/gorm/logger"
+)
+
+var (
+	format    string
+	gzipOut   bool
+	outFile   string
+	since     string
+	makerID   string
+	castID    string
+	tagID     string
+	category  string
+	seoStatus string
+	hasReel   string
+)
+
+func init() {
+	flag.StringVar(&format, "format", "jsonl", "File format: jsonl, csv")
+	flag.BoolVar(&gzipOut, "gzip", false, "Gzip compress output (default on when -out ends with .gz)")
+	flag.StringVar(&outFile, "out", "", "Output file (default stdout)")
+	flag.StringVar(&since, "since", "", "Incremental: only videos updated, deleted or merged at or after this RFC3339 time")
+	flag.StringVar(&makerID, "maker", "", "Filter by maker ID")
+	flag.StringVar(&castID, "cast", "", "Filter by cast ID")
+	flag.StringVar(&tagID, "tag", "", "Filter by tag ID")
+	flag.StringVar(&category, "category", "", "Filter by category slug")
+	flag.StringVar(&seoStatus, "seo-status", "", "Filter by SEO status: pending, draft, published")
+	flag.StringVar(&hasReel, "has-reel", "", "Filter by reel: true, false")
+}
+
+func main() {
+	flag.Parse()
+
+	if format != dto.CatalogFormatJSONL && format != dto.CatalogFormatCSV {
+		fmt.Fprintln(os.Stderr, "Usage: go run cmd/catalog-export/main.go [-format=jsonl|csv] [-out=catalog.jsonl.gz] [-since=2026-01-01T00:00:00Z]")
+		fmt.Fprintln(os.Stderr, "\nFlags:")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	req := &dto.CatalogExportRequest{
+		Format:    format,
+		Gzip:      gzipOut || strings.HasSuffix(outFile, ".gz"),
+		Since:     since,
+		MakerID:   makerID,
+		CastID:    castID,
+		TagID:     tagID,
+		Category:  category,
+		SEOStatus: seoStatus,
+		HasReel:   hasReel,
+	}
+
+	// Load config
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load config: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Initialize database
+	dbConfig := postgres.DatabaseConfig{
+		Host:     cfg.Database.Host,
+		Port:     cfg.Database.Port,
+		User:     cfg.Database.User,
+		Password: cfg.Database.Password,
+		DBName:   cfg.Database.DBName,
+		SSLMode:  cfg.Database.SSLMode,
+	}
+	db, err := postgres.NewDatabase(dbConfig)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to connect to database: %v\n", err)
+		os.Exit(1)
+	}
+	// output อาจเป็น stdout จึงปิด GORM log
+	db.Logger = logger.Default.LogMode(logger.Silent)
+
+	catalogService := serviceimpl.NewCatalogService(postgres.NewCatalogRepository(db))
+	if err := catalogService.Validate(req); err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid filter: %v\n", err)
+		os.Exit(1)
+	}
+
+	var out io.Writer = os.Stdout
+	if outFile != "" {
+		f, err := os.Create(outFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to create %s: %v\n", outFile, err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		out = f
+	}
+	w := bufio.NewWriterSize(out, 256*1024)
+
+	result, err := catalogService.Export(context.Background(), req, w)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Export failed: %v\n", err)
+		os.Exit(1)
+	}
+
+	// ข้อความสรุปไป stderr เพื่อไม่ปนกับข้อมูลเมื่อเขียนลง stdout
+	fmt.Fprintf(os.Stderr, "✓ Exported %d videos\n", result.Count)
+	if result.UpdatedAt != "" {
+		fmt.Fprintf(os.Stderr, "  Next incremental run: -since=%s\n", result.UpdatedAt)
+	}
+}
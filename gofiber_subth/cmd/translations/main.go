@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gofiber-template/application/serviceimpl"
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/services"
+	"gofiber-template/infrastructure/postgres"
+	"gofiber-template/pkg/config"
+
+	"gorm.io/gorm/logger"
+)
+
+var (
+	entityType string
+	lang       string
+	format     string
+	missing    bool
+	outFile    string
+	inFile     string
+	dryRun     bool
+)
+
+func init() {
+	flag.StringVar(&entityType, "type", "", "Entity type: video, cast, tag, category (export)")
+	flag.StringVar(&lang, "lang", "", "Language: en, th, ja (export)")
+	flag.StringVar(&format, "format", "", "File format: csv, jsonl (default from file extension, else csv)")
+	flag.BoolVar(&missing, "missing", false, "Export only entries without a translation")
+	flag.StringVar(&outFile, "out", "", "Output file (export, default stdout)")
+	flag.StringVar(&inFile, "in", "", "Input file (import, required)")
+	flag.BoolVar(&dryRun, "dry-run", false, "Validate and print diff without writing (import)")
+}
+
+func usage() {
+	fmt.Println("Usage:")
+	fmt.Println("  go run cmd/translations/main.go export -type=cast -lang=th [-missing] [-out=cast_th.csv]")
+	fmt.Println("  go run cmd/translations/main.go import -in=cast_th.csv [-dry-run]")
+	fmt.Println("\nFlags:")
+	flag.PrintDefaults()
+}
+
+func main() {
+	if len(os.Args) < 2 || (os.Args[1] != "export" && os.Args[1] != "import") {
+		usage()
+		os.Exit(1)
+	}
+	command := os.Args[1]
+	flag.CommandLine.Parse(os.Args[2:])
+
+	// Load config
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load config: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Initialize database
+	dbConfig := postgres.DatabaseConfig{
+		Host:     cfg.Database.Host,
+		Port:     cfg.Database.Port,
+		User:     cfg.Database.User,
+		Password: cfg.Database.Password,
+		DBName:   cfg.Database.DBName,
+		SSLMode:  cfg.Database.SSLMode,
+	}
+	db, err := postgres.NewDatabase(dbConfig)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to connect to database: %v\n", err)
+		os.Exit(1)
+	}
+	// export อาจเขียนลง stdout จึงปิด GORM log
+	db.Logger = logger.Default.LogMode(logger.Silent)
+
+	translationService := serviceimpl.NewTranslationService(postgres.NewTranslationRepository(db))
+	ctx := context.Background()
+
+	switch command {
+	case "export":
+		runExport(ctx, translationService)
+	case "import":
+		runImport(ctx, translationService)
+	}
+}
+
+// formatFromPath - ใช้ -format ถ้าระบุ ไม่งั้นดูจากนามสกุลไฟล์
+func formatFromPath(path string) string {
+	if format != "" {
+		return format
+	}
+	if strings.EqualFold(filepath.Ext(path), ".jsonl") {
+		return dto.TranslationFormatJSONL
+	}
+	return dto.TranslationFormatCSV
+}
+
+func runExport(ctx context.Context, translationService services.TranslationService) {
+	if entityType == "" || lang == "" {
+		usage()
+		os.Exit(1)
+	}
+
+	file, err := translationService.Export(ctx, &dto.TranslationExportRequest{
+		Type:    entityType,
+		Lang:    lang,
+		Format:  formatFromPath(outFile),
+		Missing: missing,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Export failed: %v\n", err)
+		os.Exit(1)
+	}
+
+	if outFile == "" {
+		os.Stdout.Write(file.Data)
+		return
+	}
+	if err := os.WriteFile(outFile, file.Data, 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to write %s: %v\n", outFile, err)
+		os.Exit(1)
+	}
+	fmt.Printf("✓ Exported %d %s translations (%s) to %s\n", file.Count, entityType, lang, outFile)
+}
+
+func runImport(ctx context.Context, translationService services.TranslationService) {
+	if inFile == "" {
+		usage()
+		os.Exit(1)
+	}
+
+	f, err := os.Open(inFile)
+	if err != nil {
+		fmt.Printf("Failed to open %s: %v\n", inFile, err)
+		os.Exit(1)
+	}
+	defer f.Close()
+
+	result, err := translationService.Import(ctx, &dto.TranslationImportRequest{
+		Format: formatFromPath(inFile),
+		DryRun: dryRun,
+	}, f)
+	if err != nil {
+		fmt.Printf("Import failed: %v\n", err)
+		os.Exit(1)
+	}
+
+	for _, change := range result.Changes {
+		fmt.Printf("%6d  %-6s %-8s %s [%s] %q → %q\n", change.Line, change.Op, change.Type, change.Key, change.Lang, change.From, change.To)
+	}
+	for _, e := range result.Errors {
+		fmt.Printf("%6d  ERROR  %s\n", e.Line, e.Message)
+	}
+
+	summary, _ := json.MarshalIndent(map[string]int{
+		"total":     result.Total,
+		"created":   result.Created,
+		"updated":   result.Updated,
+		"unchanged": result.Unchanged,
+		"skipped":   result.Skipped,
+		"invalid":   result.Invalid,
+	}, "", "  ")
+	fmt.Println(string(summary))
+
+	switch {
+	case result.Applied:
+		fmt.Println("✓ Translations imported")
+	case result.Invalid > 0:
+		fmt.Println("✗ Invalid rows found, nothing was written")
+		os.Exit(1)
+	case dryRun:
+		fmt.Println("Dry run, nothing was written")
+	default:
+		fmt.Println("No changes")
+	}
+}
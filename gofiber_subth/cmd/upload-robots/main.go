@@ -34,6 +34,12 @@ func main() {
 	robotsContent := `User-agent: *
 Allow: /
 `
+	// sitemap index ที่ backend สร้าง (SITEMAP_BASE_URL ว่าง = index อยู่ที่ frontend /sitemap.xml)
+	if cfg.Site.SitemapBaseURL != "" {
+		robotsContent += fmt.Sprintf("\nSitemap: %s/index.xml\n", strings.TrimRight(cfg.Site.SitemapBaseURL, "/"))
+	} else if cfg.Site.URL != "" {
+		robotsContent += fmt.Sprintf("\nSitemap: %s/sitemap.xml\n", strings.TrimRight(cfg.Site.URL, "/"))
+	}
 
 	// Upload robots.txt
 	ctx := context.Background()
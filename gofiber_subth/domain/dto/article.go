@@ -1,6 +1,10 @@
 package dto
 
-import "time"
+import (
+	"time"
+
+	"gofiber-template/pkg/seo"
+)
 
 // ========================================
 // Request DTOs
@@ -32,9 +36,11 @@ func (p *ArticleListParams) SetDefaults() {
 	}
 }
 
+// UpdateArticleStatusRequest - approved ตั้งผ่าน review decision เท่านั้น
 type UpdateArticleStatusRequest struct {
-	Status      string     `json:"status" validate:"required,oneof=draft scheduled published archived"`
+	Status      string     `json:"status" validate:"required,oneof=draft in_review scheduled published archived"`
 	ScheduledAt *time.Time `json:"scheduledAt,omitempty"`
+	Notes       string     `json:"notes" validate:"max=2000"` // บันทึกลงประวัติสถานะ
 }
 
 type BulkScheduleRequest struct {
@@ -76,7 +82,9 @@ type ArticleListItemResponse struct {
 	ReadingTime    int     `json:"readingTime"`
 	ScheduledAt    *string `json:"scheduledAt,omitempty"`
 	PublishedAt    *string `json:"publishedAt,omitempty"`
+	ReviewerID     *string `json:"reviewerId,omitempty"`
 	CreatedAt      string  `json:"createdAt"`
+	UpdatedAt      string  `json:"updatedAt"`
 }
 
 type ArticleDetailResponse struct {
@@ -97,6 +105,9 @@ type ArticleDetailResponse struct {
 	ScheduledAt     *string                `json:"scheduledAt,omitempty"`
 	PublishedAt     *string                `json:"publishedAt,omitempty"`
 	IndexedAt       *string                `json:"indexedAt,omitempty"`
+	ReviewerID      *string                `json:"reviewerId,omitempty"`
+	ReviewNotes     string                 `json:"reviewNotes,omitempty"`
+	ReviewedAt      *string                `json:"reviewedAt,omitempty"`
 	CreatedAt       string                 `json:"createdAt"`
 	UpdatedAt       string                 `json:"updatedAt"`
 }
@@ -104,9 +115,12 @@ type ArticleDetailResponse struct {
 type ArticleStatsResponse struct {
 	TotalArticles  int `json:"totalArticles"`
 	DraftCount     int `json:"draftCount"`
+	InReviewCount  int `json:"inReviewCount"`
+	ApprovedCount  int `json:"approvedCount"`
 	ScheduledCount int `json:"scheduledCount"`
 	PublishedCount int `json:"publishedCount"`
 	IndexedCount   int `json:"indexedCount"`
+	SubmittedIndex int `json:"submittedIndex"`
 	PendingIndex   int `json:"pendingIndex"`
 	FailedIndex    int `json:"failedIndex"`
 }
@@ -129,10 +143,16 @@ type PublicArticleResponse struct {
 	PublishedAt     string                 `json:"publishedAt"`
 	Translations    map[string]string      `json:"translations,omitempty"`   // slug ของแต่ละภาษา {"en": "...", "th": "..."}
 	RedirectSlug    string                 `json:"redirectSlug,omitempty"`   // redirect ไป slug ที่ถูกต้อง (fallback)
+	Redirect        *ArticleRedirectTarget `json:"redirect,omitempty"`       // canonical type/slug เมื่อต้อง redirect
+	Status          string                 `json:"status,omitempty"`         // ส่งเฉพาะ preview (draft/scheduled/...)
+	Preview         bool                   `json:"preview,omitempty"`        // true = เปิดผ่าน preview token (ห้าม index)
 	// Engagement counts
 	LikesCount    int `json:"likesCount"`
 	CommentsCount int `json:"commentsCount"`
 	ViewCount     int `json:"viewCount"`
+
+	// Schema.org structured data (Review / ItemList / Article + VideoObject)
+	JSONLD *seo.JSONLDGraph `json:"jsonLd,omitempty"`
 }
 
 // Public Article List (for SEO pages)
@@ -142,7 +162,7 @@ type PublicArticleListParams struct {
 	Type   string `query:"type"` // filter by article type (review, ranking, etc.)
 	Lang   string `query:"lang"`
 	Search string `query:"search"`
-	Sort   string `query:"sort"`  // published_at, updated_at (default: published_at)
+	Sort   string `query:"sort"`  // published_at, updated_at, view_count (default: published_at)
 	Order  string `query:"order"` // asc, desc (default: desc)
 }
 
@@ -176,6 +196,27 @@ type PublicArticleSummary struct {
 	Tags            []string `json:"tags,omitempty"`
 }
 
+// RelatedArticleParams - query ของ related articles
+type RelatedArticleParams struct {
+	Lang  string `query:"lang"`
+	Limit int    `query:"limit"`
+}
+
+func (p *RelatedArticleParams) SetDefaults() {
+	if p.Lang == "" {
+		p.Lang = "th"
+	}
+	if p.Limit < 1 || p.Limit > 24 {
+		p.Limit = 8
+	}
+}
+
+// RelatedArticleSummary - บทความแนะนำ ("อ่านต่อ") พร้อมคะแนนความเกี่ยวข้อง
+type RelatedArticleSummary struct {
+	PublicArticleSummary
+	Score float64 `json:"score"`
+}
+
 // ========================================
 // V3 Content Types (Intent-Driven Structure)
 // ========================================
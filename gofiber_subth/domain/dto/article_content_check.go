@@ -0,0 +1,24 @@
+package dto
+
+// ArticleContentCheckRequest - filter ของการตรวจ content ที่เก็บไว้แล้ว (ว่าง = ทั้งหมด)
+type ArticleContentCheckRequest struct {
+	Type   string `json:"type" validate:"omitempty,oneof=review ranking best-of guide news"`
+	Status string `json:"status" validate:"omitempty,oneof=draft in_review approved scheduled published archived"`
+}
+
+// ArticleContentViolation - บทความที่ content ไม่ตรง schema ของ type
+type ArticleContentViolation struct {
+	ID       string            `json:"id"`
+	Slug     string            `json:"slug"`
+	Type     string            `json:"type"`
+	Language string            `json:"language"`
+	Status   string            `json:"status"`
+	Errors   map[string]string `json:"errors"`
+}
+
+// ArticleContentCheckResponse - ผลการตรวจแบบ bulk
+type ArticleContentCheckResponse struct {
+	Checked    int                       `json:"checked"`
+	Invalid    int                       `json:"invalid"`
+	Violations []ArticleContentViolation `json:"violations"`
+}
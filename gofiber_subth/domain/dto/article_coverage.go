@@ -0,0 +1,155 @@
+package dto
+
+// ========================================
+// Article Coverage Report + "needs article" queue
+// ========================================
+
+// ArticleCoverageParams - filter ของรายงาน (slug ของ maker/cast/category)
+// maker/cast ใช้กรอง hub ด้วย, category/seo_status กรองเฉพาะ video
+type ArticleCoverageParams struct {
+	Maker     string `query:"maker"`
+	Cast      string `query:"cast"`
+	Category  string `query:"category"`
+	SEOStatus string `query:"seo_status" validate:"omitempty,oneof=pending draft published"`
+	HubLimit  int    `query:"hub_limit" validate:"omitempty,min=1,max=100"` // จำนวน hub ที่ขาดบทความที่จะแสดงต่อภาษา
+}
+
+func (p *ArticleCoverageParams) SetDefaults() {
+	if p.HubLimit < 1 {
+		p.HubLimit = 20
+	}
+}
+
+// ArticleCoverageMissingParams - video ที่ยังไม่มีบทความในภาษา lang
+type ArticleCoverageMissingParams struct {
+	ArticleCoverageParams
+	Lang  string `query:"lang" validate:"omitempty,oneof=th en"`
+	Page  int    `query:"page"`
+	Limit int    `query:"limit"`
+}
+
+func (p *ArticleCoverageMissingParams) SetDefaults() {
+	p.ArticleCoverageParams.SetDefaults()
+	if p.Lang == "" {
+		p.Lang = "th"
+	}
+	if p.Page < 1 {
+		p.Page = 1
+	}
+	if p.Limit < 1 || p.Limit > 100 {
+		p.Limit = 50
+	}
+}
+
+type ArticleCoverageResponse struct {
+	TotalVideos int64                      `json:"totalVideos"`
+	Languages   []LanguageCoverageResponse `json:"languages"`
+	Hubs        []HubCoverageResponse      `json:"hubs"`
+	Queue       map[string]int64           `json:"queue"` // จำนวนงานในคิวต่อสถานะ
+}
+
+type LanguageCoverageResponse struct {
+	Language    string                 `json:"language"`
+	WithArticle int64                  `json:"withArticle"`
+	Published   int64                  `json:"published"`
+	Missing     int64                  `json:"missing"`
+	Queued      int64                  `json:"queued"` // missing ที่อยู่ในคิวแล้ว (pending + leased)
+	ByType      []TypeCoverageResponse `json:"byType"`
+}
+
+type TypeCoverageResponse struct {
+	Type      string `json:"type"`
+	Total     int64  `json:"total"`
+	Published int64  `json:"published"`
+}
+
+// HubCoverageResponse - cast/maker ที่มีรีวิวถึงเกณฑ์ เทียบกับที่มีบทความรวมแล้ว
+type HubCoverageResponse struct {
+	Kind        string           `json:"kind"`        // cast, maker
+	ArticleKind string           `json:"articleKind"` // cast-ranking, maker-best-of (ตาม generator)
+	Language    string           `json:"language"`
+	Eligible    int64            `json:"eligible"`
+	Covered     int64            `json:"covered"`
+	Missing     []HubGapResponse `json:"missing"`
+}
+
+type HubGapResponse struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Slug       string `json:"slug"`
+	VideoCount int    `json:"videoCount"` // video ที่มีรีวิว published ในภาษานั้น
+}
+
+type MissingVideoResponse struct {
+	VideoID     string  `json:"videoId"`
+	Code        string  `json:"code"`
+	Title       string  `json:"title"`
+	SEOStatus   string  `json:"seoStatus"`
+	Views       int     `json:"views"`
+	ReleaseDate *string `json:"releaseDate,omitempty"`
+	QueueStatus string  `json:"queueStatus,omitempty"` // ว่าง = ยังไม่อยู่ในคิว
+}
+
+// EnqueueCoverageRequest - เพิ่ม video ที่ยังไม่มีบทความเข้าคิว (ใช้ filter เดียวกับรายงาน)
+type EnqueueCoverageRequest struct {
+	Maker     string   `json:"maker"`
+	Cast      string   `json:"cast"`
+	Category  string   `json:"category"`
+	SEOStatus string   `json:"seoStatus" validate:"omitempty,oneof=pending draft published"`
+	Languages []string `json:"languages" validate:"omitempty,dive,oneof=th en"` // ว่าง = th + en
+	Limit     int      `json:"limit" validate:"omitempty,min=1,max=5000"`       // ต่อภาษา (default 500)
+}
+
+type EnqueueCoverageResponse struct {
+	Enqueued map[string]int64 `json:"enqueued"` // จำนวนที่เพิ่ม/เปิดใหม่ต่อภาษา
+}
+
+// ========================================
+// Queue (SEO worker)
+// ========================================
+
+// ClaimArticleQueueRequest - worker ขอรับงาน
+type ClaimArticleQueueRequest struct {
+	WorkerID     string `json:"workerId" validate:"required,max=100"`
+	Language     string `json:"language" validate:"omitempty,oneof=th en"`
+	Limit        int    `json:"limit" validate:"omitempty,min=1,max=50"`           // default 1
+	LeaseSeconds int    `json:"leaseSeconds" validate:"omitempty,min=60,max=7200"` // default 900
+}
+
+// ArticleQueueLeaseRequest - ต่อ lease / คืนงาน (ต้องมี leaseToken จากตอน claim)
+type ArticleQueueLeaseRequest struct {
+	LeaseToken   string `json:"leaseToken" validate:"required,uuid"`
+	LeaseSeconds int    `json:"leaseSeconds" validate:"omitempty,min=60,max=7200"`
+}
+
+type ArticleQueueListParams struct {
+	Status string `query:"status" validate:"omitempty,oneof=pending leased done"`
+	Lang   string `query:"lang" validate:"omitempty,oneof=th en"`
+	Page   int    `query:"page"`
+	Limit  int    `query:"limit"`
+}
+
+func (p *ArticleQueueListParams) SetDefaults() {
+	if p.Page < 1 {
+		p.Page = 1
+	}
+	if p.Limit < 1 || p.Limit > 100 {
+		p.Limit = 50
+	}
+}
+
+type ArticleQueueItemResponse struct {
+	ID             string  `json:"id"`
+	VideoID        string  `json:"videoId"`
+	VideoCode      string  `json:"videoCode,omitempty"`
+	Language       string  `json:"language"`
+	Type           string  `json:"type"`
+	Status         string  `json:"status"`
+	Priority       int     `json:"priority"`
+	LeaseToken     string  `json:"leaseToken,omitempty"` // ส่งเฉพาะตอน claim
+	LeasedBy       string  `json:"leasedBy,omitempty"`
+	LeaseExpiresAt *string `json:"leaseExpiresAt,omitempty"`
+	Attempts       int     `json:"attempts"`
+	CompletedAt    *string `json:"completedAt,omitempty"`
+	CreatedAt      string  `json:"createdAt"`
+}
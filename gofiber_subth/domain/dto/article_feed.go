@@ -0,0 +1,28 @@
+package dto
+
+import "time"
+
+// Feed formats
+const (
+	ArticleFeedRSS  = "rss"
+	ArticleFeedAtom = "atom"
+)
+
+// ArticleFeedParams - query ของ feed endpoint
+type ArticleFeedParams struct {
+	Type string `query:"type"` // filter by article type (ใช้กับ feed รวมเท่านั้น)
+	Lang string `query:"lang"` // th (default), en
+}
+
+func (p *ArticleFeedParams) SetDefaults() {
+	if p.Lang == "" {
+		p.Lang = "th"
+	}
+}
+
+// ArticleFeedResponse - XML ที่ render แล้ว (เก็บทั้งก้อนใน cache)
+type ArticleFeedResponse struct {
+	Format    string    `json:"format"`
+	Data      string    `json:"data"`
+	UpdatedAt time.Time `json:"updatedAt"` // เวลาของบทความล่าสุดใน feed (ใช้ทำ Last-Modified)
+}
@@ -0,0 +1,48 @@
+package dto
+
+// ========================================
+// Article Generator (ranking / best-of / guide จาก catalogue)
+// ========================================
+
+// GenerateArticleRequest - admin สั่งสร้างบทความจากข้อมูลใน DB
+// cast-ranking = video ยอดนิยมของ cast, maker-best-of = ผลงานเด่นของค่าย, tag-guide = แนะนำ tag
+type GenerateArticleRequest struct {
+	Kind     string `json:"kind" validate:"required,oneof=cast-ranking maker-best-of tag-guide"`
+	Slug     string `json:"slug" validate:"required,max=255"` // slug ของ cast/maker/tag
+	Language string `json:"language" validate:"omitempty,oneof=th en"`
+	Limit    int    `json:"limit" validate:"omitempty,min=3,max=30"`
+	SortBy   string `json:"sortBy" validate:"omitempty,oneof=views rating"` // maker-best-of เท่านั้น (default: views)
+}
+
+// GenerateArticleResponse - บทความ draft ที่ถูกสร้าง/เขียนทับ
+type GenerateArticleResponse struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Slug       string `json:"slug"`
+	Language   string `json:"language"`
+	Title      string `json:"title"`
+	Status     string `json:"status"`
+	VideoCount int    `json:"videoCount"`
+	Created    bool   `json:"created"` // false = เขียนทับ draft เดิม
+}
+
+// GeneratedArticleContent - content ของบทความที่ generator สร้าง
+// ใช้โครงสร้าง V3 เดียวกับรีวิว (frontend render ได้เลย) + รายการ video
+type GeneratedArticleContent struct {
+	ArticleContentV3
+	RankingItems []GeneratedRankingItem `json:"rankingItems"`
+}
+
+// GeneratedRankingItem - video 1 รายการในบทความ (ลิงก์ไปหน้ารีวิว)
+type GeneratedRankingItem struct {
+	Position     int      `json:"position"`
+	VideoID      string   `json:"videoId"`
+	Code         string   `json:"code"`
+	Title        string   `json:"title"`
+	ThumbnailUrl string   `json:"thumbnailUrl"`
+	Views        int      `json:"views"`
+	Rating       float64  `json:"rating"`
+	Cast         []string `json:"cast"`
+	ReviewSlug   string   `json:"reviewSlug"`
+	ReleaseDate  string   `json:"releaseDate,omitempty"`
+}
@@ -0,0 +1,26 @@
+package dto
+
+// ========================================
+// Article Preview (ดูบทความ draft/scheduled บน frontend)
+// ========================================
+
+// CreateArticlePreviewRequest - ไม่ส่ง ttlMinutes = 60 นาที
+type CreateArticlePreviewRequest struct {
+	TTLMinutes int `json:"ttlMinutes" validate:"omitempty,min=5,max=1440"`
+}
+
+// ArticlePreviewTokenResponse - token ใช้กับ GET /articles/preview/:token
+type ArticlePreviewTokenResponse struct {
+	TokenID   string `json:"tokenId"` // ใช้ revoke ทีละ token
+	Token     string `json:"token"`
+	ArticleID string `json:"articleId"`
+	Type      string `json:"type"`
+	Slug      string `json:"slug"`
+	Language  string `json:"language"`
+	ExpiresAt string `json:"expiresAt"`
+}
+
+// RevokeArticlePreviewResponse - จำนวน token ที่ถูกยกเลิก
+type RevokeArticlePreviewResponse struct {
+	Revoked int `json:"revoked"`
+}
@@ -0,0 +1,52 @@
+package dto
+
+// ========================================
+// Article Redirect DTOs
+// ========================================
+
+type ArticleRedirectListParams struct {
+	Page     int    `query:"page"`
+	Limit    int    `query:"limit"`
+	Language string `query:"lang"`
+	Search   string `query:"search"`
+}
+
+func (p *ArticleRedirectListParams) SetDefaults() {
+	if p.Page < 1 {
+		p.Page = 1
+	}
+	if p.Limit < 1 || p.Limit > 100 {
+		p.Limit = 20
+	}
+}
+
+// CreateArticleRedirectRequest - admin เพิ่ม redirect เอง
+// Language ไม่ส่ง = ใช้ภาษาของบทความปลายทาง
+type CreateArticleRedirectRequest struct {
+	Language  string `json:"language" validate:"omitempty,oneof=th en"`
+	FromType  string `json:"fromType" validate:"required,oneof=review ranking best-of guide news"`
+	FromSlug  string `json:"fromSlug" validate:"required,max=100"`
+	ArticleID string `json:"articleId" validate:"required,uuid"`
+}
+
+type ArticleRedirectResponse struct {
+	ID        string `json:"id"`
+	Language  string `json:"language"`
+	FromType  string `json:"fromType"`
+	FromSlug  string `json:"fromSlug"`
+	ArticleID string `json:"articleId"`
+	ToType    string `json:"toType,omitempty"`
+	ToSlug    string `json:"toSlug,omitempty"`
+	Source    string `json:"source"`
+	CreatedAt string `json:"createdAt"`
+}
+
+// ArticleRedirectTarget - ปลายทาง canonical เมื่อ URL ที่ขอเป็น slug เก่า/ภาษาอื่น
+// StatusCode เป็น 301 เสมอ ให้ frontend ใช้ permanent redirect
+type ArticleRedirectTarget struct {
+	Type       string `json:"type"`
+	Slug       string `json:"slug"`
+	Language   string `json:"language"`
+	Path       string `json:"path"` // เช่น /en/articles/review/{slug}
+	StatusCode int    `json:"statusCode"`
+}
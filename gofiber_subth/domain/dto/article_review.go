@@ -0,0 +1,69 @@
+package dto
+
+// ========================================
+// Editorial Review (in_review → approve/reject)
+// ========================================
+
+// AssignReviewerRequest - มอบหมาย reviewer (บทความ draft จะถูกส่งเข้า in_review)
+type AssignReviewerRequest struct {
+	ReviewerID string `json:"reviewerId" validate:"required,uuid"`
+	Notes      string `json:"notes" validate:"max=2000"`
+}
+
+// ReviewDecisionRequest - approve → approved, reject → draft (ต้องมี notes)
+type ReviewDecisionRequest struct {
+	Decision string `json:"decision" validate:"required,oneof=approve reject"`
+	Notes    string `json:"notes" validate:"required_if=Decision reject,max=2000"`
+}
+
+// ReviewQueueParams - คิวบทความ in_review
+type ReviewQueueParams struct {
+	Page       int    `query:"page"`
+	Limit      int    `query:"limit"`
+	Type       string `query:"type" validate:"omitempty,oneof=review ranking best-of guide news"`
+	Lang       string `query:"lang" validate:"omitempty,oneof=th en"`
+	MinScore   int    `query:"min_score" validate:"omitempty,min=0,max=10"`
+	MaxScore   int    `query:"max_score" validate:"omitempty,min=0,max=10"`
+	ReviewerID string `query:"reviewer_id" validate:"omitempty,uuid"`
+	Mine       bool   `query:"mine"`       // เฉพาะที่มอบหมายให้ตัวเอง
+	Unassigned bool   `query:"unassigned"` // เฉพาะที่ยังไม่มี reviewer
+	Sort       string `query:"sort" validate:"omitempty,oneof=quality_score oldest"`
+}
+
+func (p *ReviewQueueParams) SetDefaults() {
+	if p.Page < 1 {
+		p.Page = 1
+	}
+	if p.Limit < 1 || p.Limit > 100 {
+		p.Limit = 20
+	}
+	if p.Sort == "" {
+		p.Sort = "quality_score"
+	}
+}
+
+type ArticleStatusHistoryParams struct {
+	Page  int `query:"page"`
+	Limit int `query:"limit"`
+}
+
+func (p *ArticleStatusHistoryParams) SetDefaults() {
+	if p.Page < 1 {
+		p.Page = 1
+	}
+	if p.Limit < 1 || p.Limit > 100 {
+		p.Limit = 50
+	}
+}
+
+// ArticleStatusChangeResponse - 1 รายการในประวัติสถานะ
+type ArticleStatusChangeResponse struct {
+	ID         string  `json:"id"`
+	FromStatus string  `json:"fromStatus"`
+	ToStatus   string  `json:"toStatus"`
+	Action     string  `json:"action"`
+	ActorID    *string `json:"actorId,omitempty"` // ไม่มี = system
+	ReviewerID *string `json:"reviewerId,omitempty"`
+	Notes      string  `json:"notes,omitempty"`
+	CreatedAt  string  `json:"createdAt"`
+}
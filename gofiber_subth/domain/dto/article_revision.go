@@ -0,0 +1,74 @@
+package dto
+
+import "encoding/json"
+
+// ========================================
+// Article Revision DTOs
+// ========================================
+
+type ArticleRevisionListParams struct {
+	Page  int `query:"page"`
+	Limit int `query:"limit"`
+}
+
+func (p *ArticleRevisionListParams) SetDefaults() {
+	if p.Page < 1 {
+		p.Page = 1
+	}
+	if p.Limit < 1 || p.Limit > 100 {
+		p.Limit = 20
+	}
+}
+
+// ArticleRevisionDiffParams - to = 0 คือเทียบกับ revision ล่าสุด
+type ArticleRevisionDiffParams struct {
+	From int `query:"from" validate:"required,min=1"`
+	To   int `query:"to" validate:"omitempty,min=1"`
+}
+
+// UpdateArticleRequest - admin แก้ไขบทความ (ส่งเฉพาะ field ที่ต้องการเปลี่ยน)
+type UpdateArticleRequest struct {
+	Type            *string         `json:"type,omitempty" validate:"omitempty,oneof=review ranking best-of guide news"`
+	Title           *string         `json:"title,omitempty" validate:"omitempty,min=1,max=200"`
+	MetaTitle       *string         `json:"metaTitle,omitempty" validate:"omitempty,min=1,max=100"`
+	MetaDescription *string         `json:"metaDescription,omitempty" validate:"omitempty,min=1,max=250"`
+	Slug            *string         `json:"slug,omitempty" validate:"omitempty,min=1,max=100"`
+	Content         json.RawMessage `json:"content,omitempty"`
+	QualityScore    *int            `json:"qualityScore,omitempty" validate:"omitempty,min=0,max=10"`
+	ReadingTime     *int            `json:"readingTime,omitempty" validate:"omitempty,min=0"`
+}
+
+type ArticleRevisionSummary struct {
+	Revision     int     `json:"revision"`
+	Source       string  `json:"source"`
+	RestoredFrom *int    `json:"restoredFrom,omitempty"`
+	CreatedBy    *string `json:"createdBy,omitempty"`
+	Type         string  `json:"type"`
+	Slug         string  `json:"slug"`
+	Title        string  `json:"title"`
+	QualityScore int     `json:"qualityScore"`
+	CreatedAt    string  `json:"createdAt"`
+}
+
+type ArticleRevisionResponse struct {
+	ArticleRevisionSummary
+	ArticleID       string                 `json:"articleId"`
+	MetaTitle       string                 `json:"metaTitle"`
+	MetaDescription string                 `json:"metaDescription"`
+	Content         map[string]interface{} `json:"content"`
+	ReadingTime     int                    `json:"readingTime"`
+}
+
+type ArticleRevisionChange struct {
+	Path string      `json:"path"` // เช่น "title", "content.facts.cast[1]"
+	Op   string      `json:"op"`   // added, removed, changed
+	From interface{} `json:"from,omitempty"`
+	To   interface{} `json:"to,omitempty"`
+}
+
+type ArticleRevisionDiffResponse struct {
+	ArticleID string                  `json:"articleId"`
+	From      int                     `json:"from"`
+	To        int                     `json:"to"`
+	Changes   []ArticleRevisionChange `json:"changes"`
+}
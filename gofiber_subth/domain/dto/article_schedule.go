@@ -0,0 +1,56 @@
+package dto
+
+import "time"
+
+// ========================================
+// Article Schedule Planner
+// ========================================
+
+// SchedulePlanRequest - วางเวลา publish ตาม quota ต่อวันต่อภาษา + ช่วงเวลาที่อนุญาต
+// บทความเรียงตาม QualityScore (สูงก่อน) และไม่วาง cast เดียวกันติดกันถ้าเลี่ยงได้
+type SchedulePlanRequest struct {
+	ArticleIDs         []string   `json:"articleIds" validate:"required,min=1,max=500,dive,uuid"`
+	StartAt            *time.Time `json:"startAt"`                                         // default: ตอนนี้
+	DailyQuota         int        `json:"dailyQuota" validate:"required,min=1,max=100"`    // ต่อภาษาต่อวัน (นับที่ publish/schedule ไว้แล้วด้วย)
+	WindowStart        string     `json:"windowStart" validate:"omitempty,datetime=15:04"` // default 09:00
+	WindowEnd          string     `json:"windowEnd" validate:"omitempty,datetime=15:04"`   // default 23:00 (ไม่รวม)
+	Timezone           string     `json:"timezone" validate:"omitempty,timezone"`          // default Asia/Bangkok
+	AllowSameCastInRow bool       `json:"allowSameCastInRow"`                              // true = ไม่ต้องเลี่ยง cast เดียวกันติดกัน
+}
+
+// RebalanceScheduleParams - วางบทความที่ planner ตั้งไว้ใหม่ตาม policy ล่าสุด (ปิดช่องว่างในคิว)
+type RebalanceScheduleParams struct {
+	DryRun bool `query:"dryRun"`
+}
+
+type SchedulePlanResponse struct {
+	Applied bool                   `json:"applied"` // false = preview (dry-run)
+	Policy  SchedulePolicyResponse `json:"policy"`
+	Items   []ScheduleSlotResponse `json:"items"`   // เรียงตามเวลา
+	Skipped []ScheduleSkipResponse `json:"skipped"` // บทความที่ schedule ไม่ได้
+}
+
+type SchedulePolicyResponse struct {
+	DailyQuota  int     `json:"dailyQuota"`
+	WindowStart string  `json:"windowStart"`
+	WindowEnd   string  `json:"windowEnd"`
+	Timezone    string  `json:"timezone"`
+	SpreadCasts bool    `json:"spreadCasts"`
+	UpdatedAt   *string `json:"updatedAt,omitempty"`
+}
+
+type ScheduleSlotResponse struct {
+	ArticleID    string  `json:"articleId"`
+	Title        string  `json:"title"`
+	Slug         string  `json:"slug"`
+	Language     string  `json:"language"`
+	QualityScore int     `json:"qualityScore"`
+	ScheduledAt  string  `json:"scheduledAt"`
+	PreviousAt   *string `json:"previousAt,omitempty"` // เวลาเดิม (ถ้าเคย schedule)
+	CastConflict bool    `json:"castConflict"`         // ติดกับบทความ cast เดียวกันเพราะไม่มีตัวเลือกอื่น
+}
+
+type ScheduleSkipResponse struct {
+	ArticleID string `json:"articleId"`
+	Reason    string `json:"reason"`
+}
@@ -0,0 +1,47 @@
+package dto
+
+// ArticleSearchParams - query ของ full-text search (Public)
+type ArticleSearchParams struct {
+	Q     string `query:"q" validate:"required,max=200"`
+	Type  string `query:"type" validate:"omitempty,oneof=review ranking best-of guide news"`
+	Lang  string `query:"lang" validate:"omitempty,oneof=th en"`
+	Page  int    `query:"page"`
+	Limit int    `query:"limit"`
+}
+
+func (p *ArticleSearchParams) SetDefaults() {
+	if p.Lang == "" {
+		p.Lang = "th"
+	}
+	if p.Page < 1 {
+		p.Page = 1
+	}
+	if p.Limit < 1 || p.Limit > 50 {
+		p.Limit = 20
+	}
+}
+
+// ArticleSearchHit - ผลค้นหา 1 รายการ
+// TitleHighlight/Snippet เป็น HTML: ข้อความถูก escape แล้วครอบคำที่ตรงด้วย <mark>...</mark>
+type ArticleSearchHit struct {
+	PublicArticleSummary
+	Rank           float64 `json:"rank"`
+	TitleHighlight string  `json:"titleHighlight"`
+	Snippet        string  `json:"snippet"`
+}
+
+// ArticleSearchFacet - จำนวนผลค้นหาต่อ article type
+type ArticleSearchFacet struct {
+	Type  string `json:"type"`
+	Count int64  `json:"count"`
+}
+
+type ArticleSearchResponse struct {
+	Query      string               `json:"query"`
+	Hits       []ArticleSearchHit   `json:"hits"`
+	Facets     []ArticleSearchFacet `json:"facets"`
+	Total      int64                `json:"total"`
+	Page       int                  `json:"page"`
+	Limit      int                  `json:"limit"`
+	TotalPages int                  `json:"totalPages"`
+}
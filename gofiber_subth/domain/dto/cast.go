@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"gofiber-template/pkg/seo"
 )
 
 // === Requests ===
@@ -15,8 +17,9 @@ type CastListRequest struct {
 	Search      string `query:"search"`
 	SortBy      string `query:"sort_by" validate:"omitempty,oneof=name video_count created_at"`
 	Order       string `query:"order" validate:"omitempty,oneof=asc desc"`
-	IDs         string `query:"ids"`         // Comma-separated IDs for batch fetch
-	HasArticles bool   `query:"hasArticles"` // Filter only casts with published articles
+	IDs         string `query:"ids"`                                              // Comma-separated IDs for batch fetch
+	HasArticles bool   `query:"hasArticles"`                                      // Filter only casts with published articles
+	MissingLang string `query:"missing_lang" validate:"omitempty,oneof=en th ja"` // Filter only casts without name in this language
 }
 
 type CreateCastRequest struct {
@@ -45,4 +48,5 @@ type CastDetailResponse struct {
 	VideoCount   int               `json:"videoCount"`
 	Translations map[string]string `json:"translations,omitempty"` // {"en": "...", "th": "...", "ja": "..."}
 	CreatedAt    time.Time         `json:"createdAt"`
+	JSONLD       *seo.JSONLDGraph  `json:"jsonLd,omitempty"` // Person (เฉพาะ GetCastBySlug)
 }
@@ -0,0 +1,66 @@
+package dto
+
+// ========================================
+// Catalogue Export (Admin)
+// ========================================
+
+// Catalog export formats
+const (
+	CatalogFormatJSONL = "jsonl"
+	CatalogFormatCSV   = "csv"
+)
+
+// CatalogExportRequest - dump video ทั้งหมด (หรือตาม filter) แบบ stream
+// since = RFC3339: incremental export เฉพาะที่เปลี่ยนหลังเวลานี้ (รวม video ที่ถูกลบ deleted = true)
+type CatalogExportRequest struct {
+	Format    string `query:"format" validate:"omitempty,oneof=jsonl csv"`
+	Gzip      bool   `query:"gzip"`
+	Since     string `query:"since"`
+	MakerID   string `query:"maker_id" validate:"omitempty,uuid"`
+	CastID    string `query:"cast_id" validate:"omitempty,uuid"`
+	TagID     string `query:"tag_id" validate:"omitempty,uuid"`
+	Category  string `query:"category"` // slug
+	SEOStatus string `query:"seo_status" validate:"omitempty,oneof=pending draft published"`
+	HasReel   string `query:"has_reel" validate:"omitempty,oneof=true false"`
+}
+
+func (r *CatalogExportRequest) SetDefaults() {
+	if r.Format == "" {
+		r.Format = CatalogFormatJSONL
+	}
+}
+
+// CatalogItem - 1 บรรทัดของ JSONL (CSV ใช้คอลัมน์แบนของข้อมูลเดียวกัน)
+type CatalogItem struct {
+	ID          string            `json:"id"`
+	Code        string            `json:"code"`
+	Titles      map[string]string `json:"titles"` // {lang: title}
+	Thumbnail   string            `json:"thumbnail,omitempty"`
+	EmbedURL    string            `json:"embedUrl,omitempty"`
+	ReleaseDate string            `json:"releaseDate,omitempty"`
+	Views       int               `json:"views"`
+	Maker       *CatalogRef       `json:"maker,omitempty"`
+	Casts       []string          `json:"casts"`      // slug
+	Tags        []string          `json:"tags"`       // slug
+	Categories  []string          `json:"categories"` // slug
+	AutoTags    []string          `json:"autoTags"`
+	SEOStatus   string            `json:"seoStatus"`
+	HasReel     bool              `json:"hasReel"`
+	Reels       int               `json:"reels"`    // reels ที่ active
+	Articles    map[string]string `json:"articles"` // {lang: status}
+	CreatedAt   string            `json:"createdAt"`
+	UpdatedAt   string            `json:"updatedAt"` // ใช้เป็น since ของรอบถัดไป
+	Deleted     bool              `json:"deleted,omitempty"`
+	MergedInto  string            `json:"mergedInto,omitempty"` // id ของ video ที่รวมแถวนี้เข้าไป (deleted = true)
+}
+
+type CatalogRef struct {
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+}
+
+// CatalogExportResult - สรุปหลัง stream จบ
+type CatalogExportResult struct {
+	Count     int    `json:"count"`
+	UpdatedAt string `json:"updatedAt,omitempty"` // updatedAt ล่าสุดที่ export (since ของรอบถัดไป)
+}
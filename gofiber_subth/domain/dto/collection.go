@@ -0,0 +1,80 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// === Requests ===
+
+type CollectionListRequest struct {
+	Page   int    `query:"page" validate:"min=1"`
+	Limit  int    `query:"limit" validate:"min=1,max=100"`
+	Lang   string `query:"lang" validate:"omitempty,oneof=en th ja"`
+	Search string `query:"search"`
+}
+
+type CreateCollectionRequest struct {
+	Name         string                      `json:"name" validate:"required,min=1,max=255"`
+	Description  string                      `json:"description"`
+	CoverImage   string                      `json:"coverImage" validate:"omitempty,max=500"`
+	IsPublic     bool                        `json:"isPublic"`
+	ShowOnHome   bool                        `json:"showOnHome"`
+	Translations map[string]GroupTranslation `json:"translations" validate:"omitempty,dive"` // {"th": {...}, "ja": {...}}
+}
+
+type UpdateCollectionRequest struct {
+	Name         *string                     `json:"name" validate:"omitempty,min=1,max=255"`
+	Description  *string                     `json:"description"`
+	CoverImage   *string                     `json:"coverImage" validate:"omitempty,max=500"`
+	IsPublic     *bool                       `json:"isPublic"`
+	ShowOnHome   *bool                       `json:"showOnHome"`
+	Translations map[string]GroupTranslation `json:"translations" validate:"omitempty,dive"` // จะแทนที่ทั้งหมด (nil = ไม่แก้)
+}
+
+type ReorderCollectionsRequest struct {
+	// List ของ collection IDs เรียงตามลำดับ section หน้าแรกใหม่
+	CollectionIDs []uuid.UUID `json:"collectionIds" validate:"required,min=1"`
+}
+
+// CollectionsHomeRequest - section หน้าแรก (คู่กับ VideosByCategoriesRequest)
+type CollectionsHomeRequest struct {
+	LimitPerCollection int    `query:"limit" validate:"min=1,max=20"`
+	CollectionCount    int    `query:"collections" validate:"omitempty,min=0,max=100"`
+	Lang               string `query:"lang" validate:"omitempty,oneof=en th ja"`
+}
+
+// === Responses ===
+
+type CollectionResponse struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"` // แปลตาม lang
+	Slug        string    `json:"slug"`
+	Description string    `json:"description,omitempty"`
+	CoverImage  string    `json:"coverImage,omitempty"`
+	IsPublic    bool      `json:"isPublic"`
+	ShowOnHome  bool      `json:"showOnHome"`
+	SortOrder   int       `json:"sortOrder"`
+	VideoCount  int       `json:"videoCount"`
+}
+
+type CollectionDetailResponse struct {
+	ID           uuid.UUID                   `json:"id"`
+	Name         string                      `json:"name"`
+	Slug         string                      `json:"slug"`
+	Description  string                      `json:"description,omitempty"`
+	CoverImage   string                      `json:"coverImage,omitempty"`
+	IsPublic     bool                        `json:"isPublic"`
+	ShowOnHome   bool                        `json:"showOnHome"`
+	SortOrder    int                         `json:"sortOrder"`
+	VideoCount   int                         `json:"videoCount"`
+	Translations map[string]GroupTranslation `json:"translations,omitempty"`
+	CreatedAt    time.Time                   `json:"createdAt"`
+	UpdatedAt    time.Time                   `json:"updatedAt"`
+}
+
+type CollectionWithVideosResponse struct {
+	Collection CollectionResponse      `json:"collection"`
+	Videos     []VideoListItemResponse `json:"videos"`
+}
@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// === Requests ===
+
+// GroupTranslation - ชื่อ/คำอธิบายที่แปลแล้วของ series หรือ collection
+type GroupTranslation struct {
+	Name        string `json:"name" validate:"required,max=255"`
+	Description string `json:"description"`
+}
+
+type SeriesListRequest struct {
+	Page   int    `query:"page" validate:"min=1"`
+	Limit  int    `query:"limit" validate:"min=1,max=100"`
+	Lang   string `query:"lang" validate:"omitempty,oneof=en th ja"`
+	Search string `query:"search"`
+}
+
+type CreateSeriesRequest struct {
+	Name         string                      `json:"name" validate:"required,min=1,max=255"`
+	Description  string                      `json:"description"`
+	CoverImage   string                      `json:"coverImage" validate:"omitempty,max=500"`
+	Translations map[string]GroupTranslation `json:"translations" validate:"omitempty,dive"` // {"th": {...}, "ja": {...}}
+}
+
+type UpdateSeriesRequest struct {
+	Name         *string                     `json:"name" validate:"omitempty,min=1,max=255"`
+	Description  *string                     `json:"description"`
+	CoverImage   *string                     `json:"coverImage" validate:"omitempty,max=500"`
+	Translations map[string]GroupTranslation `json:"translations" validate:"omitempty,dive"` // จะแทนที่ทั้งหมด (nil = ไม่แก้)
+}
+
+// SetGroupVideosRequest - ตั้งสมาชิกของ series/collection ใหม่ทั้งหมดตามลำดับ (index 0 = ตอนแรก)
+type SetGroupVideosRequest struct {
+	VideoIDs []uuid.UUID `json:"videoIds" validate:"max=500"`
+}
+
+// === Responses ===
+
+type SeriesResponse struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"` // แปลตาม lang
+	Slug        string    `json:"slug"`
+	Description string    `json:"description,omitempty"`
+	CoverImage  string    `json:"coverImage,omitempty"`
+	VideoCount  int       `json:"videoCount"`
+}
+
+type SeriesDetailResponse struct {
+	ID           uuid.UUID                   `json:"id"`
+	Name         string                      `json:"name"`
+	Slug         string                      `json:"slug"`
+	Description  string                      `json:"description,omitempty"`
+	CoverImage   string                      `json:"coverImage,omitempty"`
+	VideoCount   int                         `json:"videoCount"`
+	Translations map[string]GroupTranslation `json:"translations,omitempty"`
+	Videos       []VideoListItemResponse     `json:"videos"` // เรียงตามตอน
+	CreatedAt    time.Time                   `json:"createdAt"`
+	UpdatedAt    time.Time                   `json:"updatedAt"`
+}
+
+// VideoSeriesResponse - series ของ video ในหน้า video detail
+type VideoSeriesResponse struct {
+	ID       uuid.UUID               `json:"id"`
+	Name     string                  `json:"name"`
+	Slug     string                  `json:"slug"`
+	Position int                     `json:"position"` // ตอนที่ของ video นี้ (เริ่มที่ 1)
+	Total    int                     `json:"total"`
+	Episodes []VideoListItemResponse `json:"episodes"` // ตอนอื่นใน series (ไม่รวม video นี้) เรียงตามตอน
+}
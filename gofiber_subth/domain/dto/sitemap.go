@@ -0,0 +1,18 @@
+package dto
+
+import "time"
+
+// SitemapFile - ไฟล์ sitemap หนึ่งไฟล์ที่สร้างในรอบ rebuild
+type SitemapFile struct {
+	Name       string `json:"name"`
+	URLCount   int    `json:"urlCount"`
+	StorageURL string `json:"storageUrl,omitempty"`
+}
+
+// SitemapBuildResponse - ผลการ rebuild sitemap ทั้งชุด
+type SitemapBuildResponse struct {
+	Index       string        `json:"index"`
+	Files       []SitemapFile `json:"files"`
+	TotalURLs   int           `json:"totalUrls"`
+	GeneratedAt time.Time     `json:"generatedAt"`
+}
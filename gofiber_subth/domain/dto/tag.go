@@ -15,8 +15,9 @@ type TagListRequest struct {
 	Search      string `query:"search"`
 	SortBy      string `query:"sort_by" validate:"omitempty,oneof=name video_count created_at"`
 	Order       string `query:"order" validate:"omitempty,oneof=asc desc"`
-	IDs         string `query:"ids"`         // Comma-separated IDs for batch fetch
-	HasArticles bool   `query:"hasArticles"` // Filter only tags with published articles
+	IDs         string `query:"ids"`                                              // Comma-separated IDs for batch fetch
+	HasArticles bool   `query:"hasArticles"`                                      // Filter only tags with published articles
+	MissingLang string `query:"missing_lang" validate:"omitempty,oneof=en th ja"` // Filter only tags without name in this language
 }
 
 type CreateTagRequest struct {
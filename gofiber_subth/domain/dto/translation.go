@@ -0,0 +1,84 @@
+package dto
+
+// ========================================
+// Translations (bulk import/export)
+// ========================================
+
+// Translation file formats
+const (
+	TranslationFormatCSV   = "csv"
+	TranslationFormatJSONL = "jsonl"
+)
+
+// TranslationExportRequest - export คำแปลของ entity ประเภทเดียว ภาษาเดียว
+type TranslationExportRequest struct {
+	Type    string `query:"type" validate:"required,oneof=video cast tag category"`
+	Lang    string `query:"lang" validate:"required,oneof=en th ja"`
+	Format  string `query:"format" validate:"omitempty,oneof=csv jsonl"`
+	Missing bool   `query:"missing"` // เฉพาะที่ยังไม่มีคำแปล
+}
+
+func (r *TranslationExportRequest) SetDefaults() {
+	if r.Format == "" {
+		r.Format = TranslationFormatCSV
+	}
+}
+
+// TranslationEntry - 1 แถวในไฟล์ (CSV header: type,id,key,lang,source,text)
+type TranslationEntry struct {
+	Type   string `json:"type"`
+	ID     string `json:"id"`
+	Key    string `json:"key"` // video code หรือ slug (อ้างอิงเท่านั้น)
+	Lang   string `json:"lang"`
+	Source string `json:"source"` // ต้นฉบับ (อ้างอิงเท่านั้น ไม่ถูก import)
+	Text   string `json:"text"`   // คำแปล ว่าง = ข้าม
+}
+
+type TranslationExportResponse struct {
+	Format      string
+	Filename    string
+	ContentType string
+	Data        []byte
+	Count       int
+}
+
+// TranslationImportRequest - dry_run=true คืน diff โดยไม่เขียนลง DB
+type TranslationImportRequest struct {
+	Format string `query:"format" validate:"omitempty,oneof=csv jsonl"`
+	DryRun bool   `query:"dry_run"`
+}
+
+func (r *TranslationImportRequest) SetDefaults() {
+	if r.Format == "" {
+		r.Format = TranslationFormatCSV
+	}
+}
+
+type TranslationImportResult struct {
+	DryRun    bool                     `json:"dryRun"`
+	Applied   bool                     `json:"applied"` // false ถ้า dry-run หรือมีแถวไม่ถูกต้อง
+	Total     int                      `json:"total"`
+	Created   int                      `json:"created"`
+	Updated   int                      `json:"updated"`
+	Unchanged int                      `json:"unchanged"`
+	Skipped   int                      `json:"skipped"` // text ว่าง
+	Invalid   int                      `json:"invalid"`
+	Changes   []TranslationChange      `json:"changes"`
+	Errors    []TranslationImportError `json:"errors"`
+}
+
+type TranslationChange struct {
+	Line int    `json:"line"`
+	Type string `json:"type"`
+	ID   string `json:"id"`
+	Key  string `json:"key"`
+	Lang string `json:"lang"`
+	Op   string `json:"op"` // create, update
+	From string `json:"from,omitempty"`
+	To   string `json:"to"`
+}
+
+type TranslationImportError struct {
+	Line    int    `json:"line"`
+	Message string `json:"message"`
+}
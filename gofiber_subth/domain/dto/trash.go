@@ -0,0 +1,40 @@
+package dto
+
+// ========================================
+// Trash (soft-deleted videos, articles, reels)
+// ========================================
+
+// TrashListRequest - รายการในถังขยะ ลบล่าสุดก่อน
+type TrashListRequest struct {
+	Type  string `query:"type" validate:"omitempty,oneof=video article reel"`
+	Page  int    `query:"page"`
+	Limit int    `query:"limit"`
+}
+
+func (p *TrashListRequest) SetDefaults() {
+	if p.Page < 1 {
+		p.Page = 1
+	}
+	if p.Limit < 1 || p.Limit > 100 {
+		p.Limit = 20
+	}
+}
+
+type TrashItemResponse struct {
+	Type      string  `json:"type"` // video, article, reel
+	ID        string  `json:"id"`
+	Title     string  `json:"title"`
+	VideoID   *string `json:"videoId,omitempty"`
+	VideoCode string  `json:"videoCode,omitempty"`
+	Thumbnail string  `json:"thumbnail,omitempty"`
+	DeletedAt string  `json:"deletedAt"`
+	PurgeAt   string  `json:"purgeAt"` // ลบถาวรอัตโนมัติหลังเวลานี้
+}
+
+// TrashPurgeResult - ผลการลบถาวรรายการที่หมดเวลาเก็บ
+type TrashPurgeResult struct {
+	Videos   int `json:"videos"`
+	Articles int `json:"articles"`
+	Reels    int `json:"reels"`
+	Failed   int `json:"failed"`
+}
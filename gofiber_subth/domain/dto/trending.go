@@ -0,0 +1,60 @@
+package dto
+
+// ========================================
+// Trending (time-decayed activity scores)
+// ========================================
+
+// TrendingRequest - อันดับ trending ที่คำนวณไว้ใน Redis
+// window: 24h, 7d, 30d (ยิ่ง window สั้น คะแนนยิ่ง decay เร็ว)
+type TrendingRequest struct {
+	Kind   string `query:"kind" validate:"omitempty,oneof=video cast tag maker"`
+	Window string `query:"window" validate:"omitempty,oneof=24h 7d 30d"`
+	Lang   string `query:"lang" validate:"omitempty,oneof=en th ja"`
+	Page   int    `query:"page"`
+	Limit  int    `query:"limit"`
+}
+
+func (p *TrendingRequest) SetDefaults() {
+	if p.Kind == "" {
+		p.Kind = "video"
+	}
+	if p.Window == "" {
+		p.Window = "24h"
+	}
+	if p.Lang == "" {
+		p.Lang = "en"
+	}
+	if p.Page < 1 {
+		p.Page = 1
+	}
+	if p.Limit < 1 || p.Limit > 100 {
+		p.Limit = 20
+	}
+}
+
+type TrendingItemResponse struct {
+	Rank      int     `json:"rank"`
+	ID        string  `json:"id"`
+	Name      string  `json:"name"` // video = title
+	Slug      string  `json:"slug"` // video = code
+	Thumbnail string  `json:"thumbnail,omitempty"`
+	Score     float64 `json:"score"`
+}
+
+type TrendingResponse struct {
+	Kind       string                 `json:"kind"`
+	Window     string                 `json:"window"`
+	Lang       string                 `json:"lang"`
+	Items      []TrendingItemResponse `json:"items"`
+	Total      int64                  `json:"total"`
+	Page       int                    `json:"page"`
+	Limit      int                    `json:"limit"`
+	TotalPages int                    `json:"totalPages"`
+	UpdatedAt  *string                `json:"updatedAt,omitempty"` // nil = ยังไม่เคยคำนวณ
+}
+
+// TrendingRefreshResult ผลการคำนวณ trending ใหม่ทุกชุด
+type TrendingRefreshResult struct {
+	Sets  int `json:"sets"`  // จำนวน sorted set (kind x window x lang)
+	Items int `json:"items"` // member รวมทุก set
+}
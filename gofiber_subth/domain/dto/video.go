@@ -4,23 +4,27 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"gofiber-template/pkg/seo"
 )
 
 // === Requests ===
 
 type VideoListRequest struct {
-	Page      int    `query:"page" validate:"min=1"`
-	Limit     int    `query:"limit" validate:"min=1,max=100"`
-	Lang      string `query:"lang" validate:"omitempty,oneof=en th ja"`
-	Search    string `query:"search"`
-	MakerID   string `query:"maker_id"`
-	CastID    string `query:"cast_id"`
-	TagID     string `query:"tag_id"`
-	AutoTags  string `query:"auto_tags"` // comma separated: glasses,short_hair
-	Category  string `query:"category"`
-	SortBy    string `query:"sort_by" validate:"omitempty,oneof=date created_at"`
-	Order     string `query:"order" validate:"omitempty,oneof=asc desc"`
-	MissingTh bool   `query:"missing_th"` // Filter videos without Thai title
+	Page        int    `query:"page" validate:"min=1"`
+	Limit       int    `query:"limit" validate:"min=1,max=100"`
+	Cursor      string `query:"cursor"` // keyset pagination (ใช้แทน page, ค่าว่าง = หน้าแรก)
+	Lang        string `query:"lang" validate:"omitempty,oneof=en th ja"`
+	Search      string `query:"search"`
+	MakerID     string `query:"maker_id"`
+	CastID      string `query:"cast_id"`
+	TagID       string `query:"tag_id"`
+	AutoTags    string `query:"auto_tags"` // comma separated: glasses,short_hair
+	Category    string `query:"category"`
+	SortBy      string `query:"sort_by" validate:"omitempty,oneof=date created_at views"`
+	Order       string `query:"order" validate:"omitempty,oneof=asc desc"`
+	MissingTh   bool   `query:"missing_th"`                                       // Deprecated: ใช้ missing_lang=th
+	MissingLang string `query:"missing_lang" validate:"omitempty,oneof=en th ja"` // Filter videos without title in this language
 }
 
 type CreateVideoRequest struct {
@@ -83,6 +87,15 @@ type VideoResponse struct {
 	AutoTags     []AutoTagResponse   `json:"autoTags,omitempty"`
 	CreatedAt    time.Time           `json:"createdAt"`
 	UpdatedAt    time.Time           `json:"updatedAt"`
+
+	// Schema.org structured data (VideoObject) - ส่งเฉพาะหน้า video detail
+	JSONLD *seo.JSONLDGraph `json:"jsonLd,omitempty"`
+
+	// ID ที่ขอถูก merge เข้า video นี้แล้ว → frontend ควร 301 ไป Redirect.Path
+	Redirect *VideoRedirectTarget `json:"redirect,omitempty"`
+
+	// series ที่ video อยู่ + ตอนอื่น ๆ (ส่งเฉพาะหน้า video detail)
+	Series *VideoSeriesResponse `json:"series,omitempty"`
 }
 
 type VideoListItemResponse struct {
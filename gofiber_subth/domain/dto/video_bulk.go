@@ -0,0 +1,75 @@
+package dto
+
+// ========================================
+// Bulk Edit (Admin)
+// ========================================
+
+// Bulk edit operations
+const (
+	BulkVideoOpAddCasts         = "add_casts"
+	BulkVideoOpRemoveCasts      = "remove_casts"
+	BulkVideoOpAddTags          = "add_tags"
+	BulkVideoOpRemoveTags       = "remove_tags"
+	BulkVideoOpAddCategories    = "add_categories"
+	BulkVideoOpRemoveCategories = "remove_categories"
+	BulkVideoOpSetMaker         = "set_maker"
+	BulkVideoOpReplaceAutoTags  = "replace_auto_tags"
+	BulkVideoOpSetSEOStatus     = "set_seo_status"
+)
+
+// BulkVideoEditRequest - เลือก video ด้วย selector แล้วทำ operations ตามลำดับ
+// dryRun = true คืนผลต่อ video โดยไม่เขียนลง DB
+type BulkVideoEditRequest struct {
+	Selector   BulkVideoSelector    `json:"selector"`
+	Operations []BulkVideoOperation `json:"operations" validate:"required,min=1,max=20,dive"`
+	DryRun     bool                 `json:"dryRun"`
+}
+
+// BulkVideoSelector - ใช้ได้อย่างใดอย่างหนึ่ง: ids, codes หรือ filter
+type BulkVideoSelector struct {
+	IDs    []string         `json:"ids" validate:"omitempty,max=1000,dive,uuid"`
+	Codes  []string         `json:"codes" validate:"omitempty,max=1000,dive,required"`
+	Filter *BulkVideoFilter `json:"filter"`
+}
+
+// BulkVideoFilter - filter เดียวกับ GET /videos (ต้องระบุอย่างน้อย 1 ค่า)
+type BulkVideoFilter struct {
+	Search      string   `json:"search"`
+	Lang        string   `json:"lang" validate:"omitempty,oneof=en th ja"` // ภาษาของ title ที่ใช้ search
+	MakerID     string   `json:"makerId" validate:"omitempty,uuid"`
+	CastID      string   `json:"castId" validate:"omitempty,uuid"`
+	TagID       string   `json:"tagId" validate:"omitempty,uuid"`
+	AutoTags    []string `json:"autoTags"`
+	Category    string   `json:"category"`
+	MissingLang string   `json:"missingLang" validate:"omitempty,oneof=en th ja"`
+}
+
+// BulkVideoOperation
+// add_*/remove_*: ids = cast/tag/category IDs
+// set_maker: value = maker ID (ว่าง = ลบ maker)
+// replace_auto_tags: values = auto tag keys (ว่าง = ล้าง)
+// set_seo_status: value = pending, draft, published
+type BulkVideoOperation struct {
+	Op     string   `json:"op" validate:"required,oneof=add_casts remove_casts add_tags remove_tags add_categories remove_categories set_maker replace_auto_tags set_seo_status"`
+	IDs    []string `json:"ids" validate:"omitempty,max=50,dive,uuid"`
+	Value  string   `json:"value"`
+	Values []string `json:"values" validate:"omitempty,max=50"`
+}
+
+type BulkVideoEditResponse struct {
+	DryRun    bool                  `json:"dryRun"`
+	Matched   int                   `json:"matched"`
+	Updated   int                   `json:"updated"` // dry-run = จำนวนที่จะถูกแก้
+	Unchanged int                   `json:"unchanged"`
+	NotFound  int                   `json:"notFound"`
+	Items     []BulkVideoItemResult `json:"items"`
+}
+
+// BulkVideoItemResult - ผลต่อ video
+// status: updated, unchanged, not_found (id/code ใน selector ที่ไม่มีอยู่)
+type BulkVideoItemResult struct {
+	ID      string   `json:"id,omitempty"`
+	Code    string   `json:"code,omitempty"`
+	Status  string   `json:"status"`
+	Changes []string `json:"changes,omitempty"` // เช่น +cast:yua-mikami, maker:s1 → moodyz
+}
@@ -0,0 +1,69 @@
+package dto
+
+// ========================================
+// Faceted Video Search
+// ========================================
+
+// VideoFacetSearchRequest - filter หลายค่าต่อมิติ (comma separated)
+// *_mode: or (default) = มีค่าใดค่าหนึ่ง, and = ต้องมีครบทุกค่า (maker เป็น or เสมอ)
+type VideoFacetSearchRequest struct {
+	Lang         string `query:"lang" validate:"omitempty,oneof=en th ja"`
+	Search       string `query:"search" validate:"max=200"`
+	Makers       string `query:"makers"`     // maker ids
+	Casts        string `query:"casts"`      // cast ids
+	Tags         string `query:"tags"`       // tag ids
+	Categories   string `query:"categories"` // category ids
+	AutoTags     string `query:"auto_tags"`  // keys เช่น glasses,short_hair
+	CastMode     string `query:"cast_mode" validate:"omitempty,oneof=and or"`
+	TagMode      string `query:"tag_mode" validate:"omitempty,oneof=and or"`
+	CategoryMode string `query:"category_mode" validate:"omitempty,oneof=and or"`
+	AutoTagMode  string `query:"auto_tag_mode" validate:"omitempty,oneof=and or"`
+	ReleasedFrom string `query:"released_from" validate:"omitempty,datetime=2006-01-02"`
+	ReleasedTo   string `query:"released_to" validate:"omitempty,datetime=2006-01-02"`
+	SortBy       string `query:"sort_by" validate:"omitempty,oneof=date created_at views"`
+	Order        string `query:"order" validate:"omitempty,oneof=asc desc"`
+	Page         int    `query:"page"`
+	Limit        int    `query:"limit"`
+	FacetLimit   int    `query:"facet_limit"` // bucket สูงสุดต่อ facet
+}
+
+func (p *VideoFacetSearchRequest) SetDefaults() {
+	if p.Lang == "" {
+		p.Lang = "en"
+	}
+	if p.Page < 1 {
+		p.Page = 1
+	}
+	if p.Limit < 1 || p.Limit > 100 {
+		p.Limit = 20
+	}
+	if p.FacetLimit < 1 || p.FacetLimit > 100 {
+		p.FacetLimit = 30
+	}
+}
+
+// VideoFacetBucket - 1 ค่าใน facet พร้อมจำนวน video ในผลที่ filter แล้ว
+type VideoFacetBucket struct {
+	Value    string `json:"value"` // id (auto tag = key)
+	Name     string `json:"name"`
+	Slug     string `json:"slug,omitempty"`
+	Count    int64  `json:"count"`
+	Selected bool   `json:"selected"` // อยู่ใน filter ของ request นี้
+}
+
+type VideoFacetsResponse struct {
+	Makers     []VideoFacetBucket `json:"makers"`
+	Casts      []VideoFacetBucket `json:"casts"`
+	Tags       []VideoFacetBucket `json:"tags"`
+	Categories []VideoFacetBucket `json:"categories"`
+	AutoTags   []VideoFacetBucket `json:"autoTags"`
+}
+
+type VideoFacetSearchResponse struct {
+	Videos     []VideoListItemResponse `json:"videos"`
+	Facets     VideoFacetsResponse     `json:"facets"`
+	Total      int64                   `json:"total"`
+	Page       int                     `json:"page"`
+	Limit      int                     `json:"limit"`
+	TotalPages int                     `json:"totalPages"`
+}
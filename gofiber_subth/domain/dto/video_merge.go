@@ -0,0 +1,65 @@
+package dto
+
+// ========================================
+// Duplicate Detection / Merge (Admin)
+// ========================================
+
+// VideoDuplicateListParams - กลุ่ม video ที่น่าจะซ้ำกัน
+// match: code (NormalizeVideoCode), embed (embed URL), thumbnail - ว่าง = ทุกเกณฑ์
+type VideoDuplicateListParams struct {
+	Match string `query:"match" validate:"omitempty,oneof=code embed thumbnail"`
+	Page  int    `query:"page"`
+	Limit int    `query:"limit"`
+}
+
+func (p *VideoDuplicateListParams) SetDefaults() {
+	if p.Page < 1 {
+		p.Page = 1
+	}
+	if p.Limit < 1 || p.Limit > 100 {
+		p.Limit = 20
+	}
+}
+
+type VideoDuplicateGroupResponse struct {
+	Reasons           []string                     `json:"reasons"`           // เกณฑ์ที่ทำให้อยู่กลุ่มเดียวกัน
+	SuggestedTargetID string                       `json:"suggestedTargetId"` // video ที่ควรเก็บไว้ (ข้อมูลครบสุด)
+	Videos            []VideoDuplicateItemResponse `json:"videos"`
+}
+
+type VideoDuplicateItemResponse struct {
+	ID           string `json:"id"`
+	Code         string `json:"code,omitempty"`
+	Title        string `json:"title"`
+	Thumbnail    string `json:"thumbnail,omitempty"`
+	EmbedURL     string `json:"embedUrl,omitempty"`
+	Views        int    `json:"views"`
+	HasReel      bool   `json:"hasReel"`
+	Translations int    `json:"translations"`
+	Articles     int    `json:"articles"`
+	CreatedAt    string `json:"createdAt"`
+}
+
+// MergeVideosRequest - ย้ายข้อมูลของ sourceIds ไปที่ targetId แล้วลบ source (ID เดิม redirect ไป target)
+type MergeVideosRequest struct {
+	TargetID  string   `json:"targetId" validate:"required,uuid"`
+	SourceIDs []string `json:"sourceIds" validate:"required,min=1,max=20,dive,uuid"`
+}
+
+type MergeVideosResponse struct {
+	TargetID     string   `json:"targetId"`
+	RemovedIDs   []string `json:"removedIds"`
+	Translations int64    `json:"translations"` // จำนวนที่ย้ายมา (ที่ซ้ำกับ target จะถูกทิ้ง)
+	Casts        int64    `json:"casts"`
+	Tags         int64    `json:"tags"`
+	Categories   int64    `json:"categories"`
+	Reels        int64    `json:"reels"`
+	Articles     int64    `json:"articles"`
+}
+
+// VideoRedirectTarget - ID ที่ขอถูก merge ไปแล้ว
+type VideoRedirectTarget struct {
+	VideoID    string `json:"videoId"`
+	Path       string `json:"path"` // เช่น /th/member/videos/{id}
+	StatusCode int    `json:"statusCode"`
+}
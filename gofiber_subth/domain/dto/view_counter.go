@@ -0,0 +1,15 @@
+package dto
+
+// ViewCountResponse response หลังนับยอดวิว (view counter)
+// Counted = false เมื่อ visitor เดิมเพิ่งดูไปแล้วใน window (ไม่นับซ้ำ)
+type ViewCountResponse struct {
+	Counted bool `json:"counted"`
+}
+
+// ViewFlushResult ผลการ flush ยอดวิวจาก Redis ลง Postgres
+type ViewFlushResult struct {
+	Articles     int   `json:"articles"`     // จำนวนบทความที่ถูกอัปเดต
+	ArticleViews int64 `json:"articleViews"` // ยอดวิวรวมที่บวกเข้าไป
+	Videos       int   `json:"videos"`
+	VideoViews   int64 `json:"videoViews"`
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 // ArticleType - ประเภทบทความ
@@ -37,11 +38,21 @@ type ArticleStatus string
 
 const (
 	ArticleStatusDraft     ArticleStatus = "draft"
+	ArticleStatusInReview  ArticleStatus = "in_review" // รอ reviewer ตัดสิน
+	ArticleStatusApproved  ArticleStatus = "approved"  // ผ่าน review แล้ว รอ schedule/publish
 	ArticleStatusScheduled ArticleStatus = "scheduled"
 	ArticleStatusPublished ArticleStatus = "published"
 	ArticleStatusArchived  ArticleStatus = "archived"
 )
 
+// ArticleOrigin - ที่มาของบทความ
+type ArticleOrigin string
+
+const (
+	ArticleOriginWorker    ArticleOrigin = "worker"    // จาก SEO worker (1 บทความต่อ video + language)
+	ArticleOriginGenerator ArticleOrigin = "generator" // สร้างจาก catalogue ใน API (ranking/best-of/guide, หลาย video)
+)
+
 // IndexingStatus - สถานะการ index กับ Google
 type IndexingStatus string
 
@@ -54,17 +65,23 @@ const (
 
 // Article - บทความ
 type Article struct {
-	ID      uuid.UUID `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
-	VideoID uuid.UUID `gorm:"type:uuid;not null;index"` // 1 video = N articles (multi-lang)
+	ID uuid.UUID `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+
+	// VideoID - worker: video ของบทความ (1 video = N articles multi-lang)
+	// generator: video หลัก (อันดับ 1, ใช้ทำปก) ส่วนทั้งหมดอยู่ใน Videos
+	VideoID uuid.UUID `gorm:"type:uuid;not null;index;uniqueIndex:idx_article_video_language_active,where:origin = 'worker' AND deleted_at IS NULL"`
 
 	// Language - "th" or "en" (supports multi-language articles per video)
-	Language string `gorm:"size:5;default:'th';not null;index;uniqueIndex:idx_video_language;uniqueIndex:idx_slug_language"`
+	Language string `gorm:"size:5;default:'th';not null;index;uniqueIndex:idx_article_video_language_active,where:origin = 'worker' AND deleted_at IS NULL;uniqueIndex:idx_slug_language_active,where:deleted_at IS NULL"`
 
 	// Article Type
 	Type ArticleType `gorm:"size:20;default:'review';index"`
 
+	// Origin - worker or generator (unique video+language บังคับเฉพาะ worker)
+	Origin ArticleOrigin `gorm:"size:20;default:'worker';not null;index"`
+
 	// Core SEO (indexed for search)
-	Slug            string `gorm:"size:100;not null;index;uniqueIndex:idx_slug_language"`
+	Slug            string `gorm:"size:100;not null;index;uniqueIndex:idx_slug_language_active,where:deleted_at IS NULL"`
 	Title           string `gorm:"size:200;not null"`
 	MetaTitle       string `gorm:"size:100;not null"`
 	MetaDescription string `gorm:"size:250;not null"`
@@ -77,9 +94,20 @@ type Article struct {
 	ScheduledAt *time.Time    `gorm:"index"`
 	PublishedAt *time.Time
 
+	// Schedule planner - true = เวลาถูกวางโดย planner (rebalance ย้ายได้), false = admin ตั้งเอง (ล็อกเวลาไว้)
+	AutoScheduled bool `gorm:"default:false;not null"`
+
+	// Editorial review
+	ReviewerID  *uuid.UUID `gorm:"type:uuid;index"` // reviewer ที่ได้รับมอบหมาย
+	ReviewNotes string     `gorm:"type:text"`       // notes ของการตัดสินล่าสุด (ประวัติทั้งหมดอยู่ใน article_status_changes)
+	ReviewedAt  *time.Time
+
 	// SEO Tracking (Google Indexing API)
-	IndexedAt      *time.Time
-	IndexingStatus IndexingStatus `gorm:"size:20;default:'pending'"`
+	IndexedAt         *time.Time
+	IndexingStatus    IndexingStatus `gorm:"size:20;default:'pending'"`
+	IndexingCheckedAt *time.Time     // เวลาที่ submit/ตรวจสถานะล่าสุด (ใช้หมุนคิวตรวจ)
+	IndexingAttempts  int            `gorm:"default:0;not null"` // จำนวนรอบที่ส่งไม่สำเร็จติดกัน (ครบ limit -> failed)
+	IndexingRetryAt   *time.Time     // pending ที่ส่งไม่สำเร็จ รอ backoff ถึงเวลานี้ก่อนส่งใหม่
 
 	// Metadata
 	QualityScore int `gorm:"default:0"` // 1-10 จาก AI
@@ -91,10 +119,14 @@ type Article struct {
 	ViewCount     int `gorm:"default:0"`
 
 	// Relations
-	Video *Video `gorm:"foreignKey:VideoID"`
+	Video  *Video         `gorm:"foreignKey:VideoID"`
+	Videos []ArticleVideo `gorm:"foreignKey:ArticleID"` // บทความหลาย video (generator) เรียงตาม Position
 
 	CreatedAt time.Time `gorm:"autoCreateTime"`
 	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+
+	// Trash - slug/ภาษาของบทความในถังขยะไม่ถูกจองไว้ (unique index นับเฉพาะ deleted_at IS NULL)
+	DeletedAt gorm.DeletedAt `gorm:"index"`
 }
 
 func (Article) TableName() string {
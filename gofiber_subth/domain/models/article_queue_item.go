@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ArticleQueueStatus - สถานะของงานในคิว "needs article"
+type ArticleQueueStatus string
+
+const (
+	ArticleQueuePending ArticleQueueStatus = "pending" // รอ worker มารับ
+	ArticleQueueLeased  ArticleQueueStatus = "leased"  // worker รับไปแล้ว (หมด lease = กลับมารับใหม่ได้)
+	ArticleQueueDone    ArticleQueueStatus = "done"    // IngestArticle เข้ามาแล้ว
+)
+
+// ArticleQueueItem - video + ภาษาที่ยังไม่มีบทความ ให้ SEO worker ภายนอกมา claim ไปเขียน
+// 1 video ต่อ 1 ภาษามีได้แค่ 1 รายการ (enqueue ซ้ำ = ไม่สร้างใหม่)
+type ArticleQueueItem struct {
+	ID       uuid.UUID   `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	VideoID  uuid.UUID   `gorm:"type:uuid;not null;uniqueIndex:idx_article_queue_video_language"`
+	Video    *Video      `gorm:"foreignKey:VideoID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Language string      `gorm:"size:5;not null;default:'th';uniqueIndex:idx_article_queue_video_language"`
+	Type     ArticleType `gorm:"size:20;not null;default:'review'"`
+
+	Status   ArticleQueueStatus `gorm:"size:20;not null;default:'pending';index:idx_article_queue_claim"`
+	Priority int                `gorm:"default:0;index:idx_article_queue_claim"` // ยอดวิวของ video ตอน enqueue (มากก่อน)
+
+	// Lease
+	LeaseToken     *uuid.UUID `gorm:"type:uuid"`
+	LeasedBy       string     `gorm:"size:100"`
+	LeaseExpiresAt *time.Time `gorm:"index"`
+	Attempts       int        `gorm:"default:0"` // จำนวนครั้งที่ถูก claim
+
+	CompletedAt *time.Time
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+}
+
+func (ArticleQueueItem) TableName() string {
+	return "article_queue_items"
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RedirectSource - ที่มาของ redirect
+type RedirectSource string
+
+const (
+	RedirectSourceAuto   RedirectSource = "auto"   // สร้างอัตโนมัติเมื่อ type/slug ของบทความเปลี่ยน
+	RedirectSourceManual RedirectSource = "manual" // admin เพิ่มเอง
+)
+
+// ArticleRedirect - URL เดิม (type + slug ต่อภาษา) ที่ต้อง redirect ไปบทความปัจจุบัน
+// เก็บปลายทางเป็น ArticleID แทน slug เพื่อให้ตาม slug ล่าสุดเสมอ ไม่เกิด redirect chain
+type ArticleRedirect struct {
+	ID        uuid.UUID      `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	Language  string         `gorm:"size:5;not null;uniqueIndex:idx_article_redirect_source"`
+	FromType  ArticleType    `gorm:"size:20;not null;uniqueIndex:idx_article_redirect_source"`
+	FromSlug  string         `gorm:"size:100;not null;uniqueIndex:idx_article_redirect_source"`
+	ArticleID uuid.UUID      `gorm:"type:uuid;not null;index"`
+	Article   *Article       `gorm:"foreignKey:ArticleID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Source    RedirectSource `gorm:"size:20;not null;default:'auto'"`
+	CreatedBy *uuid.UUID     `gorm:"type:uuid"`
+	CreatedAt time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime"`
+}
+
+func (ArticleRedirect) TableName() string {
+	return "article_redirects"
+}
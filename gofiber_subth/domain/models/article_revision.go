@@ -0,0 +1,46 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RevisionSource - ที่มาของ revision
+type RevisionSource string
+
+const (
+	RevisionSourceBaseline RevisionSource = "baseline" // สถานะก่อนมีระบบ revision (บันทึกก่อนถูกเขียนทับครั้งแรก)
+	RevisionSourceIngest   RevisionSource = "ingest"   // จาก SEO worker
+	RevisionSourceAdmin    RevisionSource = "admin"    // admin แก้ไข
+	RevisionSourceRestore  RevisionSource = "restore"  // กู้คืนจาก revision เก่า
+)
+
+// ArticleRevision - snapshot ของบทความหลังการเปลี่ยนแปลงแต่ละครั้ง
+type ArticleRevision struct {
+	ID        uuid.UUID `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	ArticleID uuid.UUID `gorm:"type:uuid;not null;index;uniqueIndex:idx_article_revision_number"`
+	Article   *Article  `gorm:"foreignKey:ArticleID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Revision  int       `gorm:"not null;uniqueIndex:idx_article_revision_number"` // เลขลำดับต่อบทความ เริ่มที่ 1
+
+	Source       RevisionSource `gorm:"size:20;not null"`
+	RestoredFrom *int           // revision ต้นทางเมื่อ Source = restore
+	CreatedBy    *uuid.UUID     `gorm:"type:uuid"` // admin ที่แก้ไข (nil = worker/system)
+
+	// Snapshot fields
+	Type            ArticleType     `gorm:"size:20"`
+	Slug            string          `gorm:"size:100"`
+	Title           string          `gorm:"size:200"`
+	MetaTitle       string          `gorm:"size:100"`
+	MetaDescription string          `gorm:"size:250"`
+	Content         json.RawMessage `gorm:"type:jsonb;not null"`
+	QualityScore    int
+	ReadingTime     int
+
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (ArticleRevision) TableName() string {
+	return "article_revisions"
+}
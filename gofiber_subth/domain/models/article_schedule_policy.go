@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ArticleSchedulePolicy - กติกาของ schedule planner ที่ apply ล่าสุด (singleton - มีแถวเดียว)
+// ใช้ตอน rebalance คิวเมื่อมีบทความถูกถอดออกจากคิว schedule
+type ArticleSchedulePolicy struct {
+	ID          uuid.UUID  `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	DailyQuota  int        `gorm:"not null"`         // บทความสูงสุดต่อภาษาต่อวัน
+	WindowStart string     `gorm:"size:5;not null"`  // HH:MM (เวลาท้องถิ่นตาม Timezone)
+	WindowEnd   string     `gorm:"size:5;not null"`  // HH:MM (ไม่รวม)
+	Timezone    string     `gorm:"size:64;not null"` // IANA เช่น Asia/Bangkok
+	SpreadCasts bool       `gorm:"not null"`         // ไม่วางบทความ cast เดียวกันติดกัน
+	UpdatedBy   *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt   time.Time  `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time  `gorm:"autoUpdateTime"`
+}
+
+func (ArticleSchedulePolicy) TableName() string {
+	return "article_schedule_policies"
+}
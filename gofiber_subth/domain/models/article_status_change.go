@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StatusChangeAction - เหตุที่สถานะบทความเปลี่ยน
+type StatusChangeAction string
+
+const (
+	StatusChangeManual       StatusChangeAction = "status"  // admin เปลี่ยนสถานะเอง (UpdateStatus)
+	StatusChangeAssign       StatusChangeAction = "assign"  // มอบหมาย reviewer (draft → in_review)
+	StatusChangeApprove      StatusChangeAction = "approve" // reviewer อนุมัติ
+	StatusChangeReject       StatusChangeAction = "reject"  // reviewer ตีกลับเป็น draft
+	StatusChangeBulkSchedule StatusChangeAction = "bulk-schedule"
+	StatusChangeSchedulePlan StatusChangeAction = "schedule-plan" // schedule planner (quota + ช่วงเวลา)
+	StatusChangeAutoPublish  StatusChangeAction = "auto-publish"  // scheduler publish ตามเวลา
+)
+
+// ArticleStatusChange - ประวัติการเปลี่ยนสถานะ (ใคร เปลี่ยนจากอะไรเป็นอะไร เมื่อไหร่)
+type ArticleStatusChange struct {
+	ID        uuid.UUID `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	ArticleID uuid.UUID `gorm:"type:uuid;not null;index:idx_article_status_changes_article_created"`
+	Article   *Article  `gorm:"foreignKey:ArticleID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+
+	FromStatus ArticleStatus      `gorm:"size:20;not null"`
+	ToStatus   ArticleStatus      `gorm:"size:20;not null"`
+	Action     StatusChangeAction `gorm:"size:20;not null"`
+	ActorID    *uuid.UUID         `gorm:"type:uuid;index"` // nil = system (scheduler)
+	ReviewerID *uuid.UUID         `gorm:"type:uuid"`       // reviewer ณ ตอนเปลี่ยน
+	Notes      string             `gorm:"type:text"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime;index:idx_article_status_changes_article_created"`
+}
+
+func (ArticleStatusChange) TableName() string {
+	return "article_status_changes"
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ArticleVideo - video ที่บทความครอบคลุม (ranking/best-of/guide มีได้หลาย video)
+type ArticleVideo struct {
+	ArticleID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	VideoID   uuid.UUID `gorm:"type:uuid;primaryKey;index"`
+	Position  int       `gorm:"not null;default:0"` // ลำดับในบทความ (1 = อันดับแรก)
+
+	// DeletedAt - ตั้งเป็น deleted_at ของ video ตอน video เข้าถังขยะ (restore video คืนแถวที่ค่าตรงกัน)
+	// ไม่ใช้ gorm.DeletedAt เพราะ generator เขียนทับ membership ด้วย hard delete + insert คีย์เดิม
+	DeletedAt *time.Time `gorm:"index"`
+
+	Video *Video `gorm:"foreignKey:VideoID"`
+}
+
+func (ArticleVideo) TableName() string {
+	return "article_videos"
+}
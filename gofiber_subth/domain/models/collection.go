@@ -0,0 +1,56 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Collection - ชุด video ที่ editor คัดเอง เช่น "Best of 2024"
+// ต่างจาก Series ตรงที่ video อยู่ได้หลาย collection และเลือกโชว์เป็น section หน้าแรกได้
+type Collection struct {
+	ID           uuid.UUID               `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	Name         string                  `gorm:"size:255;not null;uniqueIndex:uni_collections_name"`
+	Slug         string                  `gorm:"size:255;not null;uniqueIndex:uni_collections_slug"`
+	Description  string                  `gorm:"type:text"`
+	CoverImage   string                  `gorm:"size:500"`            // path หรือ URL ของรูปปก
+	IsPublic     bool                    `gorm:"default:false;index"` // false = draft เห็นเฉพาะ admin
+	ShowOnHome   bool                    `gorm:"default:false"`       // แสดงเป็น section หน้าแรก
+	SortOrder    int                     `gorm:"default:0;index"`     // ลำดับ section หน้าแรก
+	VideoCount   int                     `gorm:"->;-:migration"`      // นับจาก collection_videos ตอน query (ไม่นับ video ในถังขยะ)
+	Translations []CollectionTranslation `gorm:"foreignKey:CollectionID;constraint:OnDelete:CASCADE"`
+	CreatedAt    time.Time               `gorm:"autoCreateTime"`
+	UpdatedAt    time.Time               `gorm:"autoUpdateTime"`
+}
+
+func (Collection) TableName() string {
+	return "collections"
+}
+
+type CollectionTranslation struct {
+	ID           uuid.UUID `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	CollectionID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:collection_translations_collection_id_lang_key"`
+	Lang         string    `gorm:"size:5;not null;uniqueIndex:collection_translations_collection_id_lang_key"`
+	Name         string    `gorm:"size:255;not null"`
+	Description  string    `gorm:"type:text"`
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+	UpdatedAt    time.Time `gorm:"autoUpdateTime"`
+}
+
+func (CollectionTranslation) TableName() string {
+	return "collection_translations"
+}
+
+// CollectionVideo - สมาชิกของ collection (Position เริ่มที่ 1)
+type CollectionVideo struct {
+	CollectionID uuid.UUID   `gorm:"type:uuid;primaryKey"`
+	VideoID      uuid.UUID   `gorm:"type:uuid;primaryKey;index"`
+	Position     int         `gorm:"not null"`
+	Collection   *Collection `gorm:"foreignKey:CollectionID;constraint:OnDelete:CASCADE"`
+	Video        *Video      `gorm:"foreignKey:VideoID;constraint:OnDelete:CASCADE"`
+	CreatedAt    time.Time   `gorm:"autoCreateTime"`
+}
+
+func (CollectionVideo) TableName() string {
+	return "collection_videos"
+}
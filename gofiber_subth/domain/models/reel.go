@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type Reel struct {
@@ -23,8 +24,9 @@ type Reel struct {
 	Likes    []ReelLike    `gorm:"foreignKey:ReelID"`
 	Comments []ReelComment `gorm:"foreignKey:ReelID"`
 
-	CreatedAt time.Time `gorm:"autoCreateTime"`
-	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+	CreatedAt time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt gorm.DeletedAt `gorm:"index"` // Trash
 }
 
 func (Reel) TableName() string {
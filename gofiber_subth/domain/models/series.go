@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Series - ชุดของ video ที่เป็นภาคต่อกัน (ภาค 1, 2, 3 ...)
+// video หนึ่งอยู่ได้แค่ series เดียว ลำดับตอนอยู่ที่ SeriesVideo.Position
+type Series struct {
+	ID           uuid.UUID           `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	Name         string              `gorm:"size:255;not null;uniqueIndex:uni_series_name"`
+	Slug         string              `gorm:"size:255;not null;uniqueIndex:uni_series_slug"`
+	Description  string              `gorm:"type:text"`
+	CoverImage   string              `gorm:"size:500"`       // path หรือ URL ของรูปปก
+	VideoCount   int                 `gorm:"->;-:migration"` // นับจาก series_videos ตอน query (ไม่นับ video ในถังขยะ)
+	Translations []SeriesTranslation `gorm:"foreignKey:SeriesID;constraint:OnDelete:CASCADE"`
+	CreatedAt    time.Time           `gorm:"autoCreateTime"`
+	UpdatedAt    time.Time           `gorm:"autoUpdateTime"`
+}
+
+func (Series) TableName() string {
+	return "series"
+}
+
+type SeriesTranslation struct {
+	ID          uuid.UUID `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	SeriesID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:series_translations_series_id_lang_key"`
+	Lang        string    `gorm:"size:5;not null;uniqueIndex:series_translations_series_id_lang_key"`
+	Name        string    `gorm:"size:255;not null"`
+	Description string    `gorm:"type:text"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+}
+
+func (SeriesTranslation) TableName() string {
+	return "series_translations"
+}
+
+// SeriesVideo - สมาชิกของ series (Position เริ่มที่ 1 = ตอนแรก)
+type SeriesVideo struct {
+	SeriesID  uuid.UUID `gorm:"type:uuid;primaryKey"`
+	VideoID   uuid.UUID `gorm:"type:uuid;primaryKey;uniqueIndex"` // video อยู่ได้ series เดียว
+	Position  int       `gorm:"not null"`
+	Series    *Series   `gorm:"foreignKey:SeriesID;constraint:OnDelete:CASCADE"`
+	Video     *Video    `gorm:"foreignKey:VideoID;constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (SeriesVideo) TableName() string {
+	return "series_videos"
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
+	"gorm.io/gorm"
 )
 
 type Video struct {
@@ -38,6 +39,9 @@ type Video struct {
 
 	CreatedAt time.Time `gorm:"autoCreateTime"`
 	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+
+	// Trash - ถูกลบแบบ soft delete (ลบจริง + ล้าง storage ตอน purge)
+	DeletedAt gorm.DeletedAt `gorm:"index"`
 }
 
 func (Video) TableName() string {
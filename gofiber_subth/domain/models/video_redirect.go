@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VideoRedirect - video ที่ถูก merge เข้า video อื่น
+// ID เดิมยังเปิดได้ (bookmark, link ในบทความเก่า) โดย redirect ไป video ที่เหลืออยู่
+type VideoRedirect struct {
+	ID          uuid.UUID  `gorm:"primaryKey;type:uuid;default:gen_random_uuid()"`
+	FromVideoID uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex"`
+	ToVideoID   uuid.UUID  `gorm:"type:uuid;not null;index"`
+	ToVideo     *Video     `gorm:"foreignKey:ToVideoID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	MergedBy    *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt   time.Time  `gorm:"autoCreateTime"`
+}
+
+func (VideoRedirect) TableName() string {
+	return "video_redirects"
+}
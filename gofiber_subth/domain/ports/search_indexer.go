@@ -0,0 +1,49 @@
+package ports
+
+import (
+	"context"
+	"fmt"
+)
+
+// SearchIndexer เป็น port interface สำหรับแจ้ง search engine ว่ามี URL ใหม่/อัปเดต
+// ไม่ว่าจะเป็น Google Indexing API, IndexNow หรือ fake สำหรับ local
+type SearchIndexer interface {
+	// Name ชื่อ provider ใช้เป็นส่วนหนึ่งของ quota key เช่น "google", "indexnow"
+	Name() string
+
+	// DailyQuota จำนวน URL สูงสุดที่ส่งได้ต่อวัน (0 = ไม่จำกัด)
+	DailyQuota() int
+
+	// Submit ส่ง URL ไปยัง search engine
+	// returns: ผลลัพธ์ต่อ URL เรียงตามลำดับเดียวกับ urls
+	Submit(ctx context.Context, urls []string) []SubmitResult
+}
+
+// IndexStatusChecker เป็น optional interface สำหรับ provider ที่ตรวจสถานะการ index ได้
+// (IndexNow ไม่มี API ให้ตรวจ จึงไม่ implement)
+type IndexStatusChecker interface {
+	// IsIndexed ตรวจว่า URL อยู่ใน index ของ search engine แล้วหรือยัง
+	IsIndexed(ctx context.Context, url string) (bool, error)
+}
+
+// SubmitResult ผลการส่ง URL หนึ่งรายการ (Err = nil คือสำเร็จ)
+type SubmitResult struct {
+	URL string
+	Err error
+}
+
+// IndexingError error ที่ได้จาก search engine พร้อม HTTP status
+type IndexingError struct {
+	Provider   string
+	StatusCode int
+	Message    string
+}
+
+func (e *IndexingError) Error() string {
+	return fmt.Sprintf("%s indexing failed (status %d): %s", e.Provider, e.StatusCode, e.Message)
+}
+
+// Retryable - 429 และ 5xx ลองใหม่ได้, status อื่น (เช่น 400/403) ลองซ้ำก็ไม่ผ่าน
+func (e *IndexingError) Retryable() bool {
+	return e.StatusCode == 429 || e.StatusCode >= 500
+}
@@ -23,6 +23,9 @@ type Storage interface {
 	// GetURL สร้าง public URL จาก path
 	GetURL(path string) string
 
+	// GetFile ดึงไฟล์จาก storage (ผู้เรียกต้อง Close)
+	GetFile(ctx context.Context, path string) (io.ReadCloser, error)
+
 	// Exists ตรวจสอบว่าไฟล์มีอยู่หรือไม่
 	Exists(ctx context.Context, path string) (bool, error)
 }
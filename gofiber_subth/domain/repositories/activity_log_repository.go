@@ -18,6 +18,9 @@ type ActivityLogRepository interface {
 	// GetByUser ดึง activity logs ของ user (paginated)
 	GetByUser(ctx context.Context, userID uuid.UUID, page, limit int) ([]*models.ActivityLog, int64, error)
 
+	// GetByUserAfter ดึง activity logs ของ user แบบ keyset (ต่อจาก after, ไม่นับ total)
+	GetByUserAfter(ctx context.Context, userID uuid.UUID, limit int, after *Keyset) ([]*models.ActivityLog, error)
+
 	// GetByPage ดึง activity logs ของ page ใดๆ (paginated)
 	GetByPage(ctx context.Context, pageType string, pageID *uuid.UUID, page, limit int) ([]*models.ActivityLog, int64, error)
 
@@ -38,6 +41,9 @@ type ActivityLogRepository interface {
 
 	// GetAll ดึง activity logs ทั้งหมด (สำหรับ admin)
 	GetAll(ctx context.Context, pageType string, page, limit int) ([]*models.ActivityLog, int64, error)
+
+	// GetAllAfter ดึง activity logs ทั้งหมดแบบ keyset (สำหรับ admin, ไม่นับ total)
+	GetAllAfter(ctx context.Context, pageType string, limit int, after *Keyset) ([]*models.ActivityLog, error)
 }
 
 // PageViewCount สำหรับ analytics
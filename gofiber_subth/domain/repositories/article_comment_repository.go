@@ -13,6 +13,7 @@ type ArticleCommentRepository interface {
 	Update(ctx context.Context, comment *models.ArticleComment) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	ListByArticle(ctx context.Context, articleID uuid.UUID, limit, offset int) ([]models.ArticleComment, int64, error)
+	ListByArticleAfter(ctx context.Context, articleID uuid.UUID, limit int, after *Keyset) ([]models.ArticleComment, error)
 	ListReplies(ctx context.Context, parentID uuid.UUID, limit, offset int) ([]models.ArticleComment, int64, error)
 	CountByArticle(ctx context.Context, articleID uuid.UUID) (int64, error)
 	CountByUser(ctx context.Context, userID uuid.UUID) (int64, error)
@@ -0,0 +1,105 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gofiber-template/domain/models"
+)
+
+// ArticleCoverageRepository - รายงานว่า video/hub ไหนยังไม่มีบทความ + คิว "needs article" ของ SEO worker
+type ArticleCoverageRepository interface {
+	// Coverage report
+	CountVideos(ctx context.Context, filter CoverageFilter) (int64, error)
+	// LanguageCoverage returns จำนวน video ที่มีบทความ (worker) ต่อภาษา
+	LanguageCoverage(ctx context.Context, filter CoverageFilter, languages []string) ([]LanguageCoverageRow, error)
+	// TypeCoverage returns จำนวนบทความ (worker) ต่อภาษา + type
+	TypeCoverage(ctx context.Context, filter CoverageFilter) ([]TypeCoverageRow, error)
+	// ListMissingVideos returns video ที่ยังไม่มีบทความในภาษานั้น (ยอดวิวมากก่อน)
+	ListMissingVideos(ctx context.Context, filter CoverageFilter, language string, offset, limit int) ([]MissingVideoRow, int64, error)
+	// HubCoverage returns cast/maker ที่มี video ถึงเกณฑ์ แต่ยังไม่มีบทความรวม (generator) ในภาษานั้น
+	HubCoverage(ctx context.Context, params HubCoverageParams) (*HubCoverageResult, error)
+
+	// Queue
+	// EnqueueMissing เพิ่ม video ที่ยังไม่มีบทความเข้าคิว (ซ้ำ = ข้าม, done แต่บทความหายไป = กลับเป็น pending)
+	EnqueueMissing(ctx context.Context, filter CoverageFilter, language string, limit int) (int64, error)
+	// Claim รับงานที่ pending หรือ lease หมดอายุ (FOR UPDATE SKIP LOCKED กัน worker แย่งกัน)
+	Claim(ctx context.Context, params QueueClaimParams) ([]models.ArticleQueueItem, error)
+	// ExtendLease / ReleaseLease ต้องส่ง leaseToken ที่ได้ตอน claim
+	ExtendLease(ctx context.Context, id uuid.UUID, leaseToken uuid.UUID, until time.Time) (*models.ArticleQueueItem, error)
+	ReleaseLease(ctx context.Context, id uuid.UUID, leaseToken uuid.UUID) error
+	// CompleteForVideo ปิดงานของ video + ภาษา (เรียกจาก IngestArticle)
+	CompleteForVideo(ctx context.Context, videoID uuid.UUID, language string) error
+	ListQueue(ctx context.Context, params QueueListParams) ([]models.ArticleQueueItem, int64, error)
+	CountQueueByStatus(ctx context.Context) (map[string]int64, error)
+}
+
+// CoverageFilter - filter video (nil/ว่าง = ไม่กรอง)
+type CoverageFilter struct {
+	MakerID    *uuid.UUID
+	CastID     *uuid.UUID
+	CategoryID *uuid.UUID
+	SEOStatus  string
+}
+
+type LanguageCoverageRow struct {
+	Language    string
+	WithArticle int64
+	Published   int64
+	Queued      int64 // pending + leased
+}
+
+type TypeCoverageRow struct {
+	Language  string
+	Type      string
+	Total     int64
+	Published int64
+}
+
+type MissingVideoRow struct {
+	VideoID     uuid.UUID
+	Code        string
+	Title       string
+	SEOStatus   string
+	Views       int
+	ReleaseDate *time.Time
+	QueueStatus string // ว่าง = ยังไม่อยู่ในคิว
+}
+
+// HubCoverageParams - SlugFormat เป็น format ของ Postgres format() เช่น "top-%s-videos"
+type HubCoverageParams struct {
+	Kind       string // cast, maker
+	Language   string
+	SlugFormat string
+	MinVideos  int
+	SubjectID  *uuid.UUID
+	Limit      int // จำนวน hub ที่ขาดบทความที่จะคืน (เรียงตาม video_count)
+}
+
+type HubCoverageResult struct {
+	Total   int64
+	Covered int64
+	Missing []HubGapRow
+}
+
+type HubGapRow struct {
+	ID         uuid.UUID
+	Name       string
+	Slug       string
+	VideoCount int
+}
+
+type QueueClaimParams struct {
+	WorkerID   string
+	Language   string // ว่าง = ทุกภาษา
+	Limit      int
+	LeaseUntil time.Time
+}
+
+type QueueListParams struct {
+	Status   string
+	Language string
+	Offset   int
+	Limit    int
+}
@@ -0,0 +1,28 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gofiber-template/domain/models"
+)
+
+type ArticleRedirectRepository interface {
+	// Upsert สร้าง redirect หรือชี้ source เดิมไปบทความใหม่ (unique: language + from_type + from_slug)
+	Upsert(ctx context.Context, redirect *models.ArticleRedirect) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.ArticleRedirect, error)
+	// FindBySource หา redirect จาก URL เดิม (language ว่าง = ทุกภาษา)
+	FindBySource(ctx context.Context, articleType string, slug string, language string) (*models.ArticleRedirect, error)
+	// DeleteBySource ลบ redirect ที่ source ชนกับ URL ที่กลับมาใช้งานจริง
+	DeleteBySource(ctx context.Context, articleType string, slug string, language string) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	List(ctx context.Context, params ArticleRedirectListParams) ([]models.ArticleRedirect, int64, error)
+}
+
+type ArticleRedirectListParams struct {
+	Limit     int
+	Offset    int
+	Language  string
+	Search    string // ค้นจาก from_slug
+	ArticleID *uuid.UUID
+}
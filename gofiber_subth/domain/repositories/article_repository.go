@@ -2,8 +2,10 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"gofiber-template/domain/models"
 )
 
@@ -14,8 +16,11 @@ type ArticleRepository interface {
 	GetBySlug(ctx context.Context, slug string) (*models.Article, error)
 	GetByVideoID(ctx context.Context, videoID uuid.UUID) (*models.Article, error)
 	GetByVideoIDAndLanguage(ctx context.Context, videoID uuid.UUID, language string) (*models.Article, error)
+	ListByVideoID(ctx context.Context, videoID uuid.UUID) ([]models.Article, error)   // บทความ worker ของ video + บทความ generator ที่มี video นี้ใน article_videos
+	ListActiveVideoIDs(ctx context.Context, articleID uuid.UUID) ([]uuid.UUID, error) // video ใน article_videos ที่ยังไม่ถูก detach (video ไม่อยู่ในถังขยะ)
+	SlugExists(ctx context.Context, slug string, language string, excludeID uuid.UUID) (bool, error)
 	Update(ctx context.Context, article *models.Article) error
-	Delete(ctx context.Context, id uuid.UUID) error
+	Delete(ctx context.Context, id uuid.UUID) error // ย้ายเข้าถังขยะ (ลบจริงผ่าน TrashRepository)
 
 	// List with filters
 	List(ctx context.Context, params ArticleListParams) ([]models.Article, int64, error)
@@ -25,14 +30,48 @@ type ArticleRepository interface {
 
 	// Status updates
 	UpdateStatus(ctx context.Context, id uuid.UUID, status models.ArticleStatus) error
-	BulkSchedule(ctx context.Context, ids []uuid.UUID, scheduledAt []interface{}) error
+	// BulkSchedule เขียนเฉพาะบทความที่ยังอยู่ใน fromStatuses (draft ต้อง quality_score >= minDraftQuality)
+	// ไม่ครบทุก id = rollback ทั้งหมด (error "article status changed")
+	BulkSchedule(ctx context.Context, ids []uuid.UUID, scheduledAt []interface{}, fromStatuses []models.ArticleStatus, minDraftQuality int) error
+
+	// Schedule planner
+	ListScheduleCandidates(ctx context.Context, ids []uuid.UUID) ([]ArticleScheduleRow, error)
+	// ListScheduledSince returns บทความ scheduled + published ตั้งแต่ since (ใช้นับ quota ต่อวัน) เรียงตามเวลา
+	ListScheduledSince(ctx context.Context, since time.Time) ([]ArticleScheduleRow, error)
+	// ApplySchedule ตั้งเวลาตาม planner (auto_scheduled = true) ใน transaction เดียว
+	// บทความที่สถานะไม่ตรง FromStatus แล้ว = rollback ทั้งหมด (error "article status changed")
+	ApplySchedule(ctx context.Context, slots []ArticleScheduleSlot) error
+	GetSchedulePolicy(ctx context.Context) (*models.ArticleSchedulePolicy, error)
+	SaveSchedulePolicy(ctx context.Context, policy *models.ArticleSchedulePolicy) error
 
 	// Scheduler queries
 	GetScheduledToPublish(ctx context.Context) ([]models.Article, error)
+	PublishIfScheduled(ctx context.Context, id uuid.UUID) (bool, error)
 
 	// Indexing queries
 	GetPendingIndexing(ctx context.Context, limit int) ([]models.Article, error)
 	UpdateIndexingStatus(ctx context.Context, id uuid.UUID, status models.IndexingStatus) error
+	ScheduleIndexingRetry(ctx context.Context, id uuid.UUID, attempts int, retryAt time.Time) error // คง pending ไว้ ส่งใหม่หลัง retryAt
+	GetSubmittedIndexing(ctx context.Context, checkedBefore time.Time, limit int) ([]models.Article, error)
+	MarkIndexingChecked(ctx context.Context, id uuid.UUID) error
+
+	// Editorial review - บทความ in_review
+	ListReviewQueue(ctx context.Context, params ReviewQueueParams) ([]models.Article, int64, error)
+
+	// Content validation - keyset ตาม id (id > afterID), filter ว่าง = ทั้งหมด
+	ListForContentCheck(ctx context.Context, afterID uuid.UUID, limit int, articleType string, status string) ([]models.Article, error)
+
+	// View counters - บวกยอดวิวที่ buffer ไว้ใน Redis (map[articleID]delta)
+	IncrementViewCounts(ctx context.Context, counts map[uuid.UUID]int64) error
+
+	// Full-text search - ต้อง refresh ทุกครั้งที่ title/meta/content เปลี่ยน
+	RefreshSearchVector(ctx context.Context, id uuid.UUID) error
+	SearchPublished(ctx context.Context, params ArticleSearchParams) (*ArticleSearchResult, error)
+
+	// Generator - บทความ ranking/best-of/guide ที่สร้างจาก catalogue
+	GetBySlugAndLanguage(ctx context.Context, slug string, language string) (*models.Article, error)
+	ListGeneratorCandidates(ctx context.Context, params ArticleCandidateParams) ([]ArticleCandidateVideo, error)
+	ReplaceVideos(ctx context.Context, articleID uuid.UUID, videos []models.ArticleVideo) error
 
 	// Public
 	GetPublishedBySlug(ctx context.Context, slug string) (*models.Article, error)
@@ -46,6 +85,110 @@ type ArticleRepository interface {
 	ListPublishedByCast(ctx context.Context, castSlug string, params PublicArticleListParams) ([]PublishedArticleWithVideo, int64, error)
 	ListPublishedByTag(ctx context.Context, tagSlug string, params PublicArticleListParams) ([]PublishedArticleWithVideo, int64, error)
 	ListPublishedByMaker(ctx context.Context, makerSlug string, params PublicArticleListParams) ([]PublishedArticleWithVideo, int64, error)
+
+	// Related - บทความ published ภาษาเดียวกัน เรียงตามคะแนน overlap กับ video ของ source
+	ListRelatedPublished(ctx context.Context, source *models.Article, weights RelatedArticleWeights, limit int) ([]RelatedPublishedArticle, error)
+}
+
+// ArticleSearchParams สำหรับ full-text search (บทความ published เท่านั้น)
+type ArticleSearchParams struct {
+	Query       string
+	Language    string // "th" (simple) or "en" (english)
+	ArticleType string // filter (facets ไม่ถูก filter นี้)
+	Limit       int
+	Offset      int
+}
+
+// ArticleSearchHit ผลค้นหาพร้อมคะแนนและ snippet (คำที่ตรงครอบด้วย <mark>)
+type ArticleSearchHit struct {
+	PublishedArticleWithVideo
+	Rank           float64
+	TitleHighlight string
+	Snippet        string
+}
+
+// ArticleSearchResult ผลค้นหา + จำนวนต่อ type
+type ArticleSearchResult struct {
+	Hits       []ArticleSearchHit
+	Total      int64
+	TypeFacets map[string]int64
+}
+
+// ReviewQueueParams filter คิว review (ค่า 0/nil/ว่าง = ไม่ filter)
+type ReviewQueueParams struct {
+	Limit           int
+	Offset          int
+	Type            string
+	Language        string
+	MinQualityScore int
+	MaxQualityScore int
+	ReviewerID      *uuid.UUID
+	Unassigned      bool   // เฉพาะที่ยังไม่มี reviewer
+	Sort            string // quality_score (default: สูงก่อน), oldest (รอนานสุดก่อน)
+}
+
+// ArticleCandidateParams เลือก video สำหรับ generator (ใส่ filter ได้ 1 อย่าง)
+// เฉพาะ video ที่มีบทความรีวิว published ในภาษานั้นแล้ว (ลิงก์ไปรีวิวได้)
+type ArticleCandidateParams struct {
+	CastID   *uuid.UUID
+	MakerID  *uuid.UUID
+	TagID    *uuid.UUID
+	Language string
+	SortBy   string // views (default), rating
+	Limit    int
+}
+
+// ArticleCandidateVideo video พร้อมข้อมูลจากบทความรีวิว
+type ArticleCandidateVideo struct {
+	VideoID         uuid.UUID
+	Code            string
+	Title           string // video_translations ภาษานั้น, ไม่มีใช้ title ของรีวิว
+	ThumbnailURL    string // content.thumbnailUrl ของรีวิว
+	Views           int
+	Rating          float64 // content.rating ของรีวิว (0 = ไม่มี)
+	ReleaseDate     *time.Time
+	DurationMinutes int
+	MakerName       string
+	CastNames       pq.StringArray `gorm:"type:text[]"`
+	TagNames        pq.StringArray `gorm:"type:text[]"`
+	ReviewSlug      string
+}
+
+// ArticleScheduleRow บทความพร้อม cast สำหรับ schedule planner
+// SlotAt = scheduled_at (scheduled) หรือ published_at (published)
+type ArticleScheduleRow struct {
+	ID            uuid.UUID
+	Title         string
+	Slug          string
+	Language      string
+	Status        models.ArticleStatus
+	QualityScore  int
+	AutoScheduled bool
+	SlotAt        *time.Time
+	CreatedAt     time.Time
+	CastIDs       pq.StringArray `gorm:"type:text[]"` // cast ของ video หลัก + article_videos
+}
+
+// ArticleScheduleSlot เวลาที่ planner วางให้บทความ
+// FromStatus = สถานะตอน planner อ่าน (สถานะเปลี่ยนระหว่างวางแผน = ไม่เขียน)
+type ArticleScheduleSlot struct {
+	ArticleID   uuid.UUID
+	FromStatus  models.ArticleStatus
+	ScheduledAt time.Time
+}
+
+// RelatedArticleWeights น้ำหนักคะแนนต่อ 1 รายการที่ตรงกัน
+type RelatedArticleWeights struct {
+	Cast    float64
+	Tag     float64
+	Maker   float64
+	AutoTag float64
+}
+
+// RelatedPublishedArticle บทความที่เกี่ยวข้องพร้อมคะแนน
+type RelatedPublishedArticle struct {
+	PublishedArticleWithVideo
+	Score float64
 }
 
 // PublicArticleListParams สำหรับ public API
@@ -55,7 +198,7 @@ type PublicArticleListParams struct {
 	Search      string
 	ArticleType string // filter by type (review, ranking, best-of, guide, news)
 	Language    string // "th" or "en" (default: th)
-	Sort        string // published_at, updated_at (default: published_at)
+	Sort        string // published_at, updated_at, view_count (default: published_at)
 	Order       string // asc, desc (default: desc)
 }
 
@@ -87,9 +230,12 @@ type ArticleListParams struct {
 type ArticleStats struct {
 	TotalArticles  int64
 	DraftCount     int64
+	InReviewCount  int64
+	ApprovedCount  int64
 	ScheduledCount int64
 	PublishedCount int64
 	IndexedCount   int64
+	SubmittedIndex int64
 	PendingIndex   int64
 	FailedIndex    int64
 }
@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gofiber-template/domain/models"
+)
+
+type ArticleRevisionRepository interface {
+	// Create บันทึก revision ใหม่ (กำหนดเลข Revision ต่อจากล่าสุดให้อัตโนมัติ)
+	Create(ctx context.Context, revision *models.ArticleRevision) error
+	GetByNumber(ctx context.Context, articleID uuid.UUID, revision int) (*models.ArticleRevision, error)
+	GetLatest(ctx context.Context, articleID uuid.UUID) (*models.ArticleRevision, error)
+	ListByArticle(ctx context.Context, articleID uuid.UUID, offset, limit int) ([]models.ArticleRevision, int64, error)
+	CountByArticle(ctx context.Context, articleID uuid.UUID) (int64, error)
+}
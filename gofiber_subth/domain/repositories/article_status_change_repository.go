@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gofiber-template/domain/models"
+)
+
+type ArticleStatusChangeRepository interface {
+	Create(ctx context.Context, change *models.ArticleStatusChange) error
+	// ListByArticle เรียงจากล่าสุด
+	ListByArticle(ctx context.Context, articleID uuid.UUID, offset, limit int) ([]models.ArticleStatusChange, int64, error)
+}
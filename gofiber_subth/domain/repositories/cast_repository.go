@@ -48,4 +48,5 @@ type CastListParams struct {
 	Order       string
 	IDs         []string // Batch fetch by IDs (comma-separated UUIDs)
 	HasArticles bool     // Filter only casts with published articles
+	MissingLang string   // Filter only casts without name in this language
 }
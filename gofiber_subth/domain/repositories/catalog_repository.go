@@ -0,0 +1,54 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// CatalogRepository - dump ข้อมูล video ทั้งหมดสำหรับ partner/analytics
+type CatalogRepository interface {
+	// Stream อ่านทีละแถวจาก cursor เรียงตาม (updated_at, id) แล้วเรียก fn (fn คืน error = หยุด)
+	Stream(ctx context.Context, params CatalogExportParams, fn func(row *CatalogRow) error) error
+}
+
+// CatalogExportParams - filter ของ export (ว่าง/nil = ไม่กรอง)
+// UpdatedSince: export เฉพาะที่เปลี่ยนหลังเวลานี้ รวม video ที่ถูกลบ (Deleted = true) เพื่อให้ฝั่งปลายทางลบตาม
+// video ที่ถูก merge เข้า video อื่นจะมาเป็นแถว Deleted = true + MergedInto (ไม่สน filter อื่น)
+type CatalogExportParams struct {
+	UpdatedSince *time.Time
+	MakerID      *uuid.UUID
+	CastID       *uuid.UUID
+	TagID        *uuid.UUID
+	Category     string // slug
+	SEOStatus    string
+	HasReel      *bool
+}
+
+// CatalogRow - 1 video; Titles/Articles เป็น JSON object {lang: ...}
+// UpdatedAt = เวลาล่าสุดของ video หรือคำแปล (ใช้เป็น since ของรอบถัดไป)
+type CatalogRow struct {
+	ID          uuid.UUID
+	Code        string
+	Thumbnail   string
+	EmbedURL    string
+	ReleaseDate *time.Time
+	Views       int
+	AutoTags    pq.StringArray `gorm:"type:text[]"`
+	SEOStatus   string
+	HasReel     bool
+	Reels       int
+	MakerSlug   string
+	MakerName   string
+	Titles      string
+	Casts       pq.StringArray `gorm:"type:text[]"`
+	Tags        pq.StringArray `gorm:"type:text[]"`
+	Categories  pq.StringArray `gorm:"type:text[]"`
+	Articles    string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Deleted     bool
+	MergedInto  *uuid.UUID // video ปลายทาง ถ้าแถวนี้ถูก merge (Deleted = true)
+}
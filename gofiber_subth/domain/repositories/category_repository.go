@@ -13,7 +13,7 @@ type CategoryRepository interface {
 	GetBySlug(ctx context.Context, slug string) (*models.Category, error)
 	GetByName(ctx context.Context, name string) (*models.Category, error)
 	GetOrCreate(ctx context.Context, name string) (*models.Category, error)
-	List(ctx context.Context) ([]*models.Category, error)
+	List(ctx context.Context, params CategoryListParams) ([]*models.Category, error)
 	Update(ctx context.Context, category *models.Category) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	UpdateVideoCount(ctx context.Context, id uuid.UUID) error
@@ -28,3 +28,7 @@ type CategoryRepository interface {
 	CreateTranslation(ctx context.Context, trans *models.CategoryTranslation) error
 	DeleteTranslationsByCategoryID(ctx context.Context, categoryID uuid.UUID) error
 }
+
+type CategoryListParams struct {
+	MissingLang string // Filter only categories without name in this language
+}
@@ -0,0 +1,42 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gofiber-template/domain/models"
+)
+
+type CollectionRepository interface {
+	// Basic CRUD (Get/List คืน Translations + VideoCount)
+	Create(ctx context.Context, collection *models.Collection) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Collection, error)
+	GetBySlug(ctx context.Context, slug string) (*models.Collection, error)
+	GetByName(ctx context.Context, name string) (*models.Collection, error)
+	Update(ctx context.Context, collection *models.Collection) error
+	Delete(ctx context.Context, id uuid.UUID) error // ลบ translations และสมาชิกไปด้วย (video ไม่ถูกลบ)
+
+	// List
+	List(ctx context.Context, params CollectionListParams) ([]models.Collection, int64, error)
+	// ListHome returns collection ที่ public + show_on_home เรียงตาม sort_order (limit 0 = ทั้งหมด)
+	ListHome(ctx context.Context, limit int) ([]models.Collection, error)
+
+	// Reorder sort_order ของ section หน้าแรก
+	Reorder(ctx context.Context, collectionIDs []uuid.UUID) error
+
+	// Translations - แทนที่ของเดิมทั้งหมด
+	ReplaceTranslations(ctx context.Context, collectionID uuid.UUID, translations []models.CollectionTranslation) error
+
+	// Videos
+	// ListVideos returns video ของ collection ตามลำดับ (ไม่รวมถังขยะ, limit 0 = ทั้งหมด)
+	ListVideos(ctx context.Context, collectionID uuid.UUID, limit, offset int) ([]models.Video, error)
+	// SetVideos แทนที่สมาชิกทั้งหมดตามลำดับใน videoIDs
+	SetVideos(ctx context.Context, collectionID uuid.UUID, videoIDs []uuid.UUID) error
+}
+
+type CollectionListParams struct {
+	Limit      int
+	Offset     int
+	Search     string
+	PublicOnly bool
+}
@@ -0,0 +1,11 @@
+package repositories
+
+import "github.com/google/uuid"
+
+// Keyset - ตำแหน่งต่อจากแถวสุดท้ายของหน้าก่อน (keyset pagination แทน OFFSET)
+// Value = ค่าคอลัมน์ที่ใช้ sort (time.Time, int64) ส่วน ID ใช้ตัดสินเมื่อค่า sort ซ้ำกัน
+// method ที่รับ Keyset ไม่นับ total และ after = nil คือหน้าแรก
+type Keyset struct {
+	Value interface{}
+	ID    uuid.UUID
+}
@@ -17,6 +17,9 @@ type ReelCommentRepository interface {
 	// List by reel (with pagination)
 	ListByReel(ctx context.Context, reelID uuid.UUID, limit, offset int) ([]models.ReelComment, int64, error)
 
+	// List by reel (keyset pagination, newest first)
+	ListByReelAfter(ctx context.Context, reelID uuid.UUID, limit int, after *Keyset) ([]models.ReelComment, error)
+
 	// List replies for a comment
 	ListReplies(ctx context.Context, parentID uuid.UUID, limit, offset int) ([]models.ReelComment, int64, error)
 
@@ -22,4 +22,7 @@ type ReelRepository interface {
 
 	// List with video relation (for feed/reels with tags)
 	ListWithVideo(ctx context.Context, limit int, offset int, activeOnly bool) ([]models.Reel, int64, error)
+
+	// ListWithVideoAfter - เหมือน ListWithVideo แต่แบ่งหน้าด้วย keyset (created_at, id) สำหรับ infinite scroll
+	ListWithVideoAfter(ctx context.Context, limit int, after *Keyset, activeOnly bool) ([]models.Reel, error)
 }
@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gofiber-template/domain/models"
+)
+
+type SeriesRepository interface {
+	// Basic CRUD (Get/List คืน Translations + VideoCount)
+	Create(ctx context.Context, series *models.Series) error
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Series, error)
+	GetBySlug(ctx context.Context, slug string) (*models.Series, error)
+	GetByName(ctx context.Context, name string) (*models.Series, error)
+	Update(ctx context.Context, series *models.Series) error
+	Delete(ctx context.Context, id uuid.UUID) error // ลบ translations และสมาชิกไปด้วย (video ไม่ถูกลบ)
+
+	// List
+	List(ctx context.Context, params SeriesListParams) ([]models.Series, int64, error)
+
+	// Translations - แทนที่ของเดิมทั้งหมด
+	ReplaceTranslations(ctx context.Context, seriesID uuid.UUID, translations []models.SeriesTranslation) error
+
+	// Videos
+	// ListVideos returns video ของ series เรียงตามตอน (ไม่รวมถังขยะ) พร้อม relation สำหรับ list item
+	ListVideos(ctx context.Context, seriesID uuid.UUID) ([]models.Video, error)
+	// SetVideos แทนที่สมาชิกทั้งหมดตามลำดับใน videoIDs (index 0 = ตอนที่ 1)
+	SetVideos(ctx context.Context, seriesID uuid.UUID, videoIDs []uuid.UUID) error
+	// GetByVideoID returns series ที่ video อยู่ (gorm.ErrRecordNotFound ถ้าไม่อยู่ใน series ไหน)
+	GetByVideoID(ctx context.Context, videoID uuid.UUID) (*models.Series, error)
+	// GetSeriesIDsByVideoIDs returns map[videoID]seriesID ของ video ที่อยู่ใน series แล้ว
+	GetSeriesIDsByVideoIDs(ctx context.Context, videoIDs []uuid.UUID) (map[uuid.UUID]uuid.UUID, error)
+}
+
+type SeriesListParams struct {
+	Limit  int
+	Offset int
+	Search string
+}
@@ -0,0 +1,44 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SitemapRepository - query แบบเบาสำหรับสร้าง sitemap (ไม่โหลด content ทั้งก้อน)
+type SitemapRepository interface {
+	// ListPublishedArticles returns บทความ published เรียงตาม id (keyset: id > afterID)
+	ListPublishedArticles(ctx context.Context, afterID uuid.UUID, limit int) ([]SitemapArticle, error)
+	// ListCasts/ListTags/ListMakers returns เฉพาะรายการที่มีบทความ published
+	// LastMod = updated_at ล่าสุดของบทความที่เกี่ยวข้อง
+	ListCasts(ctx context.Context) ([]SitemapEntity, error)
+	ListTags(ctx context.Context) ([]SitemapEntity, error)
+	ListMakers(ctx context.Context) ([]SitemapEntity, error)
+}
+
+// SitemapArticle - ข้อมูลบทความที่ sitemap ต้องใช้
+type SitemapArticle struct {
+	ID              uuid.UUID
+	VideoID         uuid.UUID
+	Type            string
+	Origin          string
+	Language        string
+	Slug            string
+	Title           string
+	MetaDescription string
+	PublishedAt     *time.Time
+	UpdatedAt       time.Time
+
+	// Video sitemap
+	ThumbnailURL    string // content.thumbnailUrl
+	EmbedURL        string // videos.embed_url
+	DurationMinutes int    // content.facts.durationMinutes
+}
+
+// SitemapEntity - cast/tag/maker ที่มีหน้ารวมบทความ
+type SitemapEntity struct {
+	Slug    string
+	LastMod time.Time
+}
@@ -48,6 +48,7 @@ type TagListParams struct {
 	Order       string
 	IDs         []string // Batch fetch by IDs
 	HasArticles bool     // Filter only tags with published articles
+	MissingLang string   // Filter only tags without name in this language
 }
 
 // AutoTagLabel Repository
@@ -0,0 +1,46 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// TranslationRepository - คำแปลชื่อ video/cast/tag/category แบบ bulk (import/export)
+type TranslationRepository interface {
+	// List returns ต้นฉบับ + คำแปลปัจจุบันของทุก entity ประเภท EntityType (เรียงตาม key)
+	List(ctx context.Context, params TranslationListParams) ([]TranslationRow, error)
+	// GetByIDs returns เฉพาะ entity ที่มีอยู่จริง (id ที่ไม่มีจะไม่อยู่ในผล)
+	GetByIDs(ctx context.Context, entityType string, lang string, ids []uuid.UUID) ([]TranslationRow, error)
+	// Upsert เขียนคำแปลทั้งชุดใน transaction เดียว (มีแล้ว = แก้, ยังไม่มี = สร้าง)
+	Upsert(ctx context.Context, entries []TranslationUpsert) error
+}
+
+// Translation entity types
+const (
+	TranslationTypeVideo    = "video"
+	TranslationTypeCast     = "cast"
+	TranslationTypeTag      = "tag"
+	TranslationTypeCategory = "category"
+)
+
+type TranslationListParams struct {
+	EntityType  string
+	Lang        string
+	MissingOnly bool // เฉพาะที่ยังไม่มีคำแปล (หรือเป็นค่าว่าง)
+}
+
+// TranslationRow - Key = code (video) หรือ slug, Source = ชื่อต้นฉบับ (video ใช้ title en)
+type TranslationRow struct {
+	ID     uuid.UUID
+	Key    string
+	Source string
+	Text   string // ว่าง = ยังไม่มีคำแปล
+}
+
+type TranslationUpsert struct {
+	EntityType string
+	ID         uuid.UUID
+	Lang       string
+	Text       string
+}
@@ -0,0 +1,64 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gofiber-template/domain/models"
+)
+
+// TrashRepository - รายการในถังขยะ (video, article, reel ที่ถูก soft delete) + restore/purge
+type TrashRepository interface {
+	// List returns รายการในถังขยะ ลบล่าสุดก่อน
+	List(ctx context.Context, params TrashListParams) ([]TrashItemRow, int64, error)
+	// Get returns รายการในถังขยะ (gorm.ErrRecordNotFound = ไม่มีหรือยังไม่ถูกลบ)
+	Get(ctx context.Context, itemType string, id uuid.UUID) (*TrashItemRow, error)
+	// ListExpired returns รายการที่ถูกลบก่อน before (เก่าก่อน)
+	ListExpired(ctx context.Context, before time.Time, limit int) ([]TrashItemRow, error)
+
+	// RestoreConflict - บทความที่จะ restore ชน slug/ภาษา หรือ video+ภาษา กับบทความที่ใช้งานอยู่
+	// itemType = video จะตรวจบทความที่ถูกลบพร้อม video
+	RestoreConflict(ctx context.Context, itemType string, id uuid.UUID) (bool, error)
+	// RestoreVideo คืน video + บทความ/reels ที่ถูกลบพร้อมกัน แล้วคำนวณ video_count ใหม่
+	RestoreVideo(ctx context.Context, id uuid.UUID) error
+	RestoreArticle(ctx context.Context, id uuid.UUID) error
+	RestoreReel(ctx context.Context, id uuid.UUID) error
+
+	// PurgeVideo ลบจริง video + translations, associations, บทความ/reels ในถังขยะของ video
+	PurgeVideo(ctx context.Context, id uuid.UUID) error
+	PurgeArticle(ctx context.Context, id uuid.UUID) error
+	PurgeReel(ctx context.Context, id uuid.UUID) error
+
+	// ListArticles returns บทความที่ได้รับผลจากการ restore/purge รายการนี้ รวมในถังขยะ (ใช้ล้าง cache)
+	// article = ตัวมันเอง, video = บทความ worker ของ video, reel = ไม่มี
+	ListArticles(ctx context.Context, itemType string, id uuid.UUID) ([]models.Article, error)
+
+	// CountVideoArticles นับบทความทั้งหมดของ video รวมในถังขยะ (ใช้ตัดสินว่าล้างไฟล์ใน storage ได้หรือยัง)
+	CountVideoArticles(ctx context.Context, videoID uuid.UUID) (int64, error)
+}
+
+// Trash item types
+const (
+	TrashTypeVideo   = "video"
+	TrashTypeArticle = "article"
+	TrashTypeReel    = "reel"
+)
+
+type TrashListParams struct {
+	Type   string // ว่าง = ทุกประเภท
+	Offset int
+	Limit  int
+}
+
+// TrashItemRow - 1 รายการในถังขยะ
+// VideoCode ใช้หา path ใน storage (articles/{code}/, thumbnail)
+type TrashItemRow struct {
+	Type      string
+	ID        uuid.UUID
+	Title     string
+	VideoID   *uuid.UUID
+	VideoCode string
+	Thumbnail string
+	DeletedAt time.Time
+}
@@ -0,0 +1,47 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TrendingRepository - คำนวณคะแนน trending จาก activity (decay ตามเวลา) + ดึงข้อมูลแสดงผลของ item
+type TrendingRepository interface {
+	// Scores returns คะแนน trending ของ kind (video, cast, tag, maker) มากก่อน
+	Scores(ctx context.Context, params TrendingScoreParams) ([]TrendingScoreRow, error)
+	// Items returns ชื่อ/slug ของ item ตามภาษา (id ที่ไม่มีแล้วจะไม่อยู่ในผล)
+	Items(ctx context.Context, kind string, lang string, ids []uuid.UUID) ([]TrendingItemRow, error)
+}
+
+// Trending kinds
+const (
+	TrendingKindVideo = "video"
+	TrendingKindCast  = "cast"
+	TrendingKindTag   = "tag"
+	TrendingKindMaker = "maker"
+)
+
+// TrendingScoreParams - นับเฉพาะ event ตั้งแต่ Since, น้ำหนักลดลงครึ่งหนึ่งทุก HalfLife
+// Lang: นับเฉพาะ video ที่มีชื่อในภาษานั้น
+type TrendingScoreParams struct {
+	Kind     string
+	Lang     string
+	Since    time.Time
+	Now      time.Time
+	HalfLife time.Duration
+	Limit    int
+}
+
+type TrendingScoreRow struct {
+	ID    uuid.UUID
+	Score float64
+}
+
+type TrendingItemRow struct {
+	ID        uuid.UUID
+	Name      string // video = title
+	Slug      string // video = code
+	Thumbnail string // video เท่านั้น
+}
@@ -2,8 +2,10 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"gofiber-template/domain/models"
 )
 
@@ -12,11 +14,14 @@ type VideoRepository interface {
 	Create(ctx context.Context, video *models.Video) error
 	GetByID(ctx context.Context, id uuid.UUID) (*models.Video, error)
 	Update(ctx context.Context, video *models.Video) error
-	Delete(ctx context.Context, id uuid.UUID) error
+	Delete(ctx context.Context, id uuid.UUID) error // ย้ายเข้าถังขยะพร้อมบทความ/reels ของ video
 
 	// List with filters
 	List(ctx context.Context, params VideoListParams) ([]models.Video, int64, error)
 
+	// ListAfter - filter/sort เดียวกับ List แต่แบ่งหน้าด้วย keyset (ไม่ใช้ Offset, ไม่นับ total)
+	ListAfter(ctx context.Context, params VideoListParams, after *Keyset) ([]models.Video, error)
+
 	// Relations
 	GetWithRelations(ctx context.Context, id uuid.UUID) (*models.Video, error)
 
@@ -33,10 +38,15 @@ type VideoRepository interface {
 
 	// Search
 	SearchByTitle(ctx context.Context, query string, lang string, limit int, offset int) ([]models.Video, int64, error)
+	// FacetedSearch - filter หลายค่าต่อมิติ (AND/OR) + จำนวนต่อ facet ของผลที่ filter แล้ว
+	FacetedSearch(ctx context.Context, params VideoFacetParams) (*VideoFacetResult, error)
 
 	// Get titles by IDs (for activity log enrichment)
 	GetTitlesByIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]string, error)
 
+	// View counters - บวกยอดวิวที่ buffer ไว้ใน Redis (map[videoID]delta)
+	IncrementViews(ctx context.Context, counts map[uuid.UUID]int64) error
+
 	// By relations
 	GetByMakerID(ctx context.Context, makerID uuid.UUID, limit int, offset int) ([]models.Video, int64, error)
 	GetByCastID(ctx context.Context, castID uuid.UUID, limit int, offset int) ([]models.Video, int64, error)
@@ -54,19 +64,132 @@ type VideoRepository interface {
 
 	// Get videos by embed codes (for cleanup)
 	GetByEmbedCodes(ctx context.Context, codes []string) ([]models.Video, error)
+
+	// Duplicate detection / merge
+	// ListDuplicateGroups จัดกลุ่ม video ซ้ำใน SQL แล้วคืน video ของกลุ่มในหน้านั้น (เรียงตามกลุ่ม, เก่าก่อน) + จำนวนกลุ่มทั้งหมด
+	// match ว่าง = ทุกเกณฑ์ (code, embed, thumbnail)
+	ListDuplicateGroups(ctx context.Context, match string, limit, offset int) ([]VideoDuplicateRow, int64, error)
+	// ArticleLanguageConflicts returns ภาษาที่มีบทความ worker มากกว่า 1 เรื่องใน video ที่จะ merge รวมกัน
+	ArticleLanguageConflicts(ctx context.Context, ids []uuid.UUID) ([]string, error)
+	// MergeInto ย้ายข้อมูลทั้งหมดของ sourceIDs ไปที่ targetID, ลบ source, สร้าง redirect และคำนวณ video_count ใหม่ (transaction เดียว)
+	MergeInto(ctx context.Context, targetID uuid.UUID, sourceIDs []uuid.UUID, mergedBy *uuid.UUID) (*VideoMergeResult, error)
+	// GetRedirect หา video ปลายทางของ ID ที่ถูก merge ไปแล้ว
+	GetRedirect(ctx context.Context, fromID uuid.UUID) (*models.VideoRedirect, error)
+
+	// Bulk edit
+	// ListIDs returns id ของ video ที่ตรง filter (เรียงเก่าก่อน สูงสุด params.Limit)
+	ListIDs(ctx context.Context, params VideoListParams) ([]uuid.UUID, error)
+	// GetForBulkEdit returns video ตาม id หรือ code พร้อม Maker, Casts, Tags, Categories
+	GetForBulkEdit(ctx context.Context, ids []uuid.UUID, codes []string) ([]models.Video, error)
+	// BulkEdit เขียนการแก้ไขทั้งชุด + คำนวณ video_count ใหม่ (transaction เดียว)
+	BulkEdit(ctx context.Context, edit VideoBulkEdit) error
 }
 
+// VideoNoReleaseDate - ค่าแทน release_date ที่ว่างเมื่อ sort ด้วย date แบบ keyset (อยู่ท้ายสุดเมื่อเรียงใหม่ก่อน)
+var VideoNoReleaseDate = time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)
+
 type VideoListParams struct {
-	Limit     int
-	Offset    int
-	Lang      string
-	Search    string
-	MakerID   *uuid.UUID
-	CastID    *uuid.UUID
-	TagID     *uuid.UUID
-	AutoTags  []string
-	Category  string
-	SortBy    string
-	Order     string
-	MissingTh bool // Filter videos without Thai title
+	Limit       int
+	Offset      int
+	Lang        string
+	Search      string
+	MakerID     *uuid.UUID
+	CastID      *uuid.UUID
+	TagID       *uuid.UUID
+	AutoTags    []string
+	Category    string
+	SortBy      string
+	Order       string
+	MissingLang string // Filter videos without title in this language (en, th, ja)
+}
+
+// VideoFacetParams - filter ของ faceted search (ว่าง/nil = ไม่กรอง)
+// *MatchAll = true: video ต้องมีครบทุกค่า (AND), false: มีค่าใดค่าหนึ่ง (OR)
+// maker มีได้ค่าเดียวต่อ video จึงเป็น OR เสมอ
+type VideoFacetParams struct {
+	Lang             string // ภาษาของชื่อใน facet + search
+	Search           string
+	MakerIDs         []uuid.UUID
+	CastIDs          []uuid.UUID
+	CastMatchAll     bool
+	TagIDs           []uuid.UUID
+	TagMatchAll      bool
+	CategoryIDs      []uuid.UUID
+	CategoryMatchAll bool
+	AutoTags         []string
+	AutoTagMatchAll  bool
+	ReleasedFrom     *time.Time
+	ReleasedTo       *time.Time
+	SortBy           string
+	Order            string
+	Limit            int
+	Offset           int
+	FacetLimit       int // จำนวน bucket สูงสุดต่อ facet
+}
+
+// VideoFacetRow - 1 bucket ของ facet (Facet = maker, cast, tag, category, auto_tag)
+// Value = id (auto_tag = key)
+type VideoFacetRow struct {
+	Facet string
+	Value string
+	Name  string
+	Slug  string
+	Count int64
+}
+
+type VideoFacetResult struct {
+	Videos []models.Video
+	Total  int64
+	Facets []VideoFacetRow
+}
+
+// VideoDuplicateRow - video 1 ตัวในกลุ่มซ้ำ + ข้อมูลที่ใช้เลือก video ที่ควรเก็บไว้
+type VideoDuplicateRow struct {
+	GroupKey     string         // id ที่น้อยที่สุดของกลุ่ม (video ในกลุ่มเดียวกันมีค่าเดียวกัน)
+	Reasons      pq.StringArray `gorm:"type:text[]"` // เกณฑ์ที่ video นี้ตรงกับ video อื่นในกลุ่ม
+	ID           uuid.UUID
+	Code         string
+	EmbedURL     string
+	Thumbnail    string
+	Title        string // en (ไม่มี = ภาษาแรกที่มี)
+	Views        int
+	HasReel      bool
+	Translations int
+	Articles     int
+	CreatedAt    time.Time
+}
+
+// VideoMergeResult - จำนวน row ที่ถูกย้ายมาที่ video ปลายทาง
+type VideoMergeResult struct {
+	Translations int64
+	Casts        int64
+	Tags         int64
+	Categories   int64
+	Reels        int64
+	Articles     int64
+}
+
+// VideoRelation - 1 แถวใน video_casts / video_tags / video_categories
+type VideoRelation struct {
+	VideoID   uuid.UUID
+	RelatedID uuid.UUID
+}
+
+// VideoBulkEdit - diff ที่คำนวณแล้ว (มีเฉพาะ video ที่เปลี่ยนจริง)
+type VideoBulkEdit struct {
+	AddCasts         []VideoRelation
+	RemoveCasts      []VideoRelation
+	AddTags          []VideoRelation
+	RemoveTags       []VideoRelation
+	AddCategories    []VideoRelation
+	RemoveCategories []VideoRelation
+
+	MakerVideoIDs []uuid.UUID
+	MakerID       *uuid.UUID // nil = ไม่มี maker
+
+	AutoTagVideoIDs []uuid.UUID
+	AutoTags        []string
+
+	SEOStatusVideoIDs []uuid.UUID
+	SEOStatus         string
 }
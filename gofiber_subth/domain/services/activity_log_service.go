@@ -17,6 +17,9 @@ type ActivityLogService interface {
 	// GetUserHistory ดึงประวัติ activity ของ user
 	GetUserHistory(ctx context.Context, userID uuid.UUID, page, limit int) ([]*dto.ActivityLogResponse, int64, error)
 
+	// GetUserHistoryAfter ดึงประวัติ activity ของ user แบบ cursor (cursor ว่าง = หน้าแรก)
+	GetUserHistoryAfter(ctx context.Context, userID uuid.UUID, cursor string, limit int) ([]*dto.ActivityLogResponse, string, error)
+
 	// GetPageViews ดึง view count ของ page
 	GetPageViews(ctx context.Context, pageType string, pageID *uuid.UUID) (int64, error)
 
@@ -28,6 +31,9 @@ type ActivityLogService interface {
 
 	// GetAllActivity ดึง activity logs ทั้งหมด (สำหรับ admin)
 	GetAllActivity(ctx context.Context, pageType string, page, limit int) ([]*dto.ActivityLogWithUserResponse, int64, error)
+
+	// GetAllActivityAfter ดึง activity logs ทั้งหมดแบบ cursor (สำหรับ admin)
+	GetAllActivityAfter(ctx context.Context, pageType string, cursor string, limit int) ([]*dto.ActivityLogWithUserResponse, string, error)
 }
 
 type ActivityQueueService interface {
@@ -13,6 +13,7 @@ type ArticleCommentService interface {
 	Update(ctx context.Context, userID, commentID uuid.UUID, req *dto.UpdateArticleCommentRequest) (*dto.ArticleCommentResponse, error)
 	Delete(ctx context.Context, userID, commentID uuid.UUID) error
 	ListByArticle(ctx context.Context, articleID uuid.UUID, page, limit int) ([]dto.ArticleCommentResponse, int64, error)
+	ListByArticleAfter(ctx context.Context, articleID uuid.UUID, cursor string, limit int) ([]dto.ArticleCommentResponse, string, error)
 	ListReplies(ctx context.Context, parentID uuid.UUID, page, limit int) ([]dto.ArticleCommentResponse, int64, error)
 	GetCommentsCount(ctx context.Context, articleID uuid.UUID) (int, error)
 }
@@ -0,0 +1,22 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gofiber-template/domain/dto"
+)
+
+// ArticleCoverageService รายงาน video/hub ที่ยังไม่มีบทความ + คิวงานของ SEO worker (lease)
+type ArticleCoverageService interface {
+	// Admin
+	GetCoverage(ctx context.Context, params *dto.ArticleCoverageParams) (*dto.ArticleCoverageResponse, error)
+	ListMissingVideos(ctx context.Context, params *dto.ArticleCoverageMissingParams) ([]dto.MissingVideoResponse, int64, error)
+	EnqueueMissing(ctx context.Context, req *dto.EnqueueCoverageRequest) (*dto.EnqueueCoverageResponse, error)
+	ListQueue(ctx context.Context, params *dto.ArticleQueueListParams) ([]dto.ArticleQueueItemResponse, int64, error)
+
+	// SEO worker (lease-based)
+	ClaimQueueItems(ctx context.Context, req *dto.ClaimArticleQueueRequest) ([]dto.ArticleQueueItemResponse, error)
+	ExtendLease(ctx context.Context, id uuid.UUID, req *dto.ArticleQueueLeaseRequest) (*dto.ArticleQueueItemResponse, error)
+	ReleaseLease(ctx context.Context, id uuid.UUID, req *dto.ArticleQueueLeaseRequest) error
+}
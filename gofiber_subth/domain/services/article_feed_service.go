@@ -0,0 +1,16 @@
+package services
+
+import (
+	"context"
+
+	"gofiber-template/domain/dto"
+)
+
+// ArticleFeedService - RSS/Atom ของบทความที่ publish แล้ว
+// format: "rss" หรือ "atom"
+type ArticleFeedService interface {
+	GetLatestFeed(ctx context.Context, format string, params *dto.ArticleFeedParams) (*dto.ArticleFeedResponse, error)
+	GetCastFeed(ctx context.Context, castSlug string, format string, params *dto.ArticleFeedParams) (*dto.ArticleFeedResponse, error)
+	GetTagFeed(ctx context.Context, tagSlug string, format string, params *dto.ArticleFeedParams) (*dto.ArticleFeedResponse, error)
+	GetMakerFeed(ctx context.Context, makerSlug string, format string, params *dto.ArticleFeedParams) (*dto.ArticleFeedResponse, error)
+}
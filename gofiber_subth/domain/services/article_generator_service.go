@@ -0,0 +1,12 @@
+package services
+
+import (
+	"context"
+
+	"gofiber-template/domain/dto"
+)
+
+// ArticleGeneratorService สร้างบทความ ranking/best-of/guide จาก catalogue (บันทึกเป็น draft)
+type ArticleGeneratorService interface {
+	GenerateArticle(ctx context.Context, req *dto.GenerateArticleRequest) (*dto.GenerateArticleResponse, error)
+}
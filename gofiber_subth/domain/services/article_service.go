@@ -23,8 +23,19 @@ type ArticleService interface {
 	GetStats(ctx context.Context) (*dto.ArticleStatsResponse, error)
 
 	// Status management
-	UpdateStatus(ctx context.Context, id uuid.UUID, req *dto.UpdateArticleStatusRequest) error
-	BulkSchedule(ctx context.Context, req *dto.BulkScheduleRequest) error
+	UpdateStatus(ctx context.Context, id uuid.UUID, actorID uuid.UUID, req *dto.UpdateArticleStatusRequest) error
+	BulkSchedule(ctx context.Context, actorID uuid.UUID, req *dto.BulkScheduleRequest) (int, error)
+
+	// Schedule planner - quota ต่อวัน + ช่วงเวลา publish (apply = false คือ preview)
+	PlanSchedule(ctx context.Context, actorID uuid.UUID, req *dto.SchedulePlanRequest, apply bool) (*dto.SchedulePlanResponse, error)
+	RebalanceSchedule(ctx context.Context, dryRun bool) (*dto.SchedulePlanResponse, error)
+	GetSchedulePolicy(ctx context.Context) (*dto.SchedulePolicyResponse, error)
+
+	// Editorial review
+	AssignReviewer(ctx context.Context, id uuid.UUID, actorID uuid.UUID, req *dto.AssignReviewerRequest) (*dto.ArticleDetailResponse, error)
+	DecideReview(ctx context.Context, id uuid.UUID, reviewerID uuid.UUID, req *dto.ReviewDecisionRequest) (*dto.ArticleDetailResponse, error)
+	ListStatusHistory(ctx context.Context, id uuid.UUID, params *dto.ArticleStatusHistoryParams) ([]dto.ArticleStatusChangeResponse, int64, error)
+	ListReviewQueue(ctx context.Context, userID uuid.UUID, params *dto.ReviewQueueParams) ([]dto.ArticleListItemResponse, int64, error)
 
 	// Scheduler
 	PublishScheduledArticles(ctx context.Context) (int, error)
@@ -36,6 +47,28 @@ type ArticleService interface {
 	ListArticlesByCast(ctx context.Context, castSlug string, params *dto.PublicArticleListParams) ([]dto.PublicArticleSummary, int64, error)
 	ListArticlesByTag(ctx context.Context, tagSlug string, params *dto.PublicArticleListParams) ([]dto.PublicArticleSummary, int64, error)
 	ListArticlesByMaker(ctx context.Context, makerSlug string, params *dto.PublicArticleListParams) ([]dto.PublicArticleSummary, int64, error)
+	SearchPublishedArticles(ctx context.Context, params *dto.ArticleSearchParams) (*dto.ArticleSearchResponse, error)
+	GetRelatedArticles(ctx context.Context, articleType string, slug string, params *dto.RelatedArticleParams) ([]dto.RelatedArticleSummary, error)
+
+	// Preview - signed token ดูบทความที่ยังไม่ publish (revoke ได้, ไม่ใช้ cache)
+	CreatePreviewToken(ctx context.Context, articleID uuid.UUID, req *dto.CreateArticlePreviewRequest) (*dto.ArticlePreviewTokenResponse, error)
+	RevokePreviewTokens(ctx context.Context, articleID uuid.UUID, tokenID string) (*dto.RevokeArticlePreviewResponse, error)
+	GetPreviewArticle(ctx context.Context, token string) (*dto.PublicArticleResponse, error)
+
+	// Admin edit & revisions
+	UpdateArticle(ctx context.Context, id uuid.UUID, userID uuid.UUID, req *dto.UpdateArticleRequest) (*dto.ArticleDetailResponse, error)
+	ListRevisions(ctx context.Context, articleID uuid.UUID, params *dto.ArticleRevisionListParams) ([]dto.ArticleRevisionSummary, int64, error)
+	GetRevision(ctx context.Context, articleID uuid.UUID, revision int) (*dto.ArticleRevisionResponse, error)
+	DiffRevisions(ctx context.Context, articleID uuid.UUID, from int, to int) (*dto.ArticleRevisionDiffResponse, error)
+	RestoreRevision(ctx context.Context, articleID uuid.UUID, revision int, userID uuid.UUID) (*dto.ArticleDetailResponse, error)
+
+	// Slug redirects
+	ListRedirects(ctx context.Context, params *dto.ArticleRedirectListParams) ([]dto.ArticleRedirectResponse, int64, error)
+	CreateRedirect(ctx context.Context, userID uuid.UUID, req *dto.CreateArticleRedirectRequest) (*dto.ArticleRedirectResponse, error)
+	DeleteRedirect(ctx context.Context, id uuid.UUID) error
+
+	// Content validation
+	ValidateStoredContent(ctx context.Context, req *dto.ArticleContentCheckRequest) (*dto.ArticleContentCheckResponse, error)
 
 	// Cache management
 	ClearArticleCache(ctx context.Context, articleType string, slug string) error
@@ -0,0 +1,19 @@
+package services
+
+import (
+	"context"
+	"io"
+
+	"gofiber-template/domain/dto"
+)
+
+type CatalogService interface {
+	// Validate ตรวจ filter ก่อนเริ่ม stream (หลังเริ่มเขียน response แล้วเปลี่ยน status ไม่ได้)
+	Validate(req *dto.CatalogExportRequest) error
+
+	// Export stream video ทีละแถวลง w เป็น JSONL หรือ CSV (gzip ถ้า req.Gzip)
+	Export(ctx context.Context, req *dto.CatalogExportRequest, w io.Writer) (*dto.CatalogExportResult, error)
+
+	// Filename ชื่อไฟล์ + Content-Type ตาม format/gzip
+	Filename(req *dto.CatalogExportRequest) (string, string)
+}
@@ -0,0 +1,31 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gofiber-template/domain/dto"
+)
+
+type CollectionService interface {
+	// CRUD (admin - เห็น draft ด้วย)
+	CreateCollection(ctx context.Context, req *dto.CreateCollectionRequest) (*dto.CollectionDetailResponse, error)
+	GetCollection(ctx context.Context, id uuid.UUID, lang string) (*dto.CollectionDetailResponse, error)
+	UpdateCollection(ctx context.Context, id uuid.UUID, req *dto.UpdateCollectionRequest) (*dto.CollectionDetailResponse, error)
+	DeleteCollection(ctx context.Context, id uuid.UUID) error
+	ReorderCollections(ctx context.Context, req *dto.ReorderCollectionsRequest) error
+
+	// Public - collection ที่ไม่ public จะได้ "collection not found"
+	GetCollectionBySlug(ctx context.Context, slug string, lang string) (*dto.CollectionDetailResponse, error)
+	ListCollectionVideosBySlug(ctx context.Context, slug string, lang string, page int, limit int) ([]dto.VideoListItemResponse, int64, error)
+
+	// List (publicOnly = false สำหรับ admin)
+	ListCollections(ctx context.Context, req *dto.CollectionListRequest, publicOnly bool) ([]dto.CollectionResponse, int64, error)
+
+	// Videos
+	ListCollectionVideos(ctx context.Context, id uuid.UUID, lang string, page int, limit int) ([]dto.VideoListItemResponse, int64, error)
+	SetCollectionVideos(ctx context.Context, id uuid.UUID, req *dto.SetGroupVideosRequest) (*dto.CollectionDetailResponse, error)
+
+	// Homepage sections (คู่กับ VideoService.GetVideosByCategories)
+	GetHomeSections(ctx context.Context, req *dto.CollectionsHomeRequest) ([]dto.CollectionWithVideosResponse, error)
+}
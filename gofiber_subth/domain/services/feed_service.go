@@ -15,4 +15,9 @@ type FeedService interface {
 	// GetReels returns videos with reels for the reels page (video player)
 	// userID is optional - if provided, includes isLiked status
 	GetReels(ctx context.Context, page int, limit int, lang string, userID *uuid.UUID) ([]dto.ReelItemResponse, int64, error)
+
+	// GetFeedAfter / GetReelsAfter - แบบ cursor (keyset) สำหรับ infinite scroll
+	// cursor ว่าง = หน้าแรก, nextCursor ว่าง = ไม่มีหน้าถัดไป
+	GetFeedAfter(ctx context.Context, cursor string, limit int, lang string, userID *uuid.UUID) ([]dto.FeedItemResponse, string, error)
+	GetReelsAfter(ctx context.Context, cursor string, limit int, lang string, userID *uuid.UUID) ([]dto.ReelItemResponse, string, error)
 }
@@ -23,6 +23,9 @@ type ReelCommentService interface {
 	// List comments for a reel
 	ListByReel(ctx context.Context, reelID uuid.UUID, page, limit int) ([]dto.CommentResponse, int64, error)
 
+	// List comments for a reel by cursor (empty cursor = first page, empty nextCursor = last page)
+	ListByReelAfter(ctx context.Context, reelID uuid.UUID, cursor string, limit int) ([]dto.CommentResponse, string, error)
+
 	// List replies for a comment
 	ListReplies(ctx context.Context, parentID uuid.UUID, page, limit int) ([]dto.CommentResponse, int64, error)
 
@@ -0,0 +1,23 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gofiber-template/domain/dto"
+)
+
+type SeriesService interface {
+	// CRUD
+	CreateSeries(ctx context.Context, req *dto.CreateSeriesRequest) (*dto.SeriesDetailResponse, error)
+	GetSeries(ctx context.Context, id uuid.UUID, lang string) (*dto.SeriesDetailResponse, error)
+	GetSeriesBySlug(ctx context.Context, slug string, lang string) (*dto.SeriesDetailResponse, error)
+	UpdateSeries(ctx context.Context, id uuid.UUID, req *dto.UpdateSeriesRequest) (*dto.SeriesDetailResponse, error)
+	DeleteSeries(ctx context.Context, id uuid.UUID) error
+
+	// List
+	ListSeries(ctx context.Context, req *dto.SeriesListRequest) ([]dto.SeriesResponse, int64, error)
+
+	// Videos - แทนที่ตอนทั้งหมดตามลำดับที่ส่งมา
+	SetSeriesVideos(ctx context.Context, id uuid.UUID, req *dto.SetGroupVideosRequest) (*dto.SeriesDetailResponse, error)
+}
@@ -0,0 +1,18 @@
+package services
+
+import (
+	"context"
+
+	"gofiber-template/domain/dto"
+)
+
+type SitemapService interface {
+	// Rebuild สร้าง sitemap ทุกไฟล์ใหม่ อัปโหลดขึ้น storage และเก็บ cache ไว้ให้ endpoint
+	Rebuild(ctx context.Context) (*dto.SitemapBuildResponse, error)
+
+	// GetFile returns XML ของ sitemap ตามชื่อไฟล์ เช่น index.xml, articles-1.xml (จาก cache ที่ Rebuild สร้างไว้ ถ้าไม่มีใช้ไฟล์ใน storage)
+	GetFile(ctx context.Context, name string) ([]byte, error)
+
+	// HasIndex returns true ถ้ามี sitemap index ให้เสิร์ฟแล้ว
+	HasIndex(ctx context.Context) bool
+}
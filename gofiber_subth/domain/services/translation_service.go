@@ -0,0 +1,16 @@
+package services
+
+import (
+	"context"
+	"io"
+
+	"gofiber-template/domain/dto"
+)
+
+type TranslationService interface {
+	// Export สร้างไฟล์ CSV/JSONL ของคำแปล (ทั้งหมด หรือเฉพาะที่ยังขาด)
+	Export(ctx context.Context, req *dto.TranslationExportRequest) (*dto.TranslationExportResponse, error)
+
+	// Import ตรวจสอบ + upsert คำแปลจากไฟล์ (มีแถวผิดแม้แถวเดียว = ไม่เขียนอะไรเลย)
+	Import(ctx context.Context, req *dto.TranslationImportRequest, r io.Reader) (*dto.TranslationImportResult, error)
+}
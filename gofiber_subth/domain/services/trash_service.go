@@ -0,0 +1,23 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"gofiber-template/domain/dto"
+)
+
+type TrashService interface {
+	// ListTrash รายการ video/article/reel ที่ถูกลบ (ยังไม่ถูก purge)
+	ListTrash(ctx context.Context, req *dto.TrashListRequest) ([]dto.TrashItemResponse, int64, error)
+
+	// Restore คืนรายการจากถังขยะ (video คืนบทความ/reels ที่ถูกลบพร้อมกันด้วย)
+	Restore(ctx context.Context, itemType string, id uuid.UUID) error
+
+	// Purge ลบถาวรทันที + ล้างไฟล์ใน storage
+	Purge(ctx context.Context, itemType string, id uuid.UUID) error
+
+	// PurgeExpired ลบถาวรรายการที่อยู่ในถังขยะเกินระยะเวลาเก็บ (เรียกจาก worker)
+	PurgeExpired(ctx context.Context) (*dto.TrashPurgeResult, error)
+}
@@ -0,0 +1,15 @@
+package services
+
+import (
+	"context"
+
+	"gofiber-template/domain/dto"
+)
+
+type TrendingService interface {
+	// GetTrending อ่านอันดับจาก Redis (ไม่คำนวณใหม่)
+	GetTrending(ctx context.Context, req *dto.TrendingRequest) (*dto.TrendingResponse, error)
+
+	// Refresh คำนวณคะแนนทุก kind/window/ภาษา แล้วเขียนทับใน Redis (เรียกจาก worker)
+	Refresh(ctx context.Context) (*dto.TrendingRefreshResult, error)
+}
@@ -17,12 +17,14 @@ type VideoService interface {
 
 	// List
 	ListVideos(ctx context.Context, req *dto.VideoListRequest) ([]dto.VideoListItemResponse, int64, error)
+	ListVideosAfter(ctx context.Context, req *dto.VideoListRequest) ([]dto.VideoListItemResponse, string, error)
 
 	// Random
 	GetRandomVideos(ctx context.Context, limit int, lang string) ([]dto.VideoListItemResponse, error)
 
 	// Search
 	SearchVideos(ctx context.Context, query string, lang string, page int, limit int) ([]dto.VideoListItemResponse, int64, error)
+	FacetedSearch(ctx context.Context, req *dto.VideoFacetSearchRequest) (*dto.VideoFacetSearchResponse, error)
 
 	// By relations
 	GetVideosByMaker(ctx context.Context, makerID uuid.UUID, lang string, page int, limit int) ([]dto.VideoListItemResponse, int64, error)
@@ -36,4 +38,11 @@ type VideoService interface {
 	// Cleanup - get videos by embed codes
 	GetVideosByEmbedCodes(ctx context.Context, codes []string) ([]dto.VideoIDWithCode, error)
 	DeleteVideosByEmbedCodes(ctx context.Context, codes []string) (int, error)
+
+	// Duplicate detection / merge (admin)
+	FindDuplicates(ctx context.Context, params *dto.VideoDuplicateListParams) ([]dto.VideoDuplicateGroupResponse, int64, error)
+	MergeVideos(ctx context.Context, actorID uuid.UUID, req *dto.MergeVideosRequest) (*dto.MergeVideosResponse, error)
+
+	// Bulk edit (admin) - cast/tag/category/maker/auto tags/seo status ของหลาย video พร้อมกัน
+	BulkEditVideos(ctx context.Context, req *dto.BulkVideoEditRequest) (*dto.BulkVideoEditResponse, error)
 }
@@ -0,0 +1,19 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gofiber-template/domain/dto"
+)
+
+type ViewCounterService interface {
+	// RecordArticleView นับยอดวิวบทความ (กันนับซ้ำต่อ visitor ใน window, buffer ไว้ใน Redis)
+	RecordArticleView(ctx context.Context, articleID uuid.UUID, visitorID string) (*dto.ViewCountResponse, error)
+
+	// RecordVideoView นับยอดวิววิดีโอ
+	RecordVideoView(ctx context.Context, videoID uuid.UUID, visitorID string) (*dto.ViewCountResponse, error)
+
+	// Flush เขียนยอดวิวที่ buffer ไว้ลง Postgres (เรียกจาก worker)
+	Flush(ctx context.Context) (*dto.ViewFlushResult, error)
+}
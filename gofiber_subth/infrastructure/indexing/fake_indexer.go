@@ -0,0 +1,73 @@
+package indexing
+
+import (
+	"context"
+	"sync"
+
+	"gofiber-template/domain/ports"
+)
+
+const fakeProviderName = "fake"
+
+// FakeIndexer implements ports.SearchIndexer และ ports.IndexStatusChecker แบบ in-memory
+// ใช้สำหรับ local/dev และทดสอบ pipeline โดยไม่ต้องเรียก search engine จริง
+type FakeIndexer struct {
+	mu        sync.Mutex
+	submitted map[string]int
+	failURLs  map[string]error
+}
+
+func NewFakeIndexer() *FakeIndexer {
+	return &FakeIndexer{
+		submitted: make(map[string]int),
+		failURLs:  make(map[string]error),
+	}
+}
+
+// Name implements ports.SearchIndexer
+func (f *FakeIndexer) Name() string {
+	return fakeProviderName
+}
+
+// DailyQuota implements ports.SearchIndexer - ไม่จำกัด
+func (f *FakeIndexer) DailyQuota() int {
+	return 0
+}
+
+// Submit implements ports.SearchIndexer
+func (f *FakeIndexer) Submit(ctx context.Context, urls []string) []ports.SubmitResult {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	results := make([]ports.SubmitResult, len(urls))
+	for i, u := range urls {
+		if err, ok := f.failURLs[u]; ok {
+			results[i] = ports.SubmitResult{URL: u, Err: err}
+			continue
+		}
+		f.submitted[u]++
+		results[i] = ports.SubmitResult{URL: u}
+	}
+	return results
+}
+
+// IsIndexed implements ports.IndexStatusChecker - URL ที่ submit แล้วถือว่า indexed
+func (f *FakeIndexer) IsIndexed(ctx context.Context, url string) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.submitted[url] > 0, nil
+}
+
+// FailURL ให้ URL นี้ submit ไม่ผ่านด้วย err ที่กำหนด
+func (f *FakeIndexer) FailURL(url string, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.failURLs[url] = err
+}
+
+// Submitted returns จำนวนครั้งที่ URL ถูก submit
+func (f *FakeIndexer) Submitted(url string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.submitted[url]
+}
@@ -0,0 +1,254 @@
+package indexing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"gofiber-template/domain/ports"
+)
+
+const (
+	googleProviderName = "google"
+
+	googlePublishURL    = "https://indexing.googleapis.com/v3/urlNotifications:publish"
+	googleInspectionURL = "https://searchconsole.googleapis.com/v1/urlInspection/index:inspect"
+	googleDefaultToken  = "https://oauth2.googleapis.com/token"
+
+	// indexing สำหรับ publish, webmasters.readonly สำหรับ URL Inspection
+	googleScopes = "https://www.googleapis.com/auth/indexing https://www.googleapis.com/auth/webmasters.readonly"
+)
+
+// GoogleConfig สำหรับ Google Indexing API (service account)
+type GoogleConfig struct {
+	CredentialsFile string // path ของ service account JSON
+	SiteURL         string // Search Console property เช่น "sc-domain:subth.com" (ว่าง = ไม่ตรวจสถานะ)
+	DailyQuota      int
+}
+
+// GoogleIndexer implements ports.SearchIndexer และ ports.IndexStatusChecker
+type GoogleIndexer struct {
+	clientEmail string
+	privateKey  interface{}
+	tokenURI    string
+	siteURL     string
+	dailyQuota  int
+	httpClient  *http.Client
+
+	mu          sync.Mutex
+	accessToken string
+	tokenExpiry time.Time
+}
+
+type googleServiceAccount struct {
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+}
+
+// NewGoogleIndexer สร้าง Google Indexing API adapter จาก service account JSON
+func NewGoogleIndexer(cfg GoogleConfig) (*GoogleIndexer, error) {
+	if cfg.CredentialsFile == "" {
+		return nil, fmt.Errorf("google indexing credentials not configured")
+	}
+
+	raw, err := os.ReadFile(cfg.CredentialsFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read google credentials: %w", err)
+	}
+
+	var sa googleServiceAccount
+	if err := json.Unmarshal(raw, &sa); err != nil {
+		return nil, fmt.Errorf("failed to parse google credentials: %w", err)
+	}
+	if sa.ClientEmail == "" || sa.PrivateKey == "" {
+		return nil, fmt.Errorf("google credentials missing client_email or private_key")
+	}
+
+	key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(sa.PrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse google private key: %w", err)
+	}
+
+	tokenURI := sa.TokenURI
+	if tokenURI == "" {
+		tokenURI = googleDefaultToken
+	}
+
+	return &GoogleIndexer{
+		clientEmail: sa.ClientEmail,
+		privateKey:  key,
+		tokenURI:    tokenURI,
+		siteURL:     cfg.SiteURL,
+		dailyQuota:  cfg.DailyQuota,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}, nil
+}
+
+// Name implements ports.SearchIndexer
+func (g *GoogleIndexer) Name() string {
+	return googleProviderName
+}
+
+// DailyQuota implements ports.SearchIndexer
+func (g *GoogleIndexer) DailyQuota() int {
+	return g.dailyQuota
+}
+
+// Submit implements ports.SearchIndexer - Google รับทีละ URL
+func (g *GoogleIndexer) Submit(ctx context.Context, urls []string) []ports.SubmitResult {
+	results := make([]ports.SubmitResult, len(urls))
+	for i, u := range urls {
+		body, _ := json.Marshal(map[string]string{
+			"url":  u,
+			"type": "URL_UPDATED",
+		})
+		results[i] = ports.SubmitResult{
+			URL: u,
+			Err: g.doJSON(ctx, googlePublishURL, body, nil),
+		}
+	}
+	return results
+}
+
+// IsIndexed implements ports.IndexStatusChecker ผ่าน Search Console URL Inspection API
+func (g *GoogleIndexer) IsIndexed(ctx context.Context, pageURL string) (bool, error) {
+	if g.siteURL == "" {
+		return false, nil
+	}
+
+	body, _ := json.Marshal(map[string]string{
+		"inspectionUrl": pageURL,
+		"siteUrl":       g.siteURL,
+	})
+
+	var resp struct {
+		InspectionResult struct {
+			IndexStatusResult struct {
+				Verdict       string `json:"verdict"`
+				CoverageState string `json:"coverageState"`
+			} `json:"indexStatusResult"`
+		} `json:"inspectionResult"`
+	}
+	if err := g.doJSON(ctx, googleInspectionURL, body, &resp); err != nil {
+		return false, err
+	}
+
+	return resp.InspectionResult.IndexStatusResult.Verdict == "PASS", nil
+}
+
+// doJSON POST JSON พร้อม bearer token แล้ว decode response ลง dest (ถ้ามี)
+func (g *GoogleIndexer) doJSON(ctx context.Context, endpoint string, body []byte, dest interface{}) error {
+	token, err := g.token(ctx)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := g.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("google request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		if resp.StatusCode == http.StatusUnauthorized {
+			g.resetToken()
+		}
+		return &ports.IndexingError{
+			Provider:   googleProviderName,
+			StatusCode: resp.StatusCode,
+			Message:    strings.TrimSpace(string(msg)),
+		}
+	}
+
+	if dest == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(dest)
+}
+
+// token returns access token ที่ cache ไว้ หรือขอใหม่ด้วย JWT bearer grant
+func (g *GoogleIndexer) token(ctx context.Context) (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	// เผื่อเวลา 1 นาทีก่อนหมดอายุ
+	if g.accessToken != "" && time.Now().Add(time.Minute).Before(g.tokenExpiry) {
+		return g.accessToken, nil
+	}
+
+	now := time.Now()
+	assertion, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   g.clientEmail,
+		"scope": googleScopes,
+		"aud":   g.tokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	}).SignedString(g.privateKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign google assertion: %w", err)
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "urn:ietf:params:oauth:grant-type:jwt-bearer")
+	form.Set("assertion", assertion)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, g.tokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := g.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("google token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", &ports.IndexingError{
+			Provider:   googleProviderName,
+			StatusCode: resp.StatusCode,
+			Message:    "token exchange: " + strings.TrimSpace(string(msg)),
+		}
+	}
+
+	var tokenResp struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return "", fmt.Errorf("failed to decode google token: %w", err)
+	}
+
+	g.accessToken = tokenResp.AccessToken
+	g.tokenExpiry = now.Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+	return g.accessToken, nil
+}
+
+func (g *GoogleIndexer) resetToken() {
+	g.mu.Lock()
+	g.accessToken = ""
+	g.mu.Unlock()
+}
@@ -0,0 +1,133 @@
+package indexing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"gofiber-template/domain/ports"
+)
+
+const (
+	indexNowProviderName = "indexnow"
+	indexNowEndpoint     = "https://api.indexnow.org/indexnow"
+
+	// IndexNow รับได้สูงสุด 10,000 URL ต่อ request
+	indexNowMaxBatch = 10000
+)
+
+// IndexNowConfig สำหรับ IndexNow (Bing, Yandex, Seznam, ...)
+type IndexNowConfig struct {
+	SiteURL     string // ใช้หา host เช่น https://subth.com
+	Key         string
+	KeyLocation string // URL ของไฟล์ {key}.txt (ว่าง = {SiteURL}/{key}.txt)
+	DailyQuota  int
+}
+
+// IndexNowIndexer implements ports.SearchIndexer
+type IndexNowIndexer struct {
+	host        string
+	key         string
+	keyLocation string
+	dailyQuota  int
+	httpClient  *http.Client
+}
+
+// NewIndexNowIndexer สร้าง IndexNow adapter
+func NewIndexNowIndexer(cfg IndexNowConfig) (*IndexNowIndexer, error) {
+	if cfg.Key == "" {
+		return nil, fmt.Errorf("indexnow key not configured")
+	}
+
+	site, err := url.Parse(cfg.SiteURL)
+	if err != nil || site.Host == "" {
+		return nil, fmt.Errorf("invalid site URL for indexnow: %q", cfg.SiteURL)
+	}
+
+	keyLocation := cfg.KeyLocation
+	if keyLocation == "" {
+		keyLocation = fmt.Sprintf("%s/%s.txt", strings.TrimRight(cfg.SiteURL, "/"), cfg.Key)
+	}
+
+	return &IndexNowIndexer{
+		host:        site.Host,
+		key:         cfg.Key,
+		keyLocation: keyLocation,
+		dailyQuota:  cfg.DailyQuota,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}, nil
+}
+
+// Name implements ports.SearchIndexer
+func (n *IndexNowIndexer) Name() string {
+	return indexNowProviderName
+}
+
+// DailyQuota implements ports.SearchIndexer
+func (n *IndexNowIndexer) DailyQuota() int {
+	return n.dailyQuota
+}
+
+// Submit implements ports.SearchIndexer - ส่งทั้ง batch ใน request เดียว
+// ผลลัพธ์ของทุก URL ใน batch เดียวกันจึงเหมือนกัน
+func (n *IndexNowIndexer) Submit(ctx context.Context, urls []string) []ports.SubmitResult {
+	results := make([]ports.SubmitResult, 0, len(urls))
+
+	for start := 0; start < len(urls); start += indexNowMaxBatch {
+		end := start + indexNowMaxBatch
+		if end > len(urls) {
+			end = len(urls)
+		}
+
+		err := n.submitBatch(ctx, urls[start:end])
+		for _, u := range urls[start:end] {
+			results = append(results, ports.SubmitResult{URL: u, Err: err})
+		}
+	}
+
+	return results
+}
+
+func (n *IndexNowIndexer) submitBatch(ctx context.Context, urls []string) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"host":        n.host,
+		"key":         n.key,
+		"keyLocation": n.keyLocation,
+		"urlList":     urls,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, indexNowEndpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("indexnow request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// 200 = ส่งสำเร็จ, 202 = รับแล้วแต่ยังตรวจ key ไม่เสร็จ
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted {
+		return nil
+	}
+
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return &ports.IndexingError{
+		Provider:   indexNowProviderName,
+		StatusCode: resp.StatusCode,
+		Message:    strings.TrimSpace(string(msg)),
+	}
+}
@@ -61,6 +61,19 @@ func (r *activityLogRepositoryImpl) GetByUser(ctx context.Context, userID uuid.U
 	return logs, total, nil
 }
 
+func (r *activityLogRepositoryImpl) GetByUserAfter(ctx context.Context, userID uuid.UUID, limit int, after *repositories.Keyset) ([]*models.ActivityLog, error) {
+	var logs []*models.ActivityLog
+
+	query := r.db.WithContext(ctx).Model(&models.ActivityLog{}).Where("user_id = ?", userID)
+
+	if err := keysetPage(query, "activity_logs.created_at", "activity_logs.id", true, after, limit).Find(&logs).Error; err != nil {
+		logger.ErrorContext(ctx, "Failed to get activity logs by user", "error", err, "user_id", userID)
+		return nil, err
+	}
+
+	return logs, nil
+}
+
 func (r *activityLogRepositoryImpl) GetByPage(ctx context.Context, pageType string, pageID *uuid.UUID, page, limit int) ([]*models.ActivityLog, int64, error) {
 	var logs []*models.ActivityLog
 	var total int64
@@ -191,3 +204,21 @@ func (r *activityLogRepositoryImpl) GetAll(ctx context.Context, pageType string,
 
 	return logs, total, nil
 }
+
+func (r *activityLogRepositoryImpl) GetAllAfter(ctx context.Context, pageType string, limit int, after *repositories.Keyset) ([]*models.ActivityLog, error) {
+	var logs []*models.ActivityLog
+
+	query := r.db.WithContext(ctx).Model(&models.ActivityLog{})
+
+	// Filter by pageType if provided
+	if pageType != "" {
+		query = query.Where("page_type = ?", pageType)
+	}
+
+	if err := keysetPage(query, "activity_logs.created_at", "activity_logs.id", true, after, limit).Preload("User").Find(&logs).Error; err != nil {
+		logger.ErrorContext(ctx, "Failed to get all activity logs", "error", err)
+		return nil, err
+	}
+
+	return logs, nil
+}
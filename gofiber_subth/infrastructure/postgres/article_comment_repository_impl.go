@@ -80,6 +80,22 @@ func (r *ArticleCommentRepositoryImpl) ListByArticle(ctx context.Context, articl
 	return comments, total, nil
 }
 
+func (r *ArticleCommentRepositoryImpl) ListByArticleAfter(ctx context.Context, articleID uuid.UUID, limit int, after *repositories.Keyset) ([]models.ArticleComment, error) {
+	var comments []models.ArticleComment
+
+	query := r.db.WithContext(ctx).
+		Preload("User").
+		Where("article_id = ? AND parent_id IS NULL", articleID)
+
+	if err := keysetPage(query, "article_comments.created_at", "article_comments.id", true, after, limit).
+		Find(&comments).Error; err != nil {
+		logger.ErrorContext(ctx, "Failed to list article comments", "error", err)
+		return nil, err
+	}
+
+	return comments, nil
+}
+
 func (r *ArticleCommentRepositoryImpl) ListReplies(ctx context.Context, parentID uuid.UUID, limit, offset int) ([]models.ArticleComment, int64, error) {
 	var replies []models.ArticleComment
 	var total int64
@@ -0,0 +1,346 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/repositories"
+)
+
+type articleCoverageRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewArticleCoverageRepository(db *gorm.DB) repositories.ArticleCoverageRepository {
+	return &articleCoverageRepositoryImpl{db: db}
+}
+
+// filteredVideos - videos ตาม filter (alias: videos) ไม่รวม video ในถังขยะ
+func (r *articleCoverageRepositoryImpl) filteredVideos(ctx context.Context, filter repositories.CoverageFilter) *gorm.DB {
+	query := r.db.WithContext(ctx).Table("videos").Where("videos.deleted_at IS NULL")
+	if filter.MakerID != nil {
+		query = query.Where("videos.maker_id = ?", *filter.MakerID)
+	}
+	if filter.CastID != nil {
+		query = query.Where("EXISTS (SELECT 1 FROM video_casts WHERE video_casts.video_id = videos.id AND video_casts.cast_id = ?)", *filter.CastID)
+	}
+	if filter.CategoryID != nil {
+		query = query.Where("EXISTS (SELECT 1 FROM video_categories WHERE video_categories.video_id = videos.id AND video_categories.category_id = ?)", *filter.CategoryID)
+	}
+	if filter.SEOStatus != "" {
+		query = query.Where("videos.seo_status = ?", filter.SEOStatus)
+	}
+	return query
+}
+
+// hasArticleSQL - video มีบทความของ worker ในภาษา ? แล้ว (ทุกสถานะ)
+const hasArticleSQL = "EXISTS (SELECT 1 FROM articles WHERE articles.video_id = videos.id AND articles.language = ? AND articles.origin = 'worker' AND articles.deleted_at IS NULL)"
+
+func (r *articleCoverageRepositoryImpl) CountVideos(ctx context.Context, filter repositories.CoverageFilter) (int64, error) {
+	var total int64
+	err := r.filteredVideos(ctx, filter).Count(&total).Error
+	return total, err
+}
+
+func (r *articleCoverageRepositoryImpl) LanguageCoverage(ctx context.Context, filter repositories.CoverageFilter, languages []string) ([]repositories.LanguageCoverageRow, error) {
+	rows := make([]repositories.LanguageCoverageRow, 0, len(languages))
+	for _, lang := range languages {
+		row := repositories.LanguageCoverageRow{Language: lang}
+		err := r.filteredVideos(ctx, filter).
+			Select(`COUNT(*) FILTER (WHERE `+hasArticleSQL+`) AS with_article,
+				COUNT(*) FILTER (WHERE EXISTS (SELECT 1 FROM articles WHERE articles.video_id = videos.id
+					AND articles.language = ? AND articles.origin = 'worker' AND articles.status = ? AND articles.deleted_at IS NULL)) AS published,
+				COUNT(*) FILTER (WHERE EXISTS (SELECT 1 FROM article_queue_items q WHERE q.video_id = videos.id
+					AND q.language = ? AND q.status IN ?)) AS queued`,
+				lang, lang, models.ArticleStatusPublished,
+				lang, []models.ArticleQueueStatus{models.ArticleQueuePending, models.ArticleQueueLeased}).
+			Scan(&row).Error
+		if err != nil {
+			return nil, err
+		}
+		row.Language = lang
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func (r *articleCoverageRepositoryImpl) TypeCoverage(ctx context.Context, filter repositories.CoverageFilter) ([]repositories.TypeCoverageRow, error) {
+	var rows []repositories.TypeCoverageRow
+	videoIDs := r.filteredVideos(ctx, filter).Select("videos.id")
+	err := r.db.WithContext(ctx).
+		Table("articles").
+		Select("articles.language, articles.type, COUNT(*) AS total, COUNT(*) FILTER (WHERE articles.status = ?) AS published", models.ArticleStatusPublished).
+		Where("articles.origin = ? AND articles.deleted_at IS NULL AND articles.video_id IN (?)", models.ArticleOriginWorker, videoIDs).
+		Group("articles.language, articles.type").
+		Order("articles.language, articles.type").
+		Scan(&rows).Error
+	return rows, err
+}
+
+func (r *articleCoverageRepositoryImpl) ListMissingVideos(ctx context.Context, filter repositories.CoverageFilter, language string, offset, limit int) ([]repositories.MissingVideoRow, int64, error) {
+	var total int64
+	if err := r.filteredVideos(ctx, filter).Where("NOT "+hasArticleSQL, language).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var rows []repositories.MissingVideoRow
+	err := r.filteredVideos(ctx, filter).
+		Select(`videos.id AS video_id, videos.code, videos.seo_status, videos.views, videos.release_date,
+			COALESCE(vt.title, vt_en.title, '') AS title,
+			COALESCE(q.status, '') AS queue_status`).
+		Joins("LEFT JOIN video_translations vt ON vt.video_id = videos.id AND vt.lang = ?", language).
+		Joins("LEFT JOIN video_translations vt_en ON vt_en.video_id = videos.id AND vt_en.lang = 'en'").
+		Joins("LEFT JOIN article_queue_items q ON q.video_id = videos.id AND q.language = ?", language).
+		Where("NOT "+hasArticleSQL, language).
+		Order("videos.views DESC").
+		Order("videos.id ASC").
+		Offset(offset).
+		Limit(limit).
+		Scan(&rows).Error
+	return rows, total, err
+}
+
+// HubCoverage - hub ที่ถึงเกณฑ์ = มี video ที่มีรีวิว published ในภาษานั้นอย่างน้อย MinVideos
+// hub มีบทความแล้ว = มีบทความ generator ที่ slug ตรง SlugFormat (ทุกสถานะ)
+func (r *articleCoverageRepositoryImpl) HubCoverage(ctx context.Context, params repositories.HubCoverageParams) (*repositories.HubCoverageResult, error) {
+	var table, videoCount string
+	switch params.Kind {
+	case "maker":
+		table = "makers"
+		videoCount = `(SELECT COUNT(*) FROM videos
+			JOIN articles ON articles.video_id = videos.id AND articles.language = @lang
+				AND articles.origin = 'worker' AND articles.status = 'published' AND articles.deleted_at IS NULL
+			WHERE videos.maker_id = hubs.id)`
+	default:
+		table = "casts"
+		videoCount = `(SELECT COUNT(*) FROM video_casts
+			JOIN articles ON articles.video_id = video_casts.video_id AND articles.language = @lang
+				AND articles.origin = 'worker' AND articles.status = 'published' AND articles.deleted_at IS NULL
+			WHERE video_casts.cast_id = hubs.id)`
+	}
+	covered := `EXISTS (SELECT 1 FROM articles WHERE articles.origin = 'generator'
+		AND articles.language = @lang AND articles.deleted_at IS NULL AND articles.slug LIKE format(@slug_format, hubs.slug))`
+
+	args := map[string]interface{}{"lang": params.Language, "slug_format": params.SlugFormat, "min_videos": params.MinVideos}
+	eligible := r.db.WithContext(ctx).
+		Table(table+" AS hubs").
+		Select("hubs.id, hubs.name, hubs.slug, "+videoCount+" AS video_count", args)
+	if params.SubjectID != nil {
+		eligible = eligible.Where("hubs.id = ?", *params.SubjectID)
+	}
+
+	base := r.db.WithContext(ctx).Table("(?) AS hubs", eligible).Where("hubs.video_count >= @min_videos", args)
+
+	result := &repositories.HubCoverageResult{}
+	err := base.Session(&gorm.Session{}).
+		Select("COUNT(*) AS total, COUNT(*) FILTER (WHERE "+covered+") AS covered", args).
+		Scan(result).Error
+	if err != nil {
+		return nil, err
+	}
+
+	err = base.Session(&gorm.Session{}).
+		Select("hubs.id, hubs.name, hubs.slug, hubs.video_count").
+		Where("NOT "+covered, args).
+		Order("hubs.video_count DESC").
+		Order("hubs.slug ASC").
+		Limit(params.Limit).
+		Scan(&result.Missing).Error
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *articleCoverageRepositoryImpl) EnqueueMissing(ctx context.Context, filter repositories.CoverageFilter, language string, limit int) (int64, error) {
+	missing := r.filteredVideos(ctx, filter).
+		Select("videos.id AS video_id, ? AS language, ? AS type, ? AS status, videos.views AS priority, NOW() AS created_at, NOW() AS updated_at",
+			language, models.ArticleTypeReview, models.ArticleQueuePending).
+		Where("NOT "+hasArticleSQL, language).
+		Order("videos.views DESC").
+		Limit(limit)
+
+	// มีอยู่แล้ว: pending/leased -> ข้าม, done แต่ยังไม่มีบทความ (ถูกลบ) -> เปิดงานใหม่
+	// RowsAffected = รายการใหม่ + รายการที่เปิดใหม่
+	result := r.db.WithContext(ctx).Exec(`INSERT INTO article_queue_items (video_id, language, type, status, priority, created_at, updated_at)
+		?
+		ON CONFLICT (video_id, language) DO UPDATE SET
+			status = EXCLUDED.status,
+			priority = EXCLUDED.priority,
+			completed_at = NULL,
+			updated_at = NOW()
+		WHERE article_queue_items.status = ?`, missing, models.ArticleQueueDone)
+	return result.RowsAffected, result.Error
+}
+
+func (r *articleCoverageRepositoryImpl) Claim(ctx context.Context, params repositories.QueueClaimParams) ([]models.ArticleQueueItem, error) {
+	var items []models.ArticleQueueItem
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&models.ArticleQueueItem{}).
+			Where("status = ? OR (status = ? AND lease_expires_at < NOW())", models.ArticleQueuePending, models.ArticleQueueLeased).
+			Where("NOT EXISTS (SELECT 1 FROM videos WHERE videos.id = article_queue_items.video_id AND videos.deleted_at IS NOT NULL)")
+		if params.Language != "" {
+			query = query.Where("language = ?", params.Language)
+		}
+
+		var candidates []models.ArticleQueueItem
+		err := query.
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Order("priority DESC").
+			Order("created_at ASC").
+			Limit(params.Limit).
+			Find(&candidates).Error
+		if err != nil || len(candidates) == 0 {
+			return err
+		}
+
+		for i := range candidates {
+			token := uuid.New()
+			item := &candidates[i]
+			item.Status = models.ArticleQueueLeased
+			item.LeaseToken = &token
+			item.LeasedBy = params.WorkerID
+			item.LeaseExpiresAt = &params.LeaseUntil
+			item.Attempts++
+			if err := tx.Model(item).Updates(map[string]interface{}{
+				"status":           item.Status,
+				"lease_token":      token,
+				"leased_by":        item.LeasedBy,
+				"lease_expires_at": params.LeaseUntil,
+				"attempts":         item.Attempts,
+			}).Error; err != nil {
+				return err
+			}
+		}
+		items = candidates
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// แนบ video (code) ให้ worker ไม่ต้องยิงถามเพิ่ม
+	if len(items) > 0 {
+		videoIDs := make([]uuid.UUID, len(items))
+		for i, item := range items {
+			videoIDs[i] = item.VideoID
+		}
+		var videos []models.Video
+		if err := r.db.WithContext(ctx).Select("id", "code").Where("id IN ?", videoIDs).Find(&videos).Error; err == nil {
+			byID := make(map[uuid.UUID]*models.Video, len(videos))
+			for i := range videos {
+				byID[videos[i].ID] = &videos[i]
+			}
+			for i := range items {
+				items[i].Video = byID[items[i].VideoID]
+			}
+		}
+	}
+	return items, nil
+}
+
+func (r *articleCoverageRepositoryImpl) ExtendLease(ctx context.Context, id uuid.UUID, leaseToken uuid.UUID, until time.Time) (*models.ArticleQueueItem, error) {
+	result := r.db.WithContext(ctx).
+		Model(&models.ArticleQueueItem{}).
+		Where("id = ? AND lease_token = ? AND status = ?", id, leaseToken, models.ArticleQueueLeased).
+		Update("lease_expires_at", until)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	var item models.ArticleQueueItem
+	if err := r.db.WithContext(ctx).First(&item, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+func (r *articleCoverageRepositoryImpl) ReleaseLease(ctx context.Context, id uuid.UUID, leaseToken uuid.UUID) error {
+	result := r.db.WithContext(ctx).
+		Model(&models.ArticleQueueItem{}).
+		Where("id = ? AND lease_token = ? AND status = ?", id, leaseToken, models.ArticleQueueLeased).
+		Updates(map[string]interface{}{
+			"status":           models.ArticleQueuePending,
+			"lease_token":      nil,
+			"leased_by":        "",
+			"lease_expires_at": nil,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *articleCoverageRepositoryImpl) CompleteForVideo(ctx context.Context, videoID uuid.UUID, language string) error {
+	now := time.Now()
+	return r.db.WithContext(ctx).
+		Model(&models.ArticleQueueItem{}).
+		Where("video_id = ? AND language = ? AND status <> ?", videoID, language, models.ArticleQueueDone).
+		Updates(map[string]interface{}{
+			"status":           models.ArticleQueueDone,
+			"lease_token":      nil,
+			"lease_expires_at": nil,
+			"completed_at":     now,
+		}).Error
+}
+
+func (r *articleCoverageRepositoryImpl) ListQueue(ctx context.Context, params repositories.QueueListParams) ([]models.ArticleQueueItem, int64, error) {
+	var items []models.ArticleQueueItem
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.ArticleQueueItem{})
+	if params.Status != "" {
+		query = query.Where("status = ?", params.Status)
+	}
+	if params.Language != "" {
+		query = query.Where("language = ?", params.Language)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Preload("Video", func(db *gorm.DB) *gorm.DB { return db.Select("id", "code") }).
+		Order("priority DESC").
+		Order("created_at ASC").
+		Offset(params.Offset).
+		Limit(params.Limit).
+		Find(&items).Error
+	return items, total, err
+}
+
+func (r *articleCoverageRepositoryImpl) CountQueueByStatus(ctx context.Context) (map[string]int64, error) {
+	var rows []struct {
+		Status string
+		Count  int64
+	}
+	err := r.db.WithContext(ctx).
+		Model(&models.ArticleQueueItem{}).
+		Select("status, COUNT(*) AS count").
+		Group("status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int64{
+		string(models.ArticleQueuePending): 0,
+		string(models.ArticleQueueLeased):  0,
+		string(models.ArticleQueueDone):    0,
+	}
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
+}
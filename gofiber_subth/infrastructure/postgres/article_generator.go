@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/repositories"
+)
+
+// ========================================
+// Article Generator (ranking / best-of / guide)
+// ========================================
+
+func (r *articleRepositoryImpl) GetBySlugAndLanguage(ctx context.Context, slug string, language string) (*models.Article, error) {
+	var article models.Article
+	err := r.db.WithContext(ctx).First(&article, "slug = ? AND language = ?", slug, language).Error
+	if err != nil {
+		return nil, err
+	}
+	return &article, nil
+}
+
+// ListGeneratorCandidates - video ที่ตรง filter และมีรีวิว published ในภาษาที่ขอ
+// rating/thumbnail มาจาก content ของรีวิว (video ไม่มี field เหล่านี้)
+func (r *articleRepositoryImpl) ListGeneratorCandidates(ctx context.Context, params repositories.ArticleCandidateParams) ([]repositories.ArticleCandidateVideo, error) {
+	query := r.db.WithContext(ctx).
+		Table("videos").
+		Select(`videos.id AS video_id, videos.code, videos.views, videos.release_date,
+			COALESCE(NULLIF(video_translations.title, ''), articles.title) AS title,
+			COALESCE(articles.content->>'thumbnailUrl', '') AS thumbnail_url,
+			CASE WHEN jsonb_typeof(articles.content->'rating') = 'number'
+				THEN (articles.content->>'rating')::float8 ELSE 0 END AS rating,
+			CASE WHEN jsonb_typeof(articles.content->'facts'->'durationMinutes') = 'number'
+				THEN (articles.content->'facts'->>'durationMinutes')::numeric::int
+				ELSE 0 END AS duration_minutes,
+			COALESCE(makers.name, '') AS maker_name,
+			ARRAY(SELECT casts.name FROM video_casts JOIN casts ON casts.id = video_casts.cast_id
+				WHERE video_casts.video_id = videos.id ORDER BY casts.name) AS cast_names,
+			ARRAY(SELECT tags.name FROM video_tags JOIN tags ON tags.id = video_tags.tag_id
+				WHERE video_tags.video_id = videos.id ORDER BY tags.name) AS tag_names,
+			articles.slug AS review_slug`).
+		Joins(`JOIN articles ON articles.video_id = videos.id AND articles.language = ?
+			AND articles.type = ? AND articles.origin = ? AND articles.status = ? AND articles.deleted_at IS NULL`,
+			params.Language, models.ArticleTypeReview, models.ArticleOriginWorker, models.ArticleStatusPublished).
+		Joins("LEFT JOIN video_translations ON video_translations.video_id = videos.id AND video_translations.lang = ?", params.Language).
+		Joins("LEFT JOIN makers ON makers.id = videos.maker_id").
+		Where("videos.deleted_at IS NULL")
+
+	if params.CastID != nil {
+		query = query.Where("EXISTS (SELECT 1 FROM video_casts WHERE video_casts.video_id = videos.id AND video_casts.cast_id = ?)", *params.CastID)
+	}
+	if params.MakerID != nil {
+		query = query.Where("videos.maker_id = ?", *params.MakerID)
+	}
+	if params.TagID != nil {
+		query = query.Where("EXISTS (SELECT 1 FROM video_tags WHERE video_tags.video_id = videos.id AND video_tags.tag_id = ?)", *params.TagID)
+	}
+
+	if params.SortBy == "rating" {
+		query = query.Order("rating DESC").Order("videos.views DESC")
+	} else {
+		query = query.Order("videos.views DESC").Order("rating DESC")
+	}
+
+	var videos []repositories.ArticleCandidateVideo
+	err := query.
+		Order("videos.release_date DESC NULLS LAST").
+		Order("videos.id ASC").
+		Limit(params.Limit).
+		Scan(&videos).Error
+	return videos, err
+}
+
+// ReplaceVideos แทนที่รายการ video ของบทความทั้งหมด (ลำดับตาม Position)
+func (r *articleRepositoryImpl) ReplaceVideos(ctx context.Context, articleID uuid.UUID, videos []models.ArticleVideo) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("article_id = ?", articleID).Delete(&models.ArticleVideo{}).Error; err != nil {
+			return err
+		}
+		if len(videos) == 0 {
+			return nil
+		}
+		for i := range videos {
+			videos[i].ArticleID = articleID
+		}
+		return tx.Create(&videos).Error
+	})
+}
@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/repositories"
+)
+
+type articleRedirectRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewArticleRedirectRepository(db *gorm.DB) repositories.ArticleRedirectRepository {
+	return &articleRedirectRepositoryImpl{db: db}
+}
+
+func (r *articleRedirectRepositoryImpl) Upsert(ctx context.Context, redirect *models.ArticleRedirect) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "language"}, {Name: "from_type"}, {Name: "from_slug"}},
+		DoUpdates: clause.AssignmentColumns([]string{"article_id", "source", "created_by", "updated_at"}),
+	}).Create(redirect).Error
+}
+
+func (r *articleRedirectRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*models.ArticleRedirect, error) {
+	var redirect models.ArticleRedirect
+	err := r.db.WithContext(ctx).Preload("Article").First(&redirect, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &redirect, nil
+}
+
+func (r *articleRedirectRepositoryImpl) FindBySource(ctx context.Context, articleType string, slug string, language string) (*models.ArticleRedirect, error) {
+	var redirect models.ArticleRedirect
+
+	query := r.db.WithContext(ctx).
+		Where("from_type = ? AND from_slug = ?", articleType, slug)
+	if language != "" {
+		query = query.Where("language = ?", language)
+	}
+
+	err := query.Order("updated_at DESC").First(&redirect).Error
+	if err != nil {
+		return nil, err
+	}
+	return &redirect, nil
+}
+
+func (r *articleRedirectRepositoryImpl) DeleteBySource(ctx context.Context, articleType string, slug string, language string) error {
+	return r.db.WithContext(ctx).
+		Where("from_type = ? AND from_slug = ? AND language = ?", articleType, slug, language).
+		Delete(&models.ArticleRedirect{}).Error
+}
+
+func (r *articleRedirectRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&models.ArticleRedirect{}, "id = ?", id).Error
+}
+
+func (r *articleRedirectRepositoryImpl) List(ctx context.Context, params repositories.ArticleRedirectListParams) ([]models.ArticleRedirect, int64, error) {
+	var redirects []models.ArticleRedirect
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.ArticleRedirect{})
+
+	if params.Language != "" {
+		query = query.Where("language = ?", params.Language)
+	}
+	if params.Search != "" {
+		query = query.Where("from_slug ILIKE ?", "%"+params.Search+"%")
+	}
+	if params.ArticleID != nil {
+		query = query.Where("article_id = ?", *params.ArticleID)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Preload("Article").
+		Order("created_at DESC").
+		Offset(params.Offset).
+		Limit(params.Limit).
+		Find(&redirects).Error
+	return redirects, total, err
+}
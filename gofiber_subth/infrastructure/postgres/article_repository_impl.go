@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"strings"
 	"time"
 
@@ -52,20 +53,48 @@ func (r *articleRepositoryImpl) GetByVideoID(ctx context.Context, videoID uuid.U
 	return &article, nil
 }
 
+func (r *articleRepositoryImpl) ListByVideoID(ctx context.Context, videoID uuid.UUID) ([]models.Article, error) {
+	var articles []models.Article
+	err := r.db.WithContext(ctx).
+		Where("(video_id = ? AND origin = ?) OR id IN (?)", videoID, models.ArticleOriginWorker,
+			r.db.Model(&models.ArticleVideo{}).Select("article_id").Where("video_id = ? AND deleted_at IS NULL", videoID)).
+		Find(&articles).Error
+	return articles, err
+}
+
+func (r *articleRepositoryImpl) ListActiveVideoIDs(ctx context.Context, articleID uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.db.WithContext(ctx).Model(&models.ArticleVideo{}).
+		Where("article_id = ? AND deleted_at IS NULL", articleID).
+		Order("position").
+		Pluck("video_id", &ids).Error
+	return ids, err
+}
+
 func (r *articleRepositoryImpl) GetByVideoIDAndLanguage(ctx context.Context, videoID uuid.UUID, language string) (*models.Article, error) {
 	var article models.Article
-	err := r.db.WithContext(ctx).First(&article, "video_id = ? AND language = ?", videoID, language).Error
+	err := r.db.WithContext(ctx).First(&article, "video_id = ? AND language = ? AND origin = ?", videoID, language, models.ArticleOriginWorker).Error
 	if err != nil {
 		return nil, err
 	}
 	return &article, nil
 }
 
+// SlugExists ตรวจว่า slug ถูกใช้ในภาษานี้โดยบทความอื่นแล้วหรือไม่ (unique: slug + language)
+func (r *articleRepositoryImpl) SlugExists(ctx context.Context, slug string, language string, excludeID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.Article{}).
+		Where("slug = ? AND language = ? AND id <> ?", slug, language, excludeID).
+		Count(&count).Error
+	return count > 0, err
+}
+
 func (r *articleRepositoryImpl) Update(ctx context.Context, article *models.Article) error {
 	return r.db.WithContext(ctx).Save(article).Error
 }
 
 func (r *articleRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	// soft delete - article_videos เก็บไว้สำหรับ restore
 	return r.db.WithContext(ctx).Delete(&models.Article{}, "id = ?", id).Error
 }
 
@@ -109,6 +138,45 @@ func (r *articleRepositoryImpl) List(ctx context.Context, params repositories.Ar
 	return articles, total, err
 }
 
+// ListReviewQueue - คิวบทความ in_review
+// updated_at ถูกตั้งตอนเข้า in_review จึงใช้เป็นเวลาที่รอ review
+func (r *articleRepositoryImpl) ListReviewQueue(ctx context.Context, params repositories.ReviewQueueParams) ([]models.Article, int64, error) {
+	var articles []models.Article
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.Article{}).Where("status = ?", models.ArticleStatusInReview)
+	if params.Type != "" {
+		query = query.Where("type = ?", params.Type)
+	}
+	if params.Language != "" {
+		query = query.Where("language = ?", params.Language)
+	}
+	if params.MinQualityScore > 0 {
+		query = query.Where("quality_score >= ?", params.MinQualityScore)
+	}
+	if params.MaxQualityScore > 0 {
+		query = query.Where("quality_score <= ?", params.MaxQualityScore)
+	}
+	if params.ReviewerID != nil {
+		query = query.Where("reviewer_id = ?", *params.ReviewerID)
+	} else if params.Unassigned {
+		query = query.Where("reviewer_id IS NULL")
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if params.Sort == "oldest" {
+		query = query.Order("updated_at ASC")
+	} else {
+		query = query.Order("quality_score DESC").Order("updated_at ASC")
+	}
+
+	err := query.Offset(params.Offset).Limit(params.Limit).Find(&articles).Error
+	return articles, total, err
+}
+
 func (r *articleRepositoryImpl) GetStats(ctx context.Context) (*repositories.ArticleStats, error) {
 	var stats repositories.ArticleStats
 
@@ -117,6 +185,8 @@ func (r *articleRepositoryImpl) GetStats(ctx context.Context) (*repositories.Art
 
 	// By status
 	r.db.WithContext(ctx).Model(&models.Article{}).Where("status = ?", models.ArticleStatusDraft).Count(&stats.DraftCount)
+	r.db.WithContext(ctx).Model(&models.Article{}).Where("status = ?", models.ArticleStatusInReview).Count(&stats.InReviewCount)
+	r.db.WithContext(ctx).Model(&models.Article{}).Where("status = ?", models.ArticleStatusApproved).Count(&stats.ApprovedCount)
 	r.db.WithContext(ctx).Model(&models.Article{}).Where("status = ?", models.ArticleStatusScheduled).Count(&stats.ScheduledCount)
 	r.db.WithContext(ctx).Model(&models.Article{}).Where("status = ?", models.ArticleStatusPublished).Count(&stats.PublishedCount)
 
@@ -124,6 +194,9 @@ func (r *articleRepositoryImpl) GetStats(ctx context.Context) (*repositories.Art
 	r.db.WithContext(ctx).Model(&models.Article{}).
 		Where("status = ? AND indexing_status = ?", models.ArticleStatusPublished, models.IndexingIndexed).
 		Count(&stats.IndexedCount)
+	r.db.WithContext(ctx).Model(&models.Article{}).
+		Where("status = ? AND indexing_status = ?", models.ArticleStatusPublished, models.IndexingSubmitted).
+		Count(&stats.SubmittedIndex)
 	r.db.WithContext(ctx).Model(&models.Article{}).
 		Where("status = ? AND indexing_status = ?", models.ArticleStatusPublished, models.IndexingPending).
 		Count(&stats.PendingIndex)
@@ -147,18 +220,27 @@ func (r *articleRepositoryImpl) UpdateStatus(ctx context.Context, id uuid.UUID,
 	return r.db.WithContext(ctx).Model(&models.Article{}).Where("id = ?", id).Updates(updates).Error
 }
 
-func (r *articleRepositoryImpl) BulkSchedule(ctx context.Context, ids []uuid.UUID, scheduledAt []interface{}) error {
+func (r *articleRepositoryImpl) BulkSchedule(ctx context.Context, ids []uuid.UUID, scheduledAt []interface{}, fromStatuses []models.ArticleStatus, minDraftQuality int) error {
 	// Use transaction for bulk update
 	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var affected int64
 		for i, id := range ids {
-			if err := tx.Model(&models.Article{}).
-				Where("id = ?", id).
+			// ตรวจ workflow ซ้ำใน WHERE กันสถานะ/คะแนนเปลี่ยนหลัง service อ่าน
+			result := tx.Model(&models.Article{}).
+				Where("id = ? AND status IN ?", id, fromStatuses).
+				Where("status <> ? OR quality_score >= ?", models.ArticleStatusDraft, minDraftQuality).
 				Updates(map[string]interface{}{
-					"status":       models.ArticleStatusScheduled,
-					"scheduled_at": scheduledAt[i],
-				}).Error; err != nil {
-				return err
+					"status":         models.ArticleStatusScheduled,
+					"scheduled_at":   scheduledAt[i],
+					"auto_scheduled": false, // ตั้งเวลาเอง = planner ไม่ย้าย
+				})
+			if result.Error != nil {
+				return result.Error
 			}
+			affected += result.RowsAffected
+		}
+		if affected != int64(len(ids)) {
+			return errors.New("article status changed")
 		}
 		return nil
 	})
@@ -172,10 +254,28 @@ func (r *articleRepositoryImpl) GetScheduledToPublish(ctx context.Context) ([]mo
 	return articles, err
 }
 
+// PublishIfScheduled เปลี่ยน status เป็น published เฉพาะเมื่อยังเป็น scheduled อยู่
+// ใช้ conditional update เพื่อให้ปลอดภัยเมื่อมีหลาย replica รัน scheduler พร้อมกัน
+// returns true ถ้า row ถูก publish โดย call นี้
+func (r *articleRepositoryImpl) PublishIfScheduled(ctx context.Context, id uuid.UUID) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&models.Article{}).
+		Where("id = ? AND status = ?", id, models.ArticleStatusScheduled).
+		Updates(map[string]interface{}{
+			"status":       models.ArticleStatusPublished,
+			"published_at": time.Now(),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
 func (r *articleRepositoryImpl) GetPendingIndexing(ctx context.Context, limit int) ([]models.Article, error) {
 	var articles []models.Article
 	err := r.db.WithContext(ctx).
 		Where("status = ? AND indexing_status = ?", models.ArticleStatusPublished, models.IndexingPending).
+		Where("indexing_retry_at IS NULL OR indexing_retry_at <= ?", time.Now()).
+		Order("indexing_retry_at ASC NULLS FIRST").
 		Limit(limit).
 		Find(&articles).Error
 	return articles, err
@@ -186,14 +286,69 @@ func (r *articleRepositoryImpl) UpdateIndexingStatus(ctx context.Context, id uui
 		"indexing_status": status,
 	}
 
-	if status == models.IndexingIndexed {
-		now := time.Now()
+	now := time.Now()
+	switch status {
+	case models.IndexingIndexed:
 		updates["indexed_at"] = now
+		updates["indexing_checked_at"] = now
+	case models.IndexingSubmitted:
+		updates["indexing_checked_at"] = now
+		updates["indexing_attempts"] = 0
+		updates["indexing_retry_at"] = nil
+	case models.IndexingFailed:
+		updates["indexing_retry_at"] = nil
 	}
 
 	return r.db.WithContext(ctx).Model(&models.Article{}).Where("id = ?", id).Updates(updates).Error
 }
 
+// GetSubmittedIndexing ดึงบทความที่ submit แล้วแต่ยังไม่ indexed และไม่ได้ตรวจตั้งแต่ checkedBefore
+// เรียงจากที่ตรวจนานที่สุดก่อน เพื่อให้ทุกบทความได้ถูกตรวจวนไป
+func (r *articleRepositoryImpl) GetSubmittedIndexing(ctx context.Context, checkedBefore time.Time, limit int) ([]models.Article, error) {
+	var articles []models.Article
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND indexing_status = ?", models.ArticleStatusPublished, models.IndexingSubmitted).
+		Where("indexing_checked_at IS NULL OR indexing_checked_at < ?", checkedBefore).
+		Order("indexing_checked_at ASC NULLS FIRST").
+		Limit(limit).
+		Find(&articles).Error
+	return articles, err
+}
+
+// ScheduleIndexingRetry บันทึกจำนวนครั้งที่ส่งไม่สำเร็จ + เวลาที่ส่งใหม่ได้ (status ยังเป็น pending)
+func (r *articleRepositoryImpl) ScheduleIndexingRetry(ctx context.Context, id uuid.UUID, attempts int, retryAt time.Time) error {
+	return r.db.WithContext(ctx).Model(&models.Article{}).Where("id = ?", id).
+		UpdateColumns(map[string]interface{}{
+			"indexing_attempts": attempts,
+			"indexing_retry_at": retryAt,
+		}).Error
+}
+
+// MarkIndexingChecked บันทึกเวลาตรวจสถานะ (ใช้ UpdateColumn เพื่อไม่ให้ updated_at เปลี่ยน)
+func (r *articleRepositoryImpl) MarkIndexingChecked(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Model(&models.Article{}).Where("id = ?", id).
+		UpdateColumn("indexing_checked_at", time.Now()).Error
+}
+
+func (r *articleRepositoryImpl) ListForContentCheck(ctx context.Context, afterID uuid.UUID, limit int, articleType string, status string) ([]models.Article, error) {
+	var articles []models.Article
+	query := r.db.WithContext(ctx).
+		Select("id, type, slug, language, status, content").
+		Where("id > ?", afterID)
+	if articleType != "" {
+		query = query.Where("type = ?", articleType)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	err := query.Order("id ASC").Limit(limit).Find(&articles).Error
+	return articles, err
+}
+
+func (r *articleRepositoryImpl) IncrementViewCounts(ctx context.Context, counts map[uuid.UUID]int64) error {
+	return incrementViewColumn(ctx, r.db, "articles", "view_count", counts)
+}
+
 func (r *articleRepositoryImpl) GetPublishedBySlug(ctx context.Context, slug string) (*models.Article, error) {
 	var article models.Article
 	err := r.db.WithContext(ctx).
@@ -266,7 +421,7 @@ func (r *articleRepositoryImpl) GetPublishedByVideoIDAndLanguage(ctx context.Con
 	var article models.Article
 
 	query := r.db.WithContext(ctx).
-		Where("video_id = ? AND status = ? AND language = ?", videoID, models.ArticleStatusPublished, language)
+		Where("video_id = ? AND status = ? AND language = ? AND origin = ?", videoID, models.ArticleStatusPublished, language, models.ArticleOriginWorker)
 
 	err := query.First(&article).Error
 	if err != nil {
@@ -322,15 +477,23 @@ func (r *articleRepositoryImpl) ListPublished(ctx context.Context, params reposi
 
 	// Sorting - validate and apply
 	sortColumn := "published_at"
-	if params.Sort == "updated_at" {
+	switch params.Sort {
+	case "updated_at":
 		sortColumn = "updated_at"
+	case "view_count":
+		sortColumn = "view_count"
 	}
 	orderDirection := "DESC"
 	if strings.ToUpper(params.Order) == "ASC" {
 		orderDirection = "ASC"
 	}
+	query = query.Order(sortColumn + " " + orderDirection)
+	if sortColumn == "view_count" {
+		// ยอดวิวเท่ากันให้บทความใหม่กว่าขึ้นก่อน
+		query = query.Order("published_at DESC")
+	}
 
-	err := query.Order(sortColumn + " " + orderDirection).
+	err := query.
 		Offset(params.Offset).
 		Limit(params.Limit).
 		Find(&articles).Error
@@ -446,6 +609,113 @@ func (r *articleRepositoryImpl) ListPublishedByMaker(ctx context.Context, makerS
 	return r.enrichArticlesWithVideoData(ctx, articles), total, nil
 }
 
+// ListRelatedPublished ให้คะแนนบทความอื่นจากจำนวน cast/tag/auto-tag ที่ซ้ำกับ video ของ source และ maker เดียวกัน
+// candidate จำกัดแค่ video ที่มีอย่างน้อย 1 อย่างตรงกัน (ไม่ scan ทุกบทความ)
+func (r *articleRepositoryImpl) ListRelatedPublished(ctx context.Context, source *models.Article, weights repositories.RelatedArticleWeights, limit int) ([]repositories.RelatedPublishedArticle, error) {
+	var scored []struct {
+		ID    uuid.UUID
+		Score float64
+	}
+
+	err := r.db.WithContext(ctx).Raw(`
+		WITH src AS (
+			SELECT maker_id, auto_tags FROM videos WHERE id = @video_id
+		),
+		cast_hits AS (
+			SELECT vc.video_id, COUNT(*) AS hits
+			FROM video_casts vc
+			WHERE vc.cast_id IN (SELECT cast_id FROM video_casts WHERE video_id = @video_id)
+			  AND vc.video_id <> @video_id
+			GROUP BY vc.video_id
+		),
+		tag_hits AS (
+			SELECT vt.video_id, COUNT(*) AS hits
+			FROM video_tags vt
+			WHERE vt.tag_id IN (SELECT tag_id FROM video_tags WHERE video_id = @video_id)
+			  AND vt.video_id <> @video_id
+			GROUP BY vt.video_id
+		),
+		scored AS (
+			SELECT v.id AS video_id,
+				@w_cast * COALESCE(ch.hits, 0)
+				+ @w_tag * COALESCE(th.hits, 0)
+				+ CASE WHEN v.maker_id = src.maker_id THEN @w_maker ELSE 0 END
+				+ @w_auto_tag * cardinality(ARRAY(SELECT unnest(v.auto_tags) INTERSECT SELECT unnest(src.auto_tags))) AS score
+			FROM videos v
+			CROSS JOIN src
+			LEFT JOIN cast_hits ch ON ch.video_id = v.id
+			LEFT JOIN tag_hits th ON th.video_id = v.id
+			WHERE v.id <> @video_id
+			  AND v.deleted_at IS NULL
+			  AND (ch.video_id IS NOT NULL
+				OR th.video_id IS NOT NULL
+				OR v.maker_id = src.maker_id
+				OR v.auto_tags && src.auto_tags)
+		)
+		SELECT a.id, s.score
+		FROM articles a
+		JOIN scored s ON s.video_id = a.video_id
+		WHERE a.status = @status
+		  AND a.language = @language
+		  AND a.deleted_at IS NULL
+		  AND a.id <> @article_id
+		  AND s.score > 0
+		ORDER BY s.score DESC, a.published_at DESC
+		LIMIT @limit`,
+		map[string]interface{}{
+			"video_id":   source.VideoID,
+			"article_id": source.ID,
+			"language":   source.Language,
+			"status":     models.ArticleStatusPublished,
+			"w_cast":     weights.Cast,
+			"w_tag":      weights.Tag,
+			"w_maker":    weights.Maker,
+			"w_auto_tag": weights.AutoTag,
+			"limit":      limit,
+		},
+	).Scan(&scored).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(scored) == 0 {
+		return []repositories.RelatedPublishedArticle{}, nil
+	}
+
+	ids := make([]uuid.UUID, len(scored))
+	for i, sc := range scored {
+		ids[i] = sc.ID
+	}
+
+	var articles []models.Article
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&articles).Error; err != nil {
+		return nil, err
+	}
+
+	// เรียงตามคะแนน (IN ไม่รักษาลำดับ)
+	byID := make(map[uuid.UUID]models.Article, len(articles))
+	for _, a := range articles {
+		byID[a.ID] = a
+	}
+	ordered := make([]models.Article, 0, len(scored))
+	scores := make([]float64, 0, len(scored))
+	for _, sc := range scored {
+		if a, ok := byID[sc.ID]; ok {
+			ordered = append(ordered, a)
+			scores = append(scores, sc.Score)
+		}
+	}
+
+	enriched := r.enrichArticlesWithVideoData(ctx, ordered)
+	result := make([]repositories.RelatedPublishedArticle, len(enriched))
+	for i := range enriched {
+		result[i] = repositories.RelatedPublishedArticle{
+			PublishedArticleWithVideo: enriched[i],
+			Score:                     scores[i],
+		}
+	}
+	return result, nil
+}
+
 // enrichArticlesWithVideoData ดึงข้อมูล video, cast, tag, maker สำหรับ articles
 func (r *articleRepositoryImpl) enrichArticlesWithVideoData(ctx context.Context, articles []models.Article) []repositories.PublishedArticleWithVideo {
 	if len(articles) == 0 {
@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/repositories"
+)
+
+type articleRevisionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewArticleRevisionRepository(db *gorm.DB) repositories.ArticleRevisionRepository {
+	return &articleRevisionRepositoryImpl{db: db}
+}
+
+// Create หาเลข revision ถัดไปและ insert ใน transaction เดียวกัน
+// lock แถว articles ก่อน (SELECT ... FOR UPDATE) ให้ ingest ที่ชนกันของบทความเดียวกันรอกันตามลำดับ
+// แทนที่จะอ่าน MAX เดียวกันแล้วไปชน unique index (article_id, revision)
+func (r *articleRevisionRepositoryImpl) Create(ctx context.Context, revision *models.ArticleRevision) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var locked []uuid.UUID
+		if err := tx.Unscoped().Model(&models.Article{}).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", revision.ArticleID).
+			Pluck("id", &locked).Error; err != nil {
+			return err
+		}
+
+		var maxRevision int
+		if err := tx.Model(&models.ArticleRevision{}).
+			Where("article_id = ?", revision.ArticleID).
+			Select("COALESCE(MAX(revision), 0)").
+			Scan(&maxRevision).Error; err != nil {
+			return err
+		}
+
+		revision.Revision = maxRevision + 1
+		return tx.Create(revision).Error
+	})
+}
+
+func (r *articleRevisionRepositoryImpl) GetByNumber(ctx context.Context, articleID uuid.UUID, revision int) (*models.ArticleRevision, error) {
+	var rev models.ArticleRevision
+	err := r.db.WithContext(ctx).
+		Where("article_id = ? AND revision = ?", articleID, revision).
+		First(&rev).Error
+	if err != nil {
+		return nil, err
+	}
+	return &rev, nil
+}
+
+func (r *articleRevisionRepositoryImpl) GetLatest(ctx context.Context, articleID uuid.UUID) (*models.ArticleRevision, error) {
+	var rev models.ArticleRevision
+	err := r.db.WithContext(ctx).
+		Where("article_id = ?", articleID).
+		Order("revision DESC").
+		First(&rev).Error
+	if err != nil {
+		return nil, err
+	}
+	return &rev, nil
+}
+
+// ListByArticle ไม่ดึง content (JSONB ใหญ่) เพราะใช้แสดงรายการเท่านั้น
+func (r *articleRevisionRepositoryImpl) ListByArticle(ctx context.Context, articleID uuid.UUID, offset, limit int) ([]models.ArticleRevision, int64, error) {
+	var revisions []models.ArticleRevision
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.ArticleRevision{}).Where("article_id = ?", articleID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Omit("content").
+		Order("revision DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&revisions).Error
+	return revisions, total, err
+}
+
+func (r *articleRevisionRepositoryImpl) CountByArticle(ctx context.Context, articleID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.ArticleRevision{}).
+		Where("article_id = ?", articleID).
+		Count(&count).Error
+	return count, err
+}
@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/repositories"
+)
+
+// ========================================
+// Article Schedule Planner
+// ========================================
+
+// articleScheduleSelect - cast ของบทความ = cast ของ video หลัก + video ใน article_videos (generator)
+const articleScheduleSelect = `articles.id, articles.title, articles.slug, articles.language, articles.status,
+	articles.quality_score, articles.auto_scheduled, articles.created_at,
+	CASE WHEN articles.status = 'published' THEN articles.published_at ELSE articles.scheduled_at END AS slot_at,
+	ARRAY(SELECT DISTINCT video_casts.cast_id::text FROM video_casts
+		WHERE video_casts.video_id = articles.video_id
+			OR video_casts.video_id IN (SELECT article_videos.video_id FROM article_videos WHERE article_videos.article_id = articles.id AND article_videos.deleted_at IS NULL)
+	) AS cast_ids`
+
+func (r *articleRepositoryImpl) ListScheduleCandidates(ctx context.Context, ids []uuid.UUID) ([]repositories.ArticleScheduleRow, error) {
+	var rows []repositories.ArticleScheduleRow
+	if len(ids) == 0 {
+		return rows, nil
+	}
+	err := r.db.WithContext(ctx).
+		Table("articles").
+		Select(articleScheduleSelect).
+		Where("articles.id IN ? AND articles.deleted_at IS NULL", ids).
+		Scan(&rows).Error
+	return rows, err
+}
+
+func (r *articleRepositoryImpl) ListScheduledSince(ctx context.Context, since time.Time) ([]repositories.ArticleScheduleRow, error) {
+	var rows []repositories.ArticleScheduleRow
+	err := r.db.WithContext(ctx).
+		Table("articles").
+		Select(articleScheduleSelect).
+		Where("(articles.status = ? AND articles.scheduled_at >= ?) OR (articles.status = ? AND articles.published_at >= ?)",
+			models.ArticleStatusScheduled, since, models.ArticleStatusPublished, since).
+		Where("articles.deleted_at IS NULL").
+		Order("slot_at ASC").
+		Order("articles.id ASC").
+		Scan(&rows).Error
+	return rows, err
+}
+
+func (r *articleRepositoryImpl) ApplySchedule(ctx context.Context, slots []repositories.ArticleScheduleSlot) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, slot := range slots {
+			// เขียนเฉพาะบทความที่ยังอยู่ในสถานะที่ planner อ่าน (เช่น ถูก publish/archive/ลบระหว่างวางแผน = rollback)
+			result := tx.Model(&models.Article{}).
+				Where("id = ? AND status = ?", slot.ArticleID, slot.FromStatus).
+				Updates(map[string]interface{}{
+					"status":         models.ArticleStatusScheduled,
+					"scheduled_at":   slot.ScheduledAt,
+					"auto_scheduled": true,
+				})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return errors.New("article status changed")
+			}
+		}
+		return nil
+	})
+}
+
+func (r *articleRepositoryImpl) GetSchedulePolicy(ctx context.Context) (*models.ArticleSchedulePolicy, error) {
+	var policy models.ArticleSchedulePolicy
+	if err := r.db.WithContext(ctx).Order("created_at ASC").First(&policy).Error; err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+func (r *articleRepositoryImpl) SaveSchedulePolicy(ctx context.Context, policy *models.ArticleSchedulePolicy) error {
+	return r.db.WithContext(ctx).Save(policy).Error
+}
@@ -0,0 +1,195 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/repositories"
+)
+
+// ========================================
+// Article Full-Text Search (tsvector)
+// ========================================
+
+// articleSearchConfigSQL - ภาษาไทยไม่มี dictionary ใน Postgres ใช้ simple, อังกฤษใช้ english (stemming)
+const articleSearchConfigSQL = `(CASE WHEN language = 'en' THEN 'english' ELSE 'simple' END)::regconfig`
+
+// articleSearchVectorSQL - document ของบทความ: title (A) > meta description (B) > text ใน content (C)
+// เลือกเฉพาะ field ที่เป็นเนื้อหา ไม่เอา URL/metadata ที่ API เติม (thumbnailUrl, castProfiles ฯลฯ)
+var articleSearchVectorSQL = fmt.Sprintf(`
+	setweight(to_tsvector(%[1]s, COALESCE(title, '')), 'A') ||
+	setweight(to_tsvector(%[1]s, COALESCE(meta_description, '')), 'B') ||
+	setweight(jsonb_to_tsvector(%[1]s, jsonb_build_object(
+		'quickAnswer', content->'quickAnswer',
+		'mainHook', content->'mainHook',
+		'verdict', content->'verdict',
+		'synopsis', content->'synopsis',
+		'storyFlow', content->'storyFlow',
+		'keyScenes', content->'keyScenes',
+		'featuredScene', content->'featuredScene',
+		'reviewSummary', content->'reviewSummary',
+		'strengths', content->'strengths',
+		'weaknesses', content->'weaknesses',
+		'whoShouldWatch', content->'whoShouldWatch',
+		'faqItems', content->'faqItems',
+		'keywords', content->'keywords',
+		'facts', content->'facts'
+	), '["string"]'), 'C')`, articleSearchConfigSQL)
+
+// articleSnippetSourceSQL - ข้อความที่ใช้ทำ snippet (ts_headline ทำงานบน text ไม่ใช่ tsvector)
+const articleSnippetSourceSQL = `concat_ws(' ', meta_description, content->>'quickAnswer', content->>'synopsis', content->>'reviewSummary')`
+
+// htmlEscapeSQL - escape ข้อความก่อน ts_headline ใส่ <mark> (ผลลัพธ์ render เป็น HTML ได้ตรงๆ)
+// & ต้องแทนก่อน ไม่งั้น entity ที่เพิ่งแทนจะถูก escape ซ้ำ
+func htmlEscapeSQL(expr string) string {
+	return fmt.Sprintf(`replace(replace(replace(replace(%s, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;')`, expr)
+}
+
+// migrateArticleSearch เพิ่ม column search_vector + GIN index และ backfill บทความที่ยังไม่มี
+func migrateArticleSearch(db *gorm.DB) error {
+	if err := db.Exec("ALTER TABLE articles ADD COLUMN IF NOT EXISTS search_vector tsvector").Error; err != nil {
+		return fmt.Errorf("failed to add articles.search_vector: %v", err)
+	}
+
+	if err := db.Exec("CREATE INDEX IF NOT EXISTS idx_articles_search_vector ON articles USING GIN (search_vector)").Error; err != nil {
+		return fmt.Errorf("failed to create article search index: %v", err)
+	}
+
+	if err := db.Exec("UPDATE articles SET search_vector = " + articleSearchVectorSQL + " WHERE search_vector IS NULL").Error; err != nil {
+		return fmt.Errorf("failed to backfill article search vectors: %v", err)
+	}
+
+	return nil
+}
+
+// articleSearchQueryConfig - config ของ query ต้องตรงกับ config ที่ใช้สร้าง vector ของภาษานั้น
+func articleSearchQueryConfig(language string) string {
+	if language == "en" {
+		return "'english'::regconfig"
+	}
+	return "'simple'::regconfig"
+}
+
+const (
+	articleTitleHeadlineOptions   = "StartSel=<mark>, StopSel=</mark>, HighlightAll=true"
+	articleSnippetHeadlineOptions = "StartSel=<mark>, StopSel=</mark>, MaxWords=35, MinWords=15, MaxFragments=2, FragmentDelimiter=\" … \""
+)
+
+func (r *articleRepositoryImpl) RefreshSearchVector(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Exec("UPDATE articles SET search_vector = "+articleSearchVectorSQL+" WHERE id = ?", id).Error
+}
+
+func (r *articleRepositoryImpl) SearchPublished(ctx context.Context, params repositories.ArticleSearchParams) (*repositories.ArticleSearchResult, error) {
+	tsquery := fmt.Sprintf("websearch_to_tsquery(%s, @query)", articleSearchQueryConfig(params.Language))
+	args := map[string]interface{}{
+		"query":    params.Query,
+		"language": params.Language,
+		"status":   models.ArticleStatusPublished,
+	}
+
+	// Facets by type (ไม่ filter type เพื่อให้ UI แสดงจำนวนของทุก type ได้)
+	var facetRows []struct {
+		Type  string
+		Count int64
+	}
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT a.type, COUNT(*) AS count
+		FROM articles a, `+tsquery+` q
+		WHERE a.status = @status AND a.language = @language AND a.deleted_at IS NULL AND a.search_vector @@ q
+		GROUP BY a.type`, args).Scan(&facetRows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := &repositories.ArticleSearchResult{
+		Hits:       []repositories.ArticleSearchHit{},
+		TypeFacets: make(map[string]int64, len(facetRows)),
+	}
+	for _, f := range facetRows {
+		result.TypeFacets[f.Type] = f.Count
+		if params.ArticleType == "" || params.ArticleType == f.Type {
+			result.Total += f.Count
+		}
+	}
+	if result.Total == 0 {
+		return result, nil
+	}
+
+	// Ranked hits - ts_headline ทำเฉพาะหน้าที่แสดง (แพง)
+	typeFilter := ""
+	if params.ArticleType != "" {
+		typeFilter = "AND a.type = @type"
+		args["type"] = params.ArticleType
+	}
+	args["limit"] = params.Limit
+	args["offset"] = params.Offset
+	args["title_opts"] = articleTitleHeadlineOptions
+	args["snippet_opts"] = articleSnippetHeadlineOptions
+
+	var rows []struct {
+		ID             uuid.UUID
+		Rank           float64
+		TitleHighlight string
+		Snippet        string
+	}
+	err = r.db.WithContext(ctx).Raw(`
+		SELECT h.id, h.rank,
+			ts_headline(h.config, `+htmlEscapeSQL("h.title")+`, q, @title_opts) AS title_highlight,
+			ts_headline(h.config, `+htmlEscapeSQL("h.snippet_source")+`, q, @snippet_opts) AS snippet
+		FROM (
+			SELECT a.id, a.title, a.published_at,
+				`+articleSearchConfigSQL+` AS config,
+				`+articleSnippetSourceSQL+` AS snippet_source,
+				ts_rank_cd(a.search_vector, q) AS rank
+			FROM articles a, `+tsquery+` q
+			WHERE a.status = @status AND a.language = @language AND a.deleted_at IS NULL AND a.search_vector @@ q `+typeFilter+`
+			ORDER BY rank DESC, a.published_at DESC
+			LIMIT @limit OFFSET @offset
+		) h, `+tsquery+` q
+		ORDER BY h.rank DESC, h.published_at DESC`, args).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return result, nil
+	}
+
+	ids := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+	var articles []models.Article
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&articles).Error; err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]models.Article, len(articles))
+	for _, a := range articles {
+		byID[a.ID] = a
+	}
+
+	ordered := make([]models.Article, 0, len(rows))
+	found := rows[:0]
+	for _, row := range rows {
+		if a, ok := byID[row.ID]; ok {
+			ordered = append(ordered, a)
+			found = append(found, row)
+		}
+	}
+
+	enriched := r.enrichArticlesWithVideoData(ctx, ordered)
+	result.Hits = make([]repositories.ArticleSearchHit, len(enriched))
+	for i := range enriched {
+		result.Hits[i] = repositories.ArticleSearchHit{
+			PublishedArticleWithVideo: enriched[i],
+			Rank:                      found[i].Rank,
+			TitleHighlight:            found[i].TitleHighlight,
+			Snippet:                   found[i].Snippet,
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,40 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/repositories"
+)
+
+type articleStatusChangeRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewArticleStatusChangeRepository(db *gorm.DB) repositories.ArticleStatusChangeRepository {
+	return &articleStatusChangeRepositoryImpl{db: db}
+}
+
+func (r *articleStatusChangeRepositoryImpl) Create(ctx context.Context, change *models.ArticleStatusChange) error {
+	return r.db.WithContext(ctx).Create(change).Error
+}
+
+func (r *articleStatusChangeRepositoryImpl) ListByArticle(ctx context.Context, articleID uuid.UUID, offset, limit int) ([]models.ArticleStatusChange, int64, error) {
+	var changes []models.ArticleStatusChange
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.ArticleStatusChange{}).Where("article_id = ?", articleID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Order("created_at DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&changes).Error
+	return changes, total, err
+}
@@ -71,10 +71,18 @@ func (r *castRepositoryImpl) List(ctx context.Context, params repositories.CastL
 			Select("DISTINCT video_casts.cast_id").
 			Joins("JOIN videos ON videos.id = video_casts.video_id").
 			Joins("JOIN articles ON articles.video_id = videos.id").
-			Where("articles.status = ?", "published")
+			Where("articles.status = ? AND articles.deleted_at IS NULL", "published")
 		query = query.Where("id IN (?)", subQuery)
 	}
 
+	// Filter casts without name in MissingLang
+	if params.MissingLang != "" {
+		subQuery := r.db.Model(&models.CastTranslation{}).
+			Select("cast_id").
+			Where("lang = ? AND name != ''", params.MissingLang)
+		query = query.Where("id NOT IN (?)", subQuery)
+	}
+
 	// Filter by IDs (batch fetch mode) - takes priority over search
 	if len(params.IDs) > 0 {
 		query = query.Where("id IN ?", params.IDs)
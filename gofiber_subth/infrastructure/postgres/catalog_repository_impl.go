@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"gofiber-template/domain/repositories"
+)
+
+type catalogRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewCatalogRepository(db *gorm.DB) repositories.CatalogRepository {
+	return &catalogRepositoryImpl{db: db}
+}
+
+// catalogSelect - relation ต่างๆ รวมเป็น array/JSON ต่อแถว เพื่อให้ 1 video = 1 แถวของ cursor
+// updated_at รวมเวลาแก้คำแปล, บทความ worker, reels + เวลาลบ (ทั้ง video และลูก) เพื่อให้ incremental export
+// เห็นการเปลี่ยนแปลงของคอลัมน์ articles/reels ด้วย
+const catalogSelect = `v.id, COALESCE(v.code, '') AS code, COALESCE(v.thumbnail, '') AS thumbnail, COALESCE(v.embed_url, '') AS embed_url,
+	v.release_date, v.views, v.auto_tags, COALESCE(v.seo_status, '') AS seo_status, v.has_reel,
+	(SELECT COUNT(*) FROM reels WHERE reels.video_id = v.id AND reels.is_active AND reels.deleted_at IS NULL) AS reels,
+	COALESCE(m.slug, '') AS maker_slug, COALESCE(m.name, '') AS maker_name,
+	COALESCE((SELECT json_object_agg(vt.lang, vt.title ORDER BY vt.lang) FROM video_translations vt WHERE vt.video_id = v.id), '{}')::text AS titles,
+	ARRAY(SELECT c.slug FROM video_casts vc JOIN casts c ON c.id = vc.cast_id WHERE vc.video_id = v.id ORDER BY c.slug) AS casts,
+	ARRAY(SELECT t.slug FROM video_tags vtg JOIN tags t ON t.id = vtg.tag_id WHERE vtg.video_id = v.id ORDER BY t.slug) AS tags,
+	ARRAY(SELECT cat.slug FROM video_categories vcat JOIN categories cat ON cat.id = vcat.category_id WHERE vcat.video_id = v.id ORDER BY cat.slug) AS categories,
+	COALESCE((SELECT json_object_agg(a.language, a.status ORDER BY a.language) FROM articles a WHERE a.video_id = v.id AND a.origin = 'worker' AND a.deleted_at IS NULL), '{}')::text AS articles,
+	v.created_at,
+	GREATEST(v.updated_at, COALESCE(v.deleted_at, v.updated_at), COALESCE(tr.updated_at, v.updated_at),
+		COALESCE(art.updated_at, v.updated_at), COALESCE(art.deleted_at, v.updated_at),
+		COALESCE(rl.updated_at, v.updated_at), COALESCE(rl.deleted_at, v.updated_at)) AS updated_at,
+	v.deleted_at IS NOT NULL AS deleted,
+	NULL::uuid AS merged_into`
+
+// catalogMergedSelect - video ที่ถูก merge (ลบจริง เหลือแค่ video_redirects) ส่งเป็นแถว deleted = true
+// คอลัมน์ต้องเรียงเหมือน catalogSelect (UNION ALL)
+const catalogMergedSelect = `vr.from_video_id AS id, '' AS code, '' AS thumbnail, '' AS embed_url,
+	NULL::date AS release_date, 0 AS views, '{}'::text[] AS auto_tags, '' AS seo_status, false AS has_reel,
+	0 AS reels,
+	'' AS maker_slug, '' AS maker_name,
+	'{}' AS titles,
+	'{}'::text[] AS casts,
+	'{}'::text[] AS tags,
+	'{}'::text[] AS categories,
+	'{}' AS articles,
+	vr.created_at,
+	vr.created_at AS updated_at,
+	true AS deleted,
+	vr.to_video_id AS merged_into`
+
+func (r *catalogRepositoryImpl) Stream(ctx context.Context, params repositories.CatalogExportParams, fn func(row *repositories.CatalogRow) error) error {
+	inner := r.db.Table("videos v").
+		Select(catalogSelect).
+		Joins("LEFT JOIN makers m ON m.id = v.maker_id").
+		Joins("LEFT JOIN LATERAL (SELECT MAX(updated_at) AS updated_at FROM video_translations WHERE video_id = v.id) tr ON TRUE").
+		Joins("LEFT JOIN LATERAL (SELECT MAX(updated_at) AS updated_at, MAX(deleted_at) AS deleted_at FROM articles WHERE video_id = v.id AND origin = 'worker') art ON TRUE").
+		Joins("LEFT JOIN LATERAL (SELECT MAX(updated_at) AS updated_at, MAX(deleted_at) AS deleted_at FROM reels WHERE video_id = v.id) rl ON TRUE")
+
+	// export เต็มไม่รวมถังขยะ, incremental รวม video ที่ถูกลบหลัง since
+	if params.UpdatedSince != nil {
+		inner = inner.Where("v.deleted_at IS NULL OR v.deleted_at >= ?", params.UpdatedSince)
+	} else {
+		inner = inner.Where("v.deleted_at IS NULL")
+	}
+	if params.MakerID != nil {
+		inner = inner.Where("v.maker_id = ?", params.MakerID)
+	}
+	if params.CastID != nil {
+		inner = inner.Where("v.id IN (SELECT video_id FROM video_casts WHERE cast_id = ?)", params.CastID)
+	}
+	if params.TagID != nil {
+		inner = inner.Where("v.id IN (SELECT video_id FROM video_tags WHERE tag_id = ?)", params.TagID)
+	}
+	if params.Category != "" {
+		inner = inner.Where("v.id IN (SELECT video_categories.video_id FROM video_categories JOIN categories ON categories.id = video_categories.category_id WHERE categories.slug = ?)", params.Category)
+	}
+	if params.SEOStatus != "" {
+		inner = inner.Where("v.seo_status = ?", params.SEOStatus)
+	}
+	if params.HasReel != nil {
+		inner = inner.Where("v.has_reel = ?", *params.HasReel)
+	}
+
+	query := r.db.WithContext(ctx).Table("(?) AS catalog", inner)
+	if params.UpdatedSince != nil {
+		// video ที่ถูก merge ไม่มีแถวเหลือให้ดู deleted_at → ส่งจาก video_redirects แทน
+		// ส่งโดยไม่สน filter อื่น (relation ของ source ถูกย้ายไป target แล้ว) ปลายทางที่ไม่มี id นี้ข้ามได้เลย
+		merged := r.db.Table("video_redirects vr").Select(catalogMergedSelect).Where("vr.created_at >= ?", params.UpdatedSince)
+		query = r.db.WithContext(ctx).Table("((?) UNION ALL (?)) AS catalog", inner, merged).
+			Where("catalog.updated_at >= ?", params.UpdatedSince)
+	}
+
+	// pgx อ่านผลทีละแถวจาก connection (ไม่โหลดทั้งหมดเข้า memory)
+	rows, err := query.Order("catalog.updated_at ASC").Order("catalog.id ASC").Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row repositories.CatalogRow
+		if err := r.db.ScanRows(rows, &row); err != nil {
+			return err
+		}
+		if err := fn(&row); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
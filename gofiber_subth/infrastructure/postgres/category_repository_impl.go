@@ -97,9 +97,19 @@ func (r *CategoryRepositoryImpl) GetOrCreate(ctx context.Context, nameOrSlug str
 	return &existing, nil
 }
 
-func (r *CategoryRepositoryImpl) List(ctx context.Context) ([]*models.Category, error) {
+func (r *CategoryRepositoryImpl) List(ctx context.Context, params repositories.CategoryListParams) ([]*models.Category, error) {
 	var categories []*models.Category
-	err := r.db.WithContext(ctx).
+	query := r.db.WithContext(ctx)
+
+	// Filter categories without name in MissingLang
+	if params.MissingLang != "" {
+		subQuery := r.db.Model(&models.CategoryTranslation{}).
+			Select("category_id").
+			Where("lang = ? AND name != ''", params.MissingLang)
+		query = query.Where("id NOT IN (?)", subQuery)
+	}
+
+	err := query.
 		Preload("Translations").
 		Order("sort_order ASC, video_count DESC").
 		Find(&categories).Error
@@ -117,7 +127,9 @@ func (r *CategoryRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error
 func (r *CategoryRepositoryImpl) UpdateVideoCount(ctx context.Context, id uuid.UUID) error {
 	return r.db.WithContext(ctx).Exec(`
 		UPDATE categories SET video_count = (
-			SELECT COUNT(*) FROM video_categories WHERE video_categories.category_id = categories.id
+			SELECT COUNT(*) FROM video_categories
+			JOIN videos ON videos.id = video_categories.video_id AND videos.deleted_at IS NULL
+			WHERE video_categories.category_id = categories.id
 		) WHERE id = ?
 	`, id).Error
 }
@@ -147,7 +159,9 @@ func (r *CategoryRepositoryImpl) RefreshAllVideoCounts(ctx context.Context) erro
 	// Update video_count ทุก category
 	return r.db.WithContext(ctx).Exec(`
 		UPDATE categories SET video_count = (
-			SELECT COUNT(*) FROM video_categories WHERE video_categories.category_id = categories.id
+			SELECT COUNT(*) FROM video_categories
+			JOIN videos ON videos.id = video_categories.video_id AND videos.deleted_at IS NULL
+			WHERE video_categories.category_id = categories.id
 		)
 	`).Error
 }
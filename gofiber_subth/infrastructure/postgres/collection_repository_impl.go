@@ -0,0 +1,151 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/repositories"
+)
+
+var collectionSelect = videoCountSelect("collections", "collection_videos", "collection_id")
+
+type collectionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewCollectionRepository(db *gorm.DB) repositories.CollectionRepository {
+	return &collectionRepositoryImpl{db: db}
+}
+
+func (r *collectionRepositoryImpl) Create(ctx context.Context, collection *models.Collection) error {
+	return r.db.WithContext(ctx).Omit(clause.Associations).Create(collection).Error
+}
+
+func (r *collectionRepositoryImpl) get(ctx context.Context, query string, args ...interface{}) (*models.Collection, error) {
+	var collection models.Collection
+	err := r.db.WithContext(ctx).
+		Select(collectionSelect).
+		Preload("Translations").
+		Where(query, args...).
+		First(&collection).Error
+	if err != nil {
+		return nil, err
+	}
+	return &collection, nil
+}
+
+func (r *collectionRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*models.Collection, error) {
+	return r.get(ctx, "collections.id = ?", id)
+}
+
+func (r *collectionRepositoryImpl) GetBySlug(ctx context.Context, slug string) (*models.Collection, error) {
+	return r.get(ctx, "collections.slug = ?", slug)
+}
+
+func (r *collectionRepositoryImpl) GetByName(ctx context.Context, name string) (*models.Collection, error) {
+	return r.get(ctx, "collections.name = ?", name)
+}
+
+func (r *collectionRepositoryImpl) Update(ctx context.Context, collection *models.Collection) error {
+	return r.db.WithContext(ctx).Omit(clause.Associations).Save(collection).Error
+}
+
+func (r *collectionRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("collection_id = ?", id).Delete(&models.CollectionVideo{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("collection_id = ?", id).Delete(&models.CollectionTranslation{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.Collection{}, "id = ?", id).Error
+	})
+}
+
+func (r *collectionRepositoryImpl) List(ctx context.Context, params repositories.CollectionListParams) ([]models.Collection, int64, error) {
+	var collections []models.Collection
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.Collection{})
+
+	if params.PublicOnly {
+		query = query.Where("collections.is_public = ?", true)
+	}
+	if params.Search != "" {
+		// ค้นจากชื่อหลักหรือชื่อที่แปลแล้ว
+		query = query.Where("collections.name ILIKE ? OR collections.id IN (?)", "%"+params.Search+"%",
+			r.db.Model(&models.CollectionTranslation{}).Select("collection_id").Where("name ILIKE ?", "%"+params.Search+"%"))
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Select(collectionSelect).
+		Preload("Translations").
+		Order("collections.sort_order ASC, collections.created_at DESC").
+		Offset(params.Offset).
+		Limit(params.Limit).
+		Find(&collections).Error
+	return collections, total, err
+}
+
+func (r *collectionRepositoryImpl) ListHome(ctx context.Context, limit int) ([]models.Collection, error) {
+	var collections []models.Collection
+
+	query := r.db.WithContext(ctx).
+		Select(collectionSelect).
+		Preload("Translations").
+		Where("collections.is_public = ? AND collections.show_on_home = ?", true, true).
+		Order("collections.sort_order ASC, collections.created_at DESC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	err := query.Find(&collections).Error
+	return collections, err
+}
+
+func (r *collectionRepositoryImpl) Reorder(ctx context.Context, collectionIDs []uuid.UUID) error {
+	// Update sort_order ตามลำดับใน slice
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i, id := range collectionIDs {
+			if err := tx.Model(&models.Collection{}).
+				Where("id = ?", id).
+				Update("sort_order", i).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *collectionRepositoryImpl) ReplaceTranslations(ctx context.Context, collectionID uuid.UUID, translations []models.CollectionTranslation) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("collection_id = ?", collectionID).Delete(&models.CollectionTranslation{}).Error; err != nil {
+			return err
+		}
+		if len(translations) == 0 {
+			return nil
+		}
+		for i := range translations {
+			translations[i].CollectionID = collectionID
+		}
+		return tx.Create(&translations).Error
+	})
+}
+
+func (r *collectionRepositoryImpl) ListVideos(ctx context.Context, collectionID uuid.UUID, limit, offset int) ([]models.Video, error) {
+	return listOrderedVideos(r.db.WithContext(ctx), "collection_videos", "collection_id", collectionID, limit, offset)
+}
+
+func (r *collectionRepositoryImpl) SetVideos(ctx context.Context, collectionID uuid.UUID, videoIDs []uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return setOrderedVideos(tx, "collection_videos", "collection_id", collectionID, videoIDs)
+	})
+}
@@ -74,7 +74,15 @@ func Migrate(db *gorm.DB) error {
 		// Video after its dependencies
 		&models.Video{},
 		&models.VideoTranslation{},
+		&models.VideoRedirect{},
 		&models.AutoTagLabel{},
+		// Series & collections (ordered video membership, references Video)
+		&models.Series{},
+		&models.SeriesTranslation{},
+		&models.SeriesVideo{},
+		&models.Collection{},
+		&models.CollectionTranslation{},
+		&models.CollectionVideo{},
 		// Reel after Video (references Video)
 		&models.Reel{},
 		// Reel engagement (likes, comments)
@@ -95,6 +103,12 @@ func Migrate(db *gorm.DB) error {
 		&models.ChatBan{},
 		// Articles
 		&models.Article{},
+		&models.ArticleRevision{},
+		&models.ArticleRedirect{},
+		&models.ArticleVideo{},
+		&models.ArticleStatusChange{},
+		&models.ArticleQueueItem{},
+		&models.ArticleSchedulePolicy{},
 		// Article engagement (likes, comments)
 		&models.ArticleLike{},
 		&models.ArticleComment{},
@@ -105,7 +119,25 @@ func Migrate(db *gorm.DB) error {
 	}
 
 	// Run custom migrations
-	return migrateVideoCategories(db)
+	if err := migrateVideoCategories(db); err != nil {
+		return err
+	}
+	if err := migrateArticleVideoIndex(db); err != nil {
+		return err
+	}
+	return migrateArticleSearch(db)
+}
+
+// migrateArticleVideoIndex - unique video+language เดิมใช้ไม่ได้กับบทความที่ generator สร้าง (หลายบทความต่อ video)
+// และบทความในถังขยะต้องไม่จอง slug/ภาษาไว้
+// idx_article_video_language_active, idx_slug_language_active (partial, deleted_at IS NULL) ถูกสร้างโดย AutoMigrate แทนแล้ว
+func migrateArticleVideoIndex(db *gorm.DB) error {
+	for _, index := range []string{"idx_video_language", "idx_article_video_language", "idx_slug_language"} {
+		if err := db.Exec("DROP INDEX IF EXISTS " + index).Error; err != nil {
+			return fmt.Errorf("failed to drop %s: %v", index, err)
+		}
+	}
+	return nil
 }
 
 // migrateVideoCategories - Migrate from single category_id to many2many video_categories
@@ -0,0 +1,20 @@
+package postgres
+
+import (
+	"gorm.io/gorm"
+
+	"gofiber-template/domain/repositories"
+)
+
+// keysetPage ใส่ WHERE (sort, id) ต่อจาก after + ORDER BY ชุดเดียวกัน แทน OFFSET
+// หน้าลึกๆ ยังเร็วเท่าเดิม และแถวที่ insert ระหว่างเลื่อนไม่ทำให้รายการซ้ำ
+func keysetPage(query *gorm.DB, sortExpr, idColumn string, desc bool, after *repositories.Keyset, limit int) *gorm.DB {
+	op, dir := ">", " ASC"
+	if desc {
+		op, dir = "<", " DESC"
+	}
+	if after != nil {
+		query = query.Where("("+sortExpr+", "+idColumn+") "+op+" (?, ?)", after.Value, after.ID)
+	}
+	return query.Order(sortExpr + dir).Order(idColumn + dir).Limit(limit)
+}
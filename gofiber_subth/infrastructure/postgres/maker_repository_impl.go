@@ -70,7 +70,7 @@ func (r *makerRepositoryImpl) List(ctx context.Context, params repositories.Make
 		subQuery := r.db.Table("videos").
 			Select("DISTINCT videos.maker_id").
 			Joins("JOIN articles ON articles.video_id = videos.id").
-			Where("articles.status = ? AND videos.maker_id IS NOT NULL", "published")
+			Where("articles.status = ? AND articles.deleted_at IS NULL AND videos.maker_id IS NOT NULL", "published")
 		query = query.Where("id IN (?)", subQuery)
 	}
 
@@ -68,6 +68,22 @@ func (r *reelCommentRepositoryImpl) ListByReel(ctx context.Context, reelID uuid.
 	return comments, total, nil
 }
 
+func (r *reelCommentRepositoryImpl) ListByReelAfter(ctx context.Context, reelID uuid.UUID, limit int, after *repositories.Keyset) ([]models.ReelComment, error) {
+	var comments []models.ReelComment
+
+	query := r.db.WithContext(ctx).
+		Preload("User").
+		Preload("User.Stats").
+		Where("reel_id = ? AND parent_id IS NULL", reelID)
+
+	if err := keysetPage(query, "reel_comments.created_at", "reel_comments.id", true, after, limit).
+		Find(&comments).Error; err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
 func (r *reelCommentRepositoryImpl) ListReplies(ctx context.Context, parentID uuid.UUID, limit, offset int) ([]models.ReelComment, int64, error) {
 	var replies []models.ReelComment
 	var total int64
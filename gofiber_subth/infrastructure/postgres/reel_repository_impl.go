@@ -101,3 +101,21 @@ func (r *reelRepositoryImpl) ListWithVideo(ctx context.Context, limit int, offse
 
 	return reels, total, err
 }
+
+func (r *reelRepositoryImpl) ListWithVideoAfter(ctx context.Context, limit int, after *repositories.Keyset, activeOnly bool) ([]models.Reel, error) {
+	var reels []models.Reel
+
+	query := r.db.WithContext(ctx).Model(&models.Reel{})
+
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+
+	err := keysetPage(query, "reels.created_at", "reels.id", true, after, limit).
+		Preload("Video").
+		Preload("Video.Tags").
+		Preload("Video.Tags.Translations").
+		Find(&reels).Error
+
+	return reels, err
+}
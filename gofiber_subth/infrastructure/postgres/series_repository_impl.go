@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/repositories"
+)
+
+var seriesSelect = videoCountSelect("series", "series_videos", "series_id")
+
+type seriesRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewSeriesRepository(db *gorm.DB) repositories.SeriesRepository {
+	return &seriesRepositoryImpl{db: db}
+}
+
+func (r *seriesRepositoryImpl) Create(ctx context.Context, series *models.Series) error {
+	return r.db.WithContext(ctx).Omit(clause.Associations).Create(series).Error
+}
+
+func (r *seriesRepositoryImpl) get(ctx context.Context, query string, args ...interface{}) (*models.Series, error) {
+	var series models.Series
+	err := r.db.WithContext(ctx).
+		Select(seriesSelect).
+		Preload("Translations").
+		Where(query, args...).
+		First(&series).Error
+	if err != nil {
+		return nil, err
+	}
+	return &series, nil
+}
+
+func (r *seriesRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*models.Series, error) {
+	return r.get(ctx, "series.id = ?", id)
+}
+
+func (r *seriesRepositoryImpl) GetBySlug(ctx context.Context, slug string) (*models.Series, error) {
+	return r.get(ctx, "series.slug = ?", slug)
+}
+
+func (r *seriesRepositoryImpl) GetByName(ctx context.Context, name string) (*models.Series, error) {
+	return r.get(ctx, "series.name = ?", name)
+}
+
+func (r *seriesRepositoryImpl) Update(ctx context.Context, series *models.Series) error {
+	return r.db.WithContext(ctx).Omit(clause.Associations).Save(series).Error
+}
+
+func (r *seriesRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("series_id = ?", id).Delete(&models.SeriesVideo{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("series_id = ?", id).Delete(&models.SeriesTranslation{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.Series{}, "id = ?", id).Error
+	})
+}
+
+func (r *seriesRepositoryImpl) List(ctx context.Context, params repositories.SeriesListParams) ([]models.Series, int64, error) {
+	var series []models.Series
+	var total int64
+
+	query := r.db.WithContext(ctx).Model(&models.Series{})
+
+	if params.Search != "" {
+		// ค้นจากชื่อหลักหรือชื่อที่แปลแล้ว
+		query = query.Where("series.name ILIKE ? OR series.id IN (?)", "%"+params.Search+"%",
+			r.db.Model(&models.SeriesTranslation{}).Select("series_id").Where("name ILIKE ?", "%"+params.Search+"%"))
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Select(seriesSelect).
+		Preload("Translations").
+		Order("series.name ASC").
+		Offset(params.Offset).
+		Limit(params.Limit).
+		Find(&series).Error
+	return series, total, err
+}
+
+func (r *seriesRepositoryImpl) ReplaceTranslations(ctx context.Context, seriesID uuid.UUID, translations []models.SeriesTranslation) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("series_id = ?", seriesID).Delete(&models.SeriesTranslation{}).Error; err != nil {
+			return err
+		}
+		if len(translations) == 0 {
+			return nil
+		}
+		for i := range translations {
+			translations[i].SeriesID = seriesID
+		}
+		return tx.Create(&translations).Error
+	})
+}
+
+func (r *seriesRepositoryImpl) ListVideos(ctx context.Context, seriesID uuid.UUID) ([]models.Video, error) {
+	return listOrderedVideos(r.db.WithContext(ctx), "series_videos", "series_id", seriesID, 0, 0)
+}
+
+func (r *seriesRepositoryImpl) SetVideos(ctx context.Context, seriesID uuid.UUID, videoIDs []uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return setOrderedVideos(tx, "series_videos", "series_id", seriesID, videoIDs)
+	})
+}
+
+func (r *seriesRepositoryImpl) GetByVideoID(ctx context.Context, videoID uuid.UUID) (*models.Series, error) {
+	return r.get(ctx, "series.id = (SELECT series_id FROM series_videos WHERE video_id = ?)", videoID)
+}
+
+func (r *seriesRepositoryImpl) GetSeriesIDsByVideoIDs(ctx context.Context, videoIDs []uuid.UUID) (map[uuid.UUID]uuid.UUID, error) {
+	result := make(map[uuid.UUID]uuid.UUID)
+	if len(videoIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []models.SeriesVideo
+	if err := r.db.WithContext(ctx).Select("series_id, video_id").Where("video_id IN ?", videoIDs).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		result[row.VideoID] = row.SeriesID
+	}
+	return result, nil
+}
@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/repositories"
+)
+
+type sitemapRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewSitemapRepository(db *gorm.DB) repositories.SitemapRepository {
+	return &sitemapRepositoryImpl{db: db}
+}
+
+func (r *sitemapRepositoryImpl) ListPublishedArticles(ctx context.Context, afterID uuid.UUID, limit int) ([]repositories.SitemapArticle, error) {
+	var articles []repositories.SitemapArticle
+	err := r.db.WithContext(ctx).
+		Table("articles").
+		Select(`articles.id, articles.video_id, articles.type, articles.origin, articles.language, articles.slug,
+			articles.title, articles.meta_description, articles.published_at, articles.updated_at,
+			COALESCE(articles.content->>'thumbnailUrl', '') AS thumbnail_url,
+			COALESCE(videos.embed_url, '') AS embed_url,
+			CASE WHEN jsonb_typeof(articles.content->'facts'->'durationMinutes') = 'number'
+				THEN (articles.content->'facts'->>'durationMinutes')::numeric::int
+				ELSE 0 END AS duration_minutes`).
+		Joins("LEFT JOIN videos ON videos.id = articles.video_id").
+		Where("articles.status = ? AND articles.deleted_at IS NULL AND articles.id > ?", models.ArticleStatusPublished, afterID).
+		Order("articles.id ASC").
+		Limit(limit).
+		Scan(&articles).Error
+	return articles, err
+}
+
+func (r *sitemapRepositoryImpl) ListCasts(ctx context.Context) ([]repositories.SitemapEntity, error) {
+	var entities []repositories.SitemapEntity
+	err := r.db.WithContext(ctx).
+		Table("casts").
+		Select("casts.slug, MAX(articles.updated_at) AS last_mod").
+		Joins("JOIN video_casts ON video_casts.cast_id = casts.id").
+		Joins("JOIN articles ON articles.video_id = video_casts.video_id").
+		Where("articles.status = ? AND articles.deleted_at IS NULL", models.ArticleStatusPublished).
+		Group("casts.slug").
+		Order("casts.slug ASC").
+		Scan(&entities).Error
+	return entities, err
+}
+
+func (r *sitemapRepositoryImpl) ListTags(ctx context.Context) ([]repositories.SitemapEntity, error) {
+	var entities []repositories.SitemapEntity
+	err := r.db.WithContext(ctx).
+		Table("tags").
+		Select("tags.slug, MAX(articles.updated_at) AS last_mod").
+		Joins("JOIN video_tags ON video_tags.tag_id = tags.id").
+		Joins("JOIN articles ON articles.video_id = video_tags.video_id").
+		Where("articles.status = ? AND articles.deleted_at IS NULL", models.ArticleStatusPublished).
+		Group("tags.slug").
+		Order("tags.slug ASC").
+		Scan(&entities).Error
+	return entities, err
+}
+
+func (r *sitemapRepositoryImpl) ListMakers(ctx context.Context) ([]repositories.SitemapEntity, error) {
+	var entities []repositories.SitemapEntity
+	err := r.db.WithContext(ctx).
+		Table("makers").
+		Select("makers.slug, MAX(articles.updated_at) AS last_mod").
+		Joins("JOIN videos ON videos.maker_id = makers.id").
+		Joins("JOIN articles ON articles.video_id = videos.id").
+		Where("articles.status = ? AND articles.deleted_at IS NULL", models.ArticleStatusPublished).
+		Group("makers.slug").
+		Order("makers.slug ASC").
+		Scan(&entities).Error
+	return entities, err
+}
@@ -71,10 +71,18 @@ func (r *tagRepositoryImpl) List(ctx context.Context, params repositories.TagLis
 			Select("DISTINCT video_tags.tag_id").
 			Joins("JOIN videos ON videos.id = video_tags.video_id").
 			Joins("JOIN articles ON articles.video_id = videos.id").
-			Where("articles.status = ?", "published")
+			Where("articles.status = ? AND articles.deleted_at IS NULL", "published")
 		query = query.Where("id IN (?)", subQuery)
 	}
 
+	// Filter tags without name in MissingLang
+	if params.MissingLang != "" {
+		subQuery := r.db.Model(&models.TagTranslation{}).
+			Select("tag_id").
+			Where("lang = ? AND name != ''", params.MissingLang)
+		query = query.Where("id NOT IN (?)", subQuery)
+	}
+
 	// Filter by IDs (batch fetch mode) - takes priority over search
 	if len(params.IDs) > 0 {
 		query = query.Where("id IN ?", params.IDs)
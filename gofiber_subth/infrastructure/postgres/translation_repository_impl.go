@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/repositories"
+)
+
+type translationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewTranslationRepository(db *gorm.DB) repositories.TranslationRepository {
+	return &translationRepositoryImpl{db: db}
+}
+
+// translationSource - table ของ entity (alias e) และ table คำแปล
+type translationSource struct {
+	table  string
+	where  string // filter ของ entity (เช่น ไม่รวมถังขยะ)
+	key    string
+	source string
+	join   string // join เพิ่มสำหรับ source
+	trans  string // table คำแปล
+	fk     string
+	column string
+}
+
+var translationSources = map[string]translationSource{
+	repositories.TranslationTypeVideo: {
+		table: "videos", where: "e.deleted_at IS NULL",
+		key:    "COALESCE(e.code, '')",
+		source: "COALESCE(NULLIF(src.title, ''), e.code, '')",
+		join:   "LEFT JOIN video_translations src ON src.video_id = e.id AND src.lang = 'en'",
+		trans:  "video_translations", fk: "video_id", column: "title",
+	},
+	repositories.TranslationTypeCast: {
+		table: "casts", where: "TRUE", key: "e.slug", source: "e.name",
+		trans: "cast_translations", fk: "cast_id", column: "name",
+	},
+	repositories.TranslationTypeTag: {
+		table: "tags", where: "TRUE", key: "e.slug", source: "e.name",
+		trans: "tag_translations", fk: "tag_id", column: "name",
+	},
+	repositories.TranslationTypeCategory: {
+		table: "categories", where: "TRUE", key: "e.slug", source: "e.name",
+		trans: "category_translations", fk: "category_id", column: "name",
+	},
+}
+
+// translationRowsSQL - cast/tag translations ไม่มี unique (id, lang) จึงเลือกแถวแรกที่ไม่ว่าง
+const translationRowsSQL = `SELECT e.id, %[3]s AS key, %[4]s AS source,
+	COALESCE((SELECT t.%[8]s FROM %[6]s t WHERE t.%[7]s = e.id AND t.lang = @lang AND t.%[8]s <> '' ORDER BY t.created_at LIMIT 1), '') AS text
+FROM %[1]s e %[5]s
+WHERE %[2]s`
+
+func (r *translationRepositoryImpl) rows(ctx context.Context, entityType string, lang string) (*gorm.DB, error) {
+	src, ok := translationSources[entityType]
+	if !ok {
+		return nil, errors.New("invalid translation type")
+	}
+	query := fmt.Sprintf(translationRowsSQL, src.table, src.where, src.key, src.source, src.join, src.trans, src.fk, src.column)
+	return r.db.WithContext(ctx).Table("(?) AS tr", r.db.Raw(query, map[string]interface{}{"lang": lang})), nil
+}
+
+func (r *translationRepositoryImpl) List(ctx context.Context, params repositories.TranslationListParams) ([]repositories.TranslationRow, error) {
+	query, err := r.rows(ctx, params.EntityType, params.Lang)
+	if err != nil {
+		return nil, err
+	}
+	if params.MissingOnly {
+		query = query.Where("tr.text = ''")
+	}
+
+	var rows []repositories.TranslationRow
+	err = query.Order("tr.key ASC").Order("tr.id ASC").Scan(&rows).Error
+	return rows, err
+}
+
+func (r *translationRepositoryImpl) GetByIDs(ctx context.Context, entityType string, lang string, ids []uuid.UUID) ([]repositories.TranslationRow, error) {
+	var rows []repositories.TranslationRow
+	if len(ids) == 0 {
+		return rows, nil
+	}
+	query, err := r.rows(ctx, entityType, lang)
+	if err != nil {
+		return nil, err
+	}
+	err = query.Where("tr.id IN ?", ids).Scan(&rows).Error
+	return rows, err
+}
+
+func (r *translationRepositoryImpl) Upsert(ctx context.Context, entries []repositories.TranslationUpsert) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, entry := range entries {
+			src, ok := translationSources[entry.EntityType]
+			if !ok {
+				return errors.New("invalid translation type")
+			}
+
+			// แก้ทุกแถวของ (id, lang) - ลบแถวซ้ำของ cast/tag ทิ้งไม่ได้เพราะไม่รู้ว่าแถวไหนถูก
+			result := tx.Table(src.trans).
+				Where(src.fk+" = ? AND lang = ?", entry.ID, entry.Lang).
+				Updates(translationUpdates(entry.EntityType, src.column, entry.Text))
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected > 0 {
+				continue
+			}
+
+			if err := tx.Create(newTranslationModel(entry)).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func translationUpdates(entityType, column, text string) map[string]interface{} {
+	updates := map[string]interface{}{column: text}
+	if entityType == repositories.TranslationTypeVideo {
+		updates["updated_at"] = gorm.Expr("NOW()")
+	}
+	return updates
+}
+
+func newTranslationModel(entry repositories.TranslationUpsert) interface{} {
+	switch entry.EntityType {
+	case repositories.TranslationTypeVideo:
+		return &models.VideoTranslation{VideoID: entry.ID, Lang: entry.Lang, Title: entry.Text}
+	case repositories.TranslationTypeCast:
+		return &models.CastTranslation{CastID: entry.ID, Lang: entry.Lang, Name: entry.Text}
+	case repositories.TranslationTypeTag:
+		return &models.TagTranslation{TagID: entry.ID, Lang: entry.Lang, Name: entry.Text}
+	default:
+		return &models.CategoryTranslation{CategoryID: entry.ID, Lang: entry.Lang, Name: entry.Text}
+	}
+}
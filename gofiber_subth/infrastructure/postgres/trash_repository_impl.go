@@ -0,0 +1,252 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/repositories"
+)
+
+type trashRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewTrashRepository(db *gorm.DB) repositories.TrashRepository {
+	return &trashRepositoryImpl{db: db}
+}
+
+// trashItemsSQL - video/article/reel ที่ถูก soft delete รวมเป็นชุดเดียว (alias: trash)
+const trashItemsSQL = `SELECT 'video' AS type, videos.id,
+		COALESCE(
+			(SELECT title FROM video_translations WHERE video_id = videos.id AND lang = 'en'),
+			(SELECT title FROM video_translations WHERE video_id = videos.id ORDER BY lang LIMIT 1),
+			NULLIF(videos.code, ''), '') AS title,
+		videos.id AS video_id, COALESCE(videos.code, '') AS video_code, COALESCE(videos.thumbnail, '') AS thumbnail, videos.deleted_at
+	FROM videos
+	WHERE videos.deleted_at IS NOT NULL
+	UNION ALL
+	SELECT 'article', articles.id, articles.title, articles.video_id, COALESCE(videos.code, ''),
+		COALESCE(articles.content->>'thumbnailUrl', ''), articles.deleted_at
+	FROM articles LEFT JOIN videos ON videos.id = articles.video_id
+	WHERE articles.deleted_at IS NOT NULL
+	UNION ALL
+	SELECT 'reel', reels.id, COALESCE(reels.title, ''), reels.video_id, COALESCE(videos.code, ''),
+		COALESCE(reels.thumb_url, ''), reels.deleted_at
+	FROM reels LEFT JOIN videos ON videos.id = reels.video_id
+	WHERE reels.deleted_at IS NOT NULL`
+
+func (r *trashRepositoryImpl) items(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Table("(" + trashItemsSQL + ") AS trash")
+}
+
+func (r *trashRepositoryImpl) List(ctx context.Context, params repositories.TrashListParams) ([]repositories.TrashItemRow, int64, error) {
+	var rows []repositories.TrashItemRow
+	var total int64
+
+	query := r.items(ctx)
+	if params.Type != "" {
+		query = query.Where("trash.type = ?", params.Type)
+	}
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.
+		Order("trash.deleted_at DESC").
+		Order("trash.id ASC").
+		Offset(params.Offset).
+		Limit(params.Limit).
+		Scan(&rows).Error
+	return rows, total, err
+}
+
+func (r *trashRepositoryImpl) Get(ctx context.Context, itemType string, id uuid.UUID) (*repositories.TrashItemRow, error) {
+	var row repositories.TrashItemRow
+	err := r.items(ctx).Where("trash.type = ? AND trash.id = ?", itemType, id).Take(&row).Error
+	if err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
+func (r *trashRepositoryImpl) ListExpired(ctx context.Context, before time.Time, limit int) ([]repositories.TrashItemRow, error) {
+	var rows []repositories.TrashItemRow
+	err := r.items(ctx).
+		Where("trash.deleted_at < ?", before).
+		Order("trash.deleted_at ASC").
+		Limit(limit).
+		Scan(&rows).Error
+	return rows, err
+}
+
+// articleRestoreConflictSQL - บทความในถังขยะ (t) ชนกับบทความที่ใช้งานอยู่ (a) (%s = scope ของ t)
+const articleRestoreConflictSQL = `SELECT EXISTS (
+	SELECT 1 FROM articles t
+	JOIN articles a ON a.id <> t.id AND a.deleted_at IS NULL AND a.language = t.language
+		AND (a.slug = t.slug OR (a.origin = 'worker' AND t.origin = 'worker' AND a.video_id = t.video_id))
+	WHERE %s)`
+
+func (r *trashRepositoryImpl) RestoreConflict(ctx context.Context, itemType string, id uuid.UUID) (bool, error) {
+	var conflict bool
+	var err error
+	switch itemType {
+	case repositories.TrashTypeArticle:
+		err = r.db.WithContext(ctx).Raw(fmt.Sprintf(articleRestoreConflictSQL, "t.id = ?"), id).Scan(&conflict).Error
+	case repositories.TrashTypeVideo:
+		err = r.db.WithContext(ctx).Raw(fmt.Sprintf(articleRestoreConflictSQL,
+			"t.video_id = ? AND t.deleted_at = (SELECT deleted_at FROM videos WHERE id = ?)"), id, id).Scan(&conflict).Error
+	}
+	return conflict, err
+}
+
+func (r *trashRepositoryImpl) RestoreVideo(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var video models.Video
+		if err := tx.Unscoped().Where("id = ? AND deleted_at IS NOT NULL", id).First(&video).Error; err != nil {
+			return err
+		}
+
+		// คืนเฉพาะบทความ/reels ที่ถูกลบพร้อม video (ที่ admin ลบเองก่อนหน้ายังอยู่ในถังขยะ)
+		deletedAt := video.DeletedAt.Time
+		if err := tx.Unscoped().Model(&models.Article{}).Where("video_id = ? AND deleted_at = ?", id, deletedAt).UpdateColumn("deleted_at", nil).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Model(&models.Reel{}).Where("video_id = ? AND deleted_at = ?", id, deletedAt).UpdateColumn("deleted_at", nil).Error; err != nil {
+			return err
+		}
+		// membership ในบทความ generator + video หลักเดิม
+		if err := tx.Model(&models.ArticleVideo{}).Where("video_id = ? AND deleted_at = ?", id, deletedAt).UpdateColumn("deleted_at", nil).Error; err != nil {
+			return err
+		}
+		if err := syncGeneratorPrimaryVideo(tx, id); err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Model(&models.Video{}).Where("id = ?", id).UpdateColumn("deleted_at", nil).Error; err != nil {
+			return err
+		}
+
+		var makerIDs, castIDs, tagIDs, categoryIDs []uuid.UUID
+		if video.MakerID != nil {
+			makerIDs = append(makerIDs, *video.MakerID)
+		}
+		if err := tx.Table("video_casts").Where("video_id = ?", id).Pluck("cast_id", &castIDs).Error; err != nil {
+			return err
+		}
+		if err := tx.Table("video_tags").Where("video_id = ?", id).Pluck("tag_id", &tagIDs).Error; err != nil {
+			return err
+		}
+		if err := tx.Table("video_categories").Where("video_id = ?", id).Pluck("category_id", &categoryIDs).Error; err != nil {
+			return err
+		}
+		return recountVideos(tx, makerIDs, castIDs, tagIDs, categoryIDs)
+	})
+}
+
+func (r *trashRepositoryImpl) RestoreArticle(ctx context.Context, id uuid.UUID) error {
+	return r.restore(ctx, &models.Article{}, id)
+}
+
+func (r *trashRepositoryImpl) RestoreReel(ctx context.Context, id uuid.UUID) error {
+	return r.restore(ctx, &models.Reel{}, id)
+}
+
+func (r *trashRepositoryImpl) restore(ctx context.Context, model interface{}, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Unscoped().Model(model).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		UpdateColumn("deleted_at", nil)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *trashRepositoryImpl) PurgeVideo(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		trashedArticles := tx.Unscoped().Model(&models.Article{}).Select("id").Where("video_id = ? AND deleted_at IS NOT NULL", id)
+		if err := tx.Where("article_id IN (?) OR video_id = ?", trashedArticles, id).Delete(&models.ArticleVideo{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("video_id = ? AND deleted_at IS NOT NULL", id).Delete(&models.Article{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("video_id = ? AND deleted_at IS NOT NULL", id).Delete(&models.Reel{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("video_id = ?", id).Delete(&models.VideoTranslation{}).Error; err != nil {
+			return err
+		}
+		for _, table := range []string{"video_categories", "video_casts", "video_tags"} {
+			if err := tx.Exec("DELETE FROM "+table+" WHERE video_id = ?", id).Error; err != nil {
+				return err
+			}
+		}
+
+		result := tx.Unscoped().Where("id = ? AND deleted_at IS NOT NULL", id).Delete(&models.Video{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+func (r *trashRepositoryImpl) PurgeArticle(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("article_id = ?", id).Delete(&models.ArticleVideo{}).Error; err != nil {
+			return err
+		}
+		result := tx.Unscoped().Where("id = ? AND deleted_at IS NOT NULL", id).Delete(&models.Article{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+func (r *trashRepositoryImpl) PurgeReel(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Unscoped().Where("id = ? AND deleted_at IS NOT NULL", id).Delete(&models.Reel{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *trashRepositoryImpl) ListArticles(ctx context.Context, itemType string, id uuid.UUID) ([]models.Article, error) {
+	var articles []models.Article
+	query := r.db.WithContext(ctx).Unscoped()
+	switch itemType {
+	case repositories.TrashTypeArticle:
+		query = query.Where("id = ?", id)
+	case repositories.TrashTypeVideo:
+		// บทความ worker + บทความ generator ที่มี video นี้ (รวมแถวที่ถูก detach ตอนลบ)
+		query = query.Where("(video_id = ? AND origin = ?) OR id IN (?)", id, models.ArticleOriginWorker,
+			r.db.Model(&models.ArticleVideo{}).Select("article_id").Where("video_id = ?", id))
+	default:
+		return articles, nil
+	}
+	err := query.Find(&articles).Error
+	return articles, err
+}
+
+func (r *trashRepositoryImpl) CountVideoArticles(ctx context.Context, videoID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Unscoped().Model(&models.Article{}).Where("video_id = ?", videoID).Count(&count).Error
+	return count, err
+}
@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"gofiber-template/domain/repositories"
+)
+
+type trendingRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewTrendingRepository(db *gorm.DB) repositories.TrendingRepository {
+	return &trendingRepositoryImpl{db: db}
+}
+
+// น้ำหนักของแต่ละ event (ก่อน decay)
+const (
+	trendingWeightPageView  = 1.0 // activity_logs (เปิดหน้า video/cast/tag/maker)
+	trendingWeightReelView  = 2.0 // video_views (ดู reel ของ video)
+	trendingWeightReelLike  = 3.0
+	trendingWeightReelReply = 4.0 // reel_comments
+	// trendingVideoShare - สัดส่วนคะแนน video ที่ส่งต่อให้ cast/tag/maker ของ video นั้น
+	trendingVideoShare = 0.5
+)
+
+// trendingDecay - ตัวคูณ 0.5^(อายุ event / half-life) (%[1]s = table ของ event)
+const trendingDecay = "EXP(-LN(2) * EXTRACT(EPOCH FROM (CAST(@now AS timestamptz) - %[1]s.created_at))::float8 / CAST(@half_life AS float8))"
+
+// trendingVideoScoresSQL - CTE คะแนนต่อ video (เฉพาะ video ที่มีชื่อในภาษาที่ขอ)
+var trendingVideoScoresSQL = `events AS (
+	SELECT activity_logs.page_id AS video_id, @w_page * ` + decayOf("activity_logs") + ` AS score
+		FROM activity_logs
+		WHERE activity_logs.page_type = 'video' AND activity_logs.page_id IS NOT NULL AND activity_logs.created_at >= @since
+	UNION ALL
+	SELECT reels.video_id, @w_view * ` + decayOf("video_views") + `
+		FROM video_views JOIN reels ON reels.id = video_views.reel_id
+		WHERE reels.video_id IS NOT NULL AND reels.deleted_at IS NULL AND video_views.created_at >= @since
+	UNION ALL
+	SELECT reels.video_id, @w_like * ` + decayOf("reel_likes") + `
+		FROM reel_likes JOIN reels ON reels.id = reel_likes.reel_id
+		WHERE reels.video_id IS NOT NULL AND reels.deleted_at IS NULL AND reel_likes.created_at >= @since
+	UNION ALL
+	SELECT reels.video_id, @w_reply * ` + decayOf("reel_comments") + `
+		FROM reel_comments JOIN reels ON reels.id = reel_comments.reel_id
+		WHERE reels.video_id IS NOT NULL AND reels.deleted_at IS NULL AND reel_comments.created_at >= @since
+),
+video_scores AS (
+	SELECT events.video_id, SUM(events.score) AS score
+	FROM events
+	WHERE EXISTS (SELECT 1 FROM video_translations WHERE video_translations.video_id = events.video_id AND video_translations.lang = @lang)
+		AND EXISTS (SELECT 1 FROM videos WHERE videos.id = events.video_id AND videos.deleted_at IS NULL)
+	GROUP BY events.video_id
+)`
+
+// trendingEntitySQL - คะแนน cast/tag/maker = page view ของตัวเอง + ส่วนแบ่งจากคะแนน video
+// %[1]s = table, %[2]s = column ที่ชี้ไปยัง entity, %[3]s = join จาก video_scores, %[4]s = decay ของ page view
+const trendingEntitySQL = `entity_events AS (
+	SELECT %[2]s AS entity_id, video_scores.score * @share AS score
+		FROM video_scores %[3]s
+	UNION ALL
+	SELECT activity_logs.page_id, @w_page * %[4]s
+		FROM activity_logs
+		WHERE activity_logs.page_type = @kind AND activity_logs.page_id IS NOT NULL AND activity_logs.created_at >= @since
+)
+SELECT %[1]s.id AS id, SUM(entity_events.score) AS score
+FROM entity_events JOIN %[1]s ON %[1]s.id = entity_events.entity_id
+GROUP BY %[1]s.id
+ORDER BY score DESC, %[1]s.id
+LIMIT @limit`
+
+// trendingEntitySources - table, column, join ของแต่ละ kind
+var trendingEntitySources = map[string][3]string{
+	repositories.TrendingKindCast:  {"casts", "video_casts.cast_id", "JOIN video_casts ON video_casts.video_id = video_scores.video_id"},
+	repositories.TrendingKindTag:   {"tags", "video_tags.tag_id", "JOIN video_tags ON video_tags.video_id = video_scores.video_id"},
+	repositories.TrendingKindMaker: {"makers", "videos.maker_id", "JOIN videos ON videos.id = video_scores.video_id"},
+}
+
+func decayOf(table string) string {
+	return fmt.Sprintf(trendingDecay, table)
+}
+
+func (r *trendingRepositoryImpl) Scores(ctx context.Context, params repositories.TrendingScoreParams) ([]repositories.TrendingScoreRow, error) {
+	query := "WITH " + trendingVideoScoresSQL
+	if params.Kind == repositories.TrendingKindVideo {
+		query += `
+SELECT video_scores.video_id AS id, video_scores.score
+FROM video_scores JOIN videos ON videos.id = video_scores.video_id
+ORDER BY video_scores.score DESC, video_scores.video_id
+LIMIT @limit`
+	} else {
+		source, ok := trendingEntitySources[params.Kind]
+		if !ok {
+			return nil, errors.New("invalid trending kind")
+		}
+		query += ",\n" + fmt.Sprintf(trendingEntitySQL, source[0], source[1], source[2], decayOf("activity_logs"))
+	}
+
+	var rows []repositories.TrendingScoreRow
+	err := r.db.WithContext(ctx).Raw(query, map[string]interface{}{
+		"now":       params.Now,
+		"since":     params.Since,
+		"half_life": params.HalfLife.Seconds(),
+		"lang":      params.Lang,
+		"kind":      params.Kind,
+		"limit":     params.Limit,
+		"share":     trendingVideoShare,
+		"w_page":    trendingWeightPageView,
+		"w_view":    trendingWeightReelView,
+		"w_like":    trendingWeightReelLike,
+		"w_reply":   trendingWeightReelReply,
+	}).Scan(&rows).Error
+	return rows, err
+}
+
+func (r *trendingRepositoryImpl) Items(ctx context.Context, kind string, lang string, ids []uuid.UUID) ([]repositories.TrendingItemRow, error) {
+	var rows []repositories.TrendingItemRow
+	if len(ids) == 0 {
+		return rows, nil
+	}
+
+	db := r.db.WithContext(ctx)
+	var err error
+	switch kind {
+	case repositories.TrendingKindVideo:
+		// ไม่มีชื่อในภาษาที่ขอ = ใช้ en, ไม่มีเลย = code
+		err = db.Raw(`SELECT videos.id, COALESCE(t.title, en.title, videos.code) AS name, videos.code AS slug, videos.thumbnail
+			FROM videos
+			LEFT JOIN video_translations t ON t.video_id = videos.id AND t.lang = ?
+			LEFT JOIN video_translations en ON en.video_id = videos.id AND en.lang = 'en'
+			WHERE videos.id IN ? AND videos.deleted_at IS NULL`, lang, ids).Scan(&rows).Error
+	case repositories.TrendingKindCast:
+		err = db.Raw(`SELECT casts.id, COALESCE(cast_translations.name, casts.name) AS name, casts.slug
+			FROM casts
+			LEFT JOIN cast_translations ON cast_translations.cast_id = casts.id AND cast_translations.lang = ?
+			WHERE casts.id IN ?`, lang, ids).Scan(&rows).Error
+	case repositories.TrendingKindTag:
+		err = db.Raw(`SELECT tags.id, COALESCE(tag_translations.name, tags.name) AS name, tags.slug
+			FROM tags
+			LEFT JOIN tag_translations ON tag_translations.tag_id = tags.id AND tag_translations.lang = ?
+			WHERE tags.id IN ?`, lang, ids).Scan(&rows).Error
+	case repositories.TrendingKindMaker:
+		err = db.Raw(`SELECT makers.id, makers.name, makers.slug FROM makers WHERE makers.id IN ?`, ids).Scan(&rows).Error
+	default:
+		return nil, errors.New("invalid trending kind")
+	}
+	return rows, err
+}
@@ -0,0 +1,151 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/repositories"
+)
+
+// ========================================
+// Bulk Edit
+// ========================================
+
+func (r *videoRepositoryImpl) ListIDs(ctx context.Context, params repositories.VideoListParams) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.filterVideos(r.db.WithContext(ctx).Model(&models.Video{}), params).
+		Order("created_at ASC").
+		Limit(params.Limit).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+func (r *videoRepositoryImpl) GetForBulkEdit(ctx context.Context, ids []uuid.UUID, codes []string) ([]models.Video, error) {
+	var videos []models.Video
+	if len(ids) == 0 && len(codes) == 0 {
+		return videos, nil
+	}
+
+	query := r.db.WithContext(ctx)
+	switch {
+	case len(ids) > 0 && len(codes) > 0:
+		query = query.Where("id IN ? OR code IN ?", ids, codes)
+	case len(ids) > 0:
+		query = query.Where("id IN ?", ids)
+	default:
+		query = query.Where("code IN ?", codes)
+	}
+
+	err := query.
+		Preload("Maker").
+		Preload("Casts").
+		Preload("Tags").
+		Preload("Categories").
+		Order("created_at ASC").
+		Find(&videos).Error
+	return videos, err
+}
+
+func (r *videoRepositoryImpl) BulkEdit(ctx context.Context, edit repositories.VideoBulkEdit) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// maker เดิมต้องคำนวณ video_count ใหม่ด้วย จึงเก็บไว้ก่อนเปลี่ยน
+		var makerIDs []uuid.UUID
+		if len(edit.MakerVideoIDs) > 0 {
+			if err := tx.Model(&models.Video{}).Where("id IN ? AND maker_id IS NOT NULL", edit.MakerVideoIDs).Distinct().Pluck("maker_id", &makerIDs).Error; err != nil {
+				return err
+			}
+			if err := tx.Model(&models.Video{}).Where("id IN ?", edit.MakerVideoIDs).Update("maker_id", edit.MakerID).Error; err != nil {
+				return err
+			}
+			if edit.MakerID != nil {
+				makerIDs = append(makerIDs, *edit.MakerID)
+			}
+		}
+
+		if len(edit.AutoTagVideoIDs) > 0 {
+			if err := tx.Model(&models.Video{}).Where("id IN ?", edit.AutoTagVideoIDs).Update("auto_tags", pq.StringArray(edit.AutoTags)).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(edit.SEOStatusVideoIDs) > 0 {
+			if err := tx.Model(&models.Video{}).Where("id IN ?", edit.SEOStatusVideoIDs).Update("seo_status", edit.SEOStatus).Error; err != nil {
+				return err
+			}
+		}
+
+		castIDs, err := applyVideoRelations(tx, "video_casts", "cast_id", edit.AddCasts, edit.RemoveCasts)
+		if err != nil {
+			return err
+		}
+		tagIDs, err := applyVideoRelations(tx, "video_tags", "tag_id", edit.AddTags, edit.RemoveTags)
+		if err != nil {
+			return err
+		}
+		categoryIDs, err := applyVideoRelations(tx, "video_categories", "category_id", edit.AddCategories, edit.RemoveCategories)
+		if err != nil {
+			return err
+		}
+
+		// join table ไม่แตะ videos.updated_at จึงต้องขยับเอง (catalog export แบบ incremental ใช้ค่านี้)
+		if videoIDs := relationVideoIDs(edit.AddCasts, edit.RemoveCasts, edit.AddTags, edit.RemoveTags, edit.AddCategories, edit.RemoveCategories); len(videoIDs) > 0 {
+			if err := tx.Model(&models.Video{}).Where("id IN ?", videoIDs).UpdateColumn("updated_at", gorm.Expr("NOW()")).Error; err != nil {
+				return err
+			}
+		}
+
+		return recountVideos(tx, makerIDs, castIDs, tagIDs, categoryIDs)
+	})
+}
+
+func relationVideoIDs(groups ...[]repositories.VideoRelation) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool)
+	var ids []uuid.UUID
+	for _, group := range groups {
+		for _, rel := range group {
+			if !seen[rel.VideoID] {
+				seen[rel.VideoID] = true
+				ids = append(ids, rel.VideoID)
+			}
+		}
+	}
+	return ids
+}
+
+// applyVideoRelations เพิ่ม/ลบแถวใน join table แล้วคืน id ฝั่ง related ที่ต้องคำนวณ video_count ใหม่
+func applyVideoRelations(tx *gorm.DB, table, column string, add, remove []repositories.VideoRelation) ([]uuid.UUID, error) {
+	touched := make(map[uuid.UUID]bool)
+
+	if len(add) > 0 {
+		rows := make([]map[string]interface{}, len(add))
+		for i, rel := range add {
+			rows[i] = map[string]interface{}{"video_id": rel.VideoID, column: rel.RelatedID}
+			touched[rel.RelatedID] = true
+		}
+		if err := tx.Table(table).Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(rows, 500).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	if len(remove) > 0 {
+		pairs := make([][]interface{}, len(remove))
+		for i, rel := range remove {
+			pairs[i] = []interface{}{rel.VideoID, rel.RelatedID}
+			touched[rel.RelatedID] = true
+		}
+		if err := tx.Table(table).Where("(video_id, "+column+") IN ?", pairs).Delete(nil).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	ids := make([]uuid.UUID, 0, len(touched))
+	for id := range touched {
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/repositories"
+)
+
+// ========================================
+// Faceted Video Search
+// ========================================
+
+// videoFacetSQL - facet ทั้งหมดใน query เดียว: filtered CTE ถูกคำนวณครั้งเดียวแล้ว group ต่อ facet
+// ชื่อ cast/tag/category ใช้ translation ของภาษาที่ขอ (ไม่มี = ชื่อหลัก)
+const videoFacetSQL = `WITH filtered AS (?)
+(SELECT 'maker' AS facet, makers.id::text AS value, makers.name AS name, makers.slug AS slug, COUNT(*) AS count
+	FROM filtered JOIN makers ON makers.id = filtered.maker_id
+	GROUP BY makers.id ORDER BY count DESC, makers.name LIMIT ?)
+UNION ALL
+(SELECT 'cast', casts.id::text, COALESCE(MAX(cast_translations.name), casts.name), casts.slug, COUNT(DISTINCT filtered.id) AS count
+	FROM filtered
+	JOIN video_casts ON video_casts.video_id = filtered.id
+	JOIN casts ON casts.id = video_casts.cast_id
+	LEFT JOIN cast_translations ON cast_translations.cast_id = casts.id AND cast_translations.lang = ?
+	GROUP BY casts.id ORDER BY count DESC, casts.name LIMIT ?)
+UNION ALL
+(SELECT 'tag', tags.id::text, COALESCE(MAX(tag_translations.name), tags.name), tags.slug, COUNT(DISTINCT filtered.id) AS count
+	FROM filtered
+	JOIN video_tags ON video_tags.video_id = filtered.id
+	JOIN tags ON tags.id = video_tags.tag_id
+	LEFT JOIN tag_translations ON tag_translations.tag_id = tags.id AND tag_translations.lang = ?
+	GROUP BY tags.id ORDER BY count DESC, tags.name LIMIT ?)
+UNION ALL
+(SELECT 'category', categories.id::text, COALESCE(MAX(category_translations.name), categories.name), categories.slug, COUNT(DISTINCT filtered.id) AS count
+	FROM filtered
+	JOIN video_categories ON video_categories.video_id = filtered.id
+	JOIN categories ON categories.id = video_categories.category_id
+	LEFT JOIN category_translations ON category_translations.category_id = categories.id AND category_translations.lang = ?
+	GROUP BY categories.id ORDER BY count DESC, categories.name LIMIT ?)
+UNION ALL
+(SELECT 'auto_tag', t.tag,
+		COALESCE(CASE ?::text WHEN 'th' THEN auto_tag_labels.name_th WHEN 'ja' THEN auto_tag_labels.name_ja END, auto_tag_labels.name_en, t.tag),
+		t.tag, COUNT(DISTINCT filtered.id) AS count
+	FROM filtered
+	CROSS JOIN LATERAL unnest(filtered.auto_tags) AS t(tag)
+	LEFT JOIN auto_tag_labels ON auto_tag_labels.key = t.tag
+	GROUP BY t.tag, auto_tag_labels.key ORDER BY count DESC, t.tag LIMIT ?)`
+
+func (r *videoRepositoryImpl) FacetedSearch(ctx context.Context, params repositories.VideoFacetParams) (*repositories.VideoFacetResult, error) {
+	db := r.db.WithContext(ctx)
+	result := &repositories.VideoFacetResult{}
+
+	query := r.applyFacetFilters(db.Model(&models.Video{}), params)
+	if err := query.Session(&gorm.Session{}).Count(&result.Total).Error; err != nil {
+		return nil, err
+	}
+
+	// Sort (เหมือน List)
+	orderBy := "created_at"
+	switch params.SortBy {
+	case "date":
+		orderBy = "release_date"
+	case "views":
+		orderBy = "views"
+	}
+	order := "DESC"
+	if strings.EqualFold(params.Order, "asc") {
+		order = "ASC"
+	}
+
+	err := query.Session(&gorm.Session{}).
+		Order(orderBy + " " + order + " NULLS LAST").
+		Order("id ASC").
+		Offset(params.Offset).
+		Limit(params.Limit).
+		Preload("Categories").Preload("Maker").Preload("Translations").Preload("Casts").Preload("Casts.Translations").
+		Find(&result.Videos).Error
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := r.applyFacetFilters(db.Model(&models.Video{}).Select("videos.id, videos.maker_id, videos.auto_tags"), params)
+	limit := params.FacetLimit
+	err = db.Raw(videoFacetSQL,
+		filtered,
+		limit,
+		params.Lang, limit,
+		params.Lang, limit,
+		params.Lang, limit,
+		params.Lang, limit,
+	).Scan(&result.Facets).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// applyFacetFilters - ใช้ทั้ง query หน้า video และ filtered CTE ของ facet
+func (r *videoRepositoryImpl) applyFacetFilters(query *gorm.DB, params repositories.VideoFacetParams) *gorm.DB {
+	if len(params.MakerIDs) > 0 {
+		query = query.Where("videos.maker_id IN ?", params.MakerIDs)
+	}
+	if len(params.CastIDs) > 0 {
+		query = whereRelation(query, "video_casts", "cast_id", params.CastIDs, params.CastMatchAll)
+	}
+	if len(params.TagIDs) > 0 {
+		query = whereRelation(query, "video_tags", "tag_id", params.TagIDs, params.TagMatchAll)
+	}
+	if len(params.CategoryIDs) > 0 {
+		query = whereRelation(query, "video_categories", "category_id", params.CategoryIDs, params.CategoryMatchAll)
+	}
+	if len(params.AutoTags) > 0 {
+		if params.AutoTagMatchAll {
+			query = query.Where("videos.auto_tags @> ?", pq.Array(params.AutoTags))
+		} else {
+			query = query.Where("videos.auto_tags && ?", pq.Array(params.AutoTags))
+		}
+	}
+	if params.ReleasedFrom != nil {
+		query = query.Where("videos.release_date >= ?", *params.ReleasedFrom)
+	}
+	if params.ReleasedTo != nil {
+		query = query.Where("videos.release_date <= ?", *params.ReleasedTo)
+	}
+
+	// Search ใน title (translations) หรือ code
+	if params.Search != "" {
+		subQuery := r.db.Model(&models.VideoTranslation{}).
+			Select("video_id").
+			Where("title ILIKE ?", "%"+params.Search+"%")
+		if params.Lang != "" {
+			subQuery = subQuery.Where("lang = ?", params.Lang)
+		}
+		query = query.Where("videos.id IN (?) OR videos.code ILIKE ?", subQuery, "%"+params.Search+"%")
+	}
+	return query
+}
+
+// whereRelation - filter ผ่าน join table (video_casts, video_tags, video_categories)
+// matchAll: ต้องมีครบทุก id, ไม่งั้นมี id ใดก็ได้
+func whereRelation(query *gorm.DB, table, column string, ids []uuid.UUID, matchAll bool) *gorm.DB {
+	match := "FROM " + table + " WHERE " + table + ".video_id = videos.id AND " + table + "." + column + " IN ?"
+	if matchAll {
+		return query.Where("(SELECT COUNT(DISTINCT "+table+"."+column+") "+match+") = ?", ids, len(ids))
+	}
+	return query.Where("EXISTS (SELECT 1 "+match+")", ids)
+}
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"gofiber-template/domain/models"
+)
+
+// ========================================
+// Ordered video membership (series_videos, collection_videos)
+// ========================================
+
+// videoCountSelect - นับสมาชิกตอน query แทนการเก็บ video_count (video เข้า/ออกถังขยะหรือถูก merge แล้วไม่ต้องคำนวณใหม่)
+func videoCountSelect(table, memberTable, ownerColumn string) string {
+	return table + ".*, (SELECT COUNT(*) FROM " + memberTable + " m JOIN videos ON videos.id = m.video_id AND videos.deleted_at IS NULL WHERE m." + ownerColumn + " = " + table + ".id) AS video_count"
+}
+
+// listOrderedVideos ดึง video ของ owner ตาม position (ไม่รวมถังขยะ, limit 0 = ทั้งหมด)
+func listOrderedVideos(db *gorm.DB, memberTable, ownerColumn string, ownerID uuid.UUID, limit, offset int) ([]models.Video, error) {
+	var videos []models.Video
+
+	query := db.Model(&models.Video{}).
+		Joins("JOIN "+memberTable+" m ON m.video_id = videos.id AND m."+ownerColumn+" = ?", ownerID).
+		Order("m.position ASC")
+	if limit > 0 {
+		query = query.Limit(limit).Offset(offset)
+	}
+
+	err := query.Preload("Categories").Preload("Maker").Preload("Translations").Preload("Casts").Preload("Casts.Translations").
+		Find(&videos).Error
+	return videos, err
+}
+
+// setOrderedVideos แทนที่สมาชิกของ owner ด้วย videoIDs ตามลำดับ (position เริ่มที่ 1)
+// ถ้ามี id ที่ไม่มีอยู่ (หรืออยู่ในถังขยะ) คืน gorm.ErrRecordNotFound โดยไม่แก้อะไร - videoIDs ต้องไม่ซ้ำกัน
+func setOrderedVideos(tx *gorm.DB, memberTable, ownerColumn string, ownerID uuid.UUID, videoIDs []uuid.UUID) error {
+	if len(videoIDs) > 0 {
+		var count int64
+		if err := tx.Model(&models.Video{}).Where("id IN ?", videoIDs).Count(&count).Error; err != nil {
+			return err
+		}
+		if int(count) != len(videoIDs) {
+			return gorm.ErrRecordNotFound
+		}
+	}
+
+	if err := tx.Table(memberTable).Where(ownerColumn+" = ?", ownerID).Delete(nil).Error; err != nil {
+		return err
+	}
+	if len(videoIDs) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	rows := make([]map[string]interface{}, len(videoIDs))
+	for i, id := range videoIDs {
+		rows[i] = map[string]interface{}{ownerColumn: ownerID, "video_id": id, "position": i + 1, "created_at": now}
+	}
+	return tx.Table(memberTable).CreateInBatches(rows, 500).Error
+}
@@ -0,0 +1,306 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"gofiber-template/domain/models"
+	"gofiber-template/domain/repositories"
+)
+
+// ========================================
+// Duplicate Detection / Merge
+// ========================================
+
+// duplicateGroupsCTE - จัดกลุ่ม video ซ้ำใน SQL
+// keys: key ของแต่ละเกณฑ์ normalize แบบเดียวกับ utils.NormalizeVideoCode / embed URL (ตัด scheme, www, query, / ท้าย) / thumbnail (ตัด / หน้า)
+// dup: key ที่มี video มากกว่า 1 ตัว, reach: video ที่ต่อถึงกันข้ามเกณฑ์ (A=B ด้วย code, B=C ด้วย embed → A,B,C)
+// members: group_key = id ที่น้อยที่สุดในกลุ่ม
+// ห้ามมีตัว ? ใน SQL (gorm มองเป็น placeholder) จึงใช้ (https|http) และ chr(63)
+const duplicateGroupsCTE = `WITH RECURSIVE keys AS (
+		SELECT id AS video_id, 'code' AS match,
+			regexp_replace(regexp_replace(upper(trim(code)), '[\s\-]+', ' ', 'g'), '^([A-Z]{2,6})\s*(\d{1,5})$', '\1-\2') AS key
+		FROM videos WHERE deleted_at IS NULL AND trim(COALESCE(code, '')) <> ''
+		UNION ALL
+		SELECT id, 'embed',
+			rtrim(regexp_replace(regexp_replace(regexp_replace(lower(trim(embed_url)), '^(https|http)://', ''), '^www\.', ''), '[' || chr(63) || '#].*$', ''), '/')
+		FROM videos WHERE deleted_at IS NULL AND trim(COALESCE(embed_url, '')) <> ''
+		UNION ALL
+		SELECT id, 'thumbnail', regexp_replace(trim(thumbnail), '^/', '')
+		FROM videos WHERE deleted_at IS NULL AND trim(COALESCE(thumbnail, '')) <> ''
+	),
+	dup AS (
+		SELECT video_id, match, key FROM (
+			SELECT keys.*, COUNT(*) OVER (PARTITION BY match, key) AS n FROM keys WHERE key <> '' AND (@match = '' OR match = @match)
+		) k WHERE n > 1
+	),
+	edges AS (
+		SELECT DISTINCT a.video_id AS src, b.video_id AS dst
+		FROM dup a JOIN dup b ON a.match = b.match AND a.key = b.key AND a.video_id <> b.video_id
+	),
+	reach (video_id, root) AS (
+		SELECT DISTINCT video_id, video_id FROM dup
+		UNION
+		SELECT e.dst, r.root FROM reach r JOIN edges e ON e.src = r.video_id
+	),
+	members AS (
+		SELECT video_id, MIN(root::text) AS group_key FROM reach GROUP BY video_id
+	),
+	dup_groups AS (
+		SELECT m.group_key, COUNT(*) AS size, MIN(v.created_at) AS first_created
+		FROM members m JOIN videos v ON v.id = m.video_id
+		GROUP BY m.group_key
+	)`
+
+// ListDuplicateGroups - กลุ่มใหญ่ก่อน แล้วเรียงตาม video ที่เก่าสุดของกลุ่ม (ให้ลำดับคงที่ระหว่างหน้า)
+func (r *videoRepositoryImpl) ListDuplicateGroups(ctx context.Context, match string, limit, offset int) ([]repositories.VideoDuplicateRow, int64, error) {
+	args := map[string]interface{}{"match": match, "limit": limit, "offset": offset}
+
+	var total int64
+	if err := r.db.WithContext(ctx).Raw(duplicateGroupsCTE+` SELECT COUNT(*) FROM dup_groups`, args).Scan(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if total == 0 {
+		return nil, 0, nil
+	}
+
+	var rows []repositories.VideoDuplicateRow
+	err := r.db.WithContext(ctx).Raw(duplicateGroupsCTE+`,
+		page AS (
+			SELECT group_key, size, first_created FROM dup_groups
+			ORDER BY size DESC, first_created, group_key
+			LIMIT @limit OFFSET @offset
+		)
+		SELECT page.group_key,
+			ARRAY(SELECT DISTINCT d.match FROM dup d WHERE d.video_id = videos.id) AS reasons,
+			videos.id, COALESCE(videos.code, '') AS code, COALESCE(videos.embed_url, '') AS embed_url, COALESCE(videos.thumbnail, '') AS thumbnail, videos.views, videos.has_reel, videos.created_at,
+			COALESCE(
+				(SELECT title FROM video_translations WHERE video_id = videos.id AND lang = 'en'),
+				(SELECT title FROM video_translations WHERE video_id = videos.id ORDER BY lang LIMIT 1),
+				'') AS title,
+			(SELECT COUNT(*) FROM video_translations WHERE video_id = videos.id) AS translations,
+			(SELECT COUNT(*) FROM articles WHERE video_id = videos.id AND deleted_at IS NULL) AS articles
+		FROM page
+		JOIN members ON members.group_key = page.group_key
+		JOIN videos ON videos.id = members.video_id
+		ORDER BY page.size DESC, page.first_created, page.group_key, videos.created_at, videos.id`, args).Scan(&rows).Error
+	return rows, total, err
+}
+
+func (r *videoRepositoryImpl) ArticleLanguageConflicts(ctx context.Context, ids []uuid.UUID) ([]string, error) {
+	var languages []string
+	err := r.db.WithContext(ctx).Model(&models.Article{}).
+		Where("video_id IN ? AND origin = ?", ids, models.ArticleOriginWorker).
+		Group("language").
+		Having("COUNT(*) > 1").
+		Order("language").
+		Pluck("language", &languages).Error
+	return languages, err
+}
+
+func (r *videoRepositoryImpl) MergeInto(ctx context.Context, targetID uuid.UUID, sourceIDs []uuid.UUID, mergedBy *uuid.UUID) (*repositories.VideoMergeResult, error) {
+	result := &repositories.VideoMergeResult{}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		allIDs := append([]uuid.UUID{targetID}, sourceIDs...)
+
+		// เก็บ id ที่ต้องคำนวณ video_count ใหม่ไว้ก่อนลบ source
+		var makerIDs, castIDs, tagIDs, categoryIDs []uuid.UUID
+		if err := tx.Model(&models.Video{}).Where("id IN ? AND maker_id IS NOT NULL", allIDs).Distinct().Pluck("maker_id", &makerIDs).Error; err != nil {
+			return err
+		}
+		if err := tx.Table("video_casts").Where("video_id IN ?", allIDs).Distinct().Pluck("cast_id", &castIDs).Error; err != nil {
+			return err
+		}
+		if err := tx.Table("video_tags").Where("video_id IN ?", allIDs).Distinct().Pluck("tag_id", &tagIDs).Error; err != nil {
+			return err
+		}
+		if err := tx.Table("video_categories").Where("video_id IN ?", allIDs).Distinct().Pluck("category_id", &categoryIDs).Error; err != nil {
+			return err
+		}
+
+		for _, sourceID := range sourceIDs {
+			if err := mergeVideo(tx, targetID, sourceID, mergedBy, result); err != nil {
+				return err
+			}
+		}
+
+		return recountVideos(tx, makerIDs, castIDs, tagIDs, categoryIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// mergeVideo ย้ายทุกอย่างของ source 1 ตัวไปที่ target แล้วลบ source
+// ข้อมูลที่ target มีอยู่แล้ว (translation ภาษาเดียวกัน, cast/tag ซ้ำ) จะใช้ของ target
+func mergeVideo(tx *gorm.DB, targetID, sourceID uuid.UUID, mergedBy *uuid.UUID, result *repositories.VideoMergeResult) error {
+	// Translations
+	res := tx.Exec(`UPDATE video_translations SET video_id = ?
+		WHERE video_id = ? AND lang NOT IN (SELECT lang FROM video_translations WHERE video_id = ?)`, targetID, sourceID, targetID)
+	if res.Error != nil {
+		return res.Error
+	}
+	result.Translations += res.RowsAffected
+	if err := tx.Exec("DELETE FROM video_translations WHERE video_id = ?", sourceID).Error; err != nil {
+		return err
+	}
+
+	// Many2many (video_casts, video_tags, video_categories)
+	for _, join := range []struct {
+		table  string
+		column string
+		count  *int64
+	}{
+		{"video_casts", "cast_id", &result.Casts},
+		{"video_tags", "tag_id", &result.Tags},
+		{"video_categories", "category_id", &result.Categories},
+	} {
+		res := tx.Exec(`INSERT INTO `+join.table+` (video_id, `+join.column+`)
+			SELECT ?, s.`+join.column+` FROM `+join.table+` s
+			WHERE s.video_id = ? AND NOT EXISTS (SELECT 1 FROM `+join.table+` t WHERE t.video_id = ? AND t.`+join.column+` = s.`+join.column+`)`,
+			targetID, sourceID, targetID)
+		if res.Error != nil {
+			return res.Error
+		}
+		*join.count += res.RowsAffected
+		if err := tx.Exec("DELETE FROM "+join.table+" WHERE video_id = ?", sourceID).Error; err != nil {
+			return err
+		}
+	}
+
+	// Reels (likes/comments/views ตาม reel ไปเอง)
+	res = tx.Exec("UPDATE reels SET video_id = ? WHERE video_id = ?", targetID, sourceID)
+	if res.Error != nil {
+		return res.Error
+	}
+	result.Reels += res.RowsAffected
+
+	// Articles (comments/likes ตามบทความไปเอง) - ภาษาที่ชนกันถูกกันไว้ใน service แล้ว
+	res = tx.Exec("UPDATE articles SET video_id = ? WHERE video_id = ?", targetID, sourceID)
+	if res.Error != nil {
+		return res.Error
+	}
+	result.Articles += res.RowsAffected
+
+	// video ที่บทความ ranking/best-of อ้างถึง
+	if err := tx.Exec(`UPDATE article_videos SET video_id = ?
+		WHERE video_id = ? AND NOT EXISTS (SELECT 1 FROM article_videos t WHERE t.article_id = article_videos.article_id AND t.video_id = ?)`,
+		targetID, sourceID, targetID).Error; err != nil {
+		return err
+	}
+	if err := tx.Exec("DELETE FROM article_videos WHERE video_id = ?", sourceID).Error; err != nil {
+		return err
+	}
+
+	// series (video อยู่ได้ series เดียว - ถ้า target มี series แล้วใช้ของ target) / collections
+	if err := tx.Exec(`UPDATE series_videos SET video_id = ?
+		WHERE video_id = ? AND NOT EXISTS (SELECT 1 FROM series_videos t WHERE t.video_id = ?)`,
+		targetID, sourceID, targetID).Error; err != nil {
+		return err
+	}
+	if err := tx.Exec("DELETE FROM series_videos WHERE video_id = ?", sourceID).Error; err != nil {
+		return err
+	}
+	if err := tx.Exec(`UPDATE collection_videos SET video_id = ?
+		WHERE video_id = ? AND NOT EXISTS (SELECT 1 FROM collection_videos t WHERE t.collection_id = collection_videos.collection_id AND t.video_id = ?)`,
+		targetID, sourceID, targetID).Error; err != nil {
+		return err
+	}
+	if err := tx.Exec("DELETE FROM collection_videos WHERE video_id = ?", sourceID).Error; err != nil {
+		return err
+	}
+
+	// คิว "needs article" (unique video + ภาษา)
+	if err := tx.Exec(`UPDATE article_queue_items SET video_id = ?
+		WHERE video_id = ? AND language NOT IN (SELECT language FROM article_queue_items WHERE video_id = ?)`,
+		targetID, sourceID, targetID).Error; err != nil {
+		return err
+	}
+	if err := tx.Exec("DELETE FROM article_queue_items WHERE video_id = ?", sourceID).Error; err != nil {
+		return err
+	}
+
+	// community chat ที่ mention video นี้
+	if err := tx.Exec("UPDATE chat_messages SET mentioned_video_id = ? WHERE mentioned_video_id = ?", targetID, sourceID).Error; err != nil {
+		return err
+	}
+
+	// ประวัติการเข้าชม (trending/popular pages นับรวมกัน)
+	if err := tx.Exec("UPDATE activity_logs SET page_id = ? WHERE page_type = ? AND page_id = ?", targetID, models.PageTypeVideo, sourceID).Error; err != nil {
+		return err
+	}
+
+	// เติม field ที่ target ยังว่างจาก source + รวมยอดวิว/auto tags
+	if err := tx.Exec(`UPDATE videos t SET
+			views = t.views + s.views,
+			code = COALESCE(NULLIF(t.code, ''), s.code),
+			thumbnail = COALESCE(NULLIF(t.thumbnail, ''), s.thumbnail),
+			source_url = COALESCE(NULLIF(t.source_url, ''), s.source_url),
+			embed_url = COALESCE(NULLIF(t.embed_url, ''), s.embed_url),
+			release_date = COALESCE(t.release_date, s.release_date),
+			maker_id = COALESCE(t.maker_id, s.maker_id),
+			auto_tags = ARRAY(SELECT DISTINCT unnest(COALESCE(t.auto_tags, '{}') || COALESCE(s.auto_tags, '{}'))),
+			reel_video_url = CASE WHEN t.has_reel THEN t.reel_video_url ELSE s.reel_video_url END,
+			reel_thumb_url = CASE WHEN t.has_reel THEN t.reel_thumb_url ELSE s.reel_thumb_url END,
+			reel_cover_url = CASE WHEN t.has_reel THEN t.reel_cover_url ELSE s.reel_cover_url END,
+			has_reel = t.has_reel OR s.has_reel,
+			updated_at = NOW()
+		FROM videos s
+		WHERE t.id = ? AND s.id = ?`, targetID, sourceID).Error; err != nil {
+		return err
+	}
+
+	// redirect เดิมที่ชี้มาที่ source → ชี้ไป target ก่อนลบ (ไม่ให้ถูก cascade ลบ และไม่เกิด redirect chain)
+	if err := tx.Model(&models.VideoRedirect{}).Where("to_video_id = ?", sourceID).Update("to_video_id", targetID).Error; err != nil {
+		return err
+	}
+
+	// ลบจริง (ไม่ผ่านถังขยะ) - ID เดิมกลายเป็น redirect
+	if err := tx.Unscoped().Delete(&models.Video{}, "id = ?", sourceID).Error; err != nil {
+		return err
+	}
+
+	return tx.Create(&models.VideoRedirect{
+		FromVideoID: sourceID,
+		ToVideoID:   targetID,
+		MergedBy:    mergedBy,
+	}).Error
+}
+
+// recountVideos คำนวณ video_count ใหม่จากข้อมูลจริง (ไม่นับ video ในถังขยะ)
+func recountVideos(tx *gorm.DB, makerIDs, castIDs, tagIDs, categoryIDs []uuid.UUID) error {
+	if len(makerIDs) > 0 {
+		if err := tx.Exec("UPDATE makers SET video_count = (SELECT COUNT(*) FROM videos WHERE videos.maker_id = makers.id AND videos.deleted_at IS NULL) WHERE id IN ?", makerIDs).Error; err != nil {
+			return err
+		}
+	}
+	if len(castIDs) > 0 {
+		if err := tx.Exec("UPDATE casts SET video_count = (SELECT COUNT(*) FROM video_casts JOIN videos ON videos.id = video_casts.video_id AND videos.deleted_at IS NULL WHERE video_casts.cast_id = casts.id) WHERE id IN ?", castIDs).Error; err != nil {
+			return err
+		}
+	}
+	if len(tagIDs) > 0 {
+		if err := tx.Exec("UPDATE tags SET video_count = (SELECT COUNT(*) FROM video_tags JOIN videos ON videos.id = video_tags.video_id AND videos.deleted_at IS NULL WHERE video_tags.tag_id = tags.id) WHERE id IN ?", tagIDs).Error; err != nil {
+			return err
+		}
+	}
+	if len(categoryIDs) > 0 {
+		if err := tx.Exec("UPDATE categories SET video_count = (SELECT COUNT(*) FROM video_categories JOIN videos ON videos.id = video_categories.video_id AND videos.deleted_at IS NULL WHERE video_categories.category_id = categories.id) WHERE id IN ?", categoryIDs).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *videoRepositoryImpl) GetRedirect(ctx context.Context, fromID uuid.UUID) (*models.VideoRedirect, error) {
+	var redirect models.VideoRedirect
+	err := r.db.WithContext(ctx).First(&redirect, "from_video_id = ?", fromID).Error
+	if err != nil {
+		return nil, err
+	}
+	return &redirect, nil
+}
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
@@ -37,8 +38,42 @@ func (r *videoRepositoryImpl) Update(ctx context.Context, video *models.Video) e
 	return r.db.WithContext(ctx).Save(video).Error
 }
 
+// Delete ย้าย video + บทความ worker/reels ที่ยังไม่ถูกลบเข้าถังขยะด้วย deleted_at เดียวกัน
+// (restore video จะคืนเฉพาะรายการที่ถูกลบพร้อมกัน)
+// บทความ generator (หลาย video) ไม่ถูกลบ แค่ soft-detach แถว article_videos ด้วย deleted_at เดียวกัน
 func (r *videoRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&models.Video{}, "id = ?", id).Error
+	now := time.Now()
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Article{}).Where("video_id = ? AND origin = ?", id, models.ArticleOriginWorker).UpdateColumn("deleted_at", now).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.ArticleVideo{}).Where("video_id = ? AND deleted_at IS NULL", id).UpdateColumn("deleted_at", now).Error; err != nil {
+			return err
+		}
+		if err := syncGeneratorPrimaryVideo(tx, id); err != nil {
+			return err
+		}
+		if err := tx.Model(&models.Reel{}).Where("video_id = ?", id).UpdateColumn("deleted_at", now).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.Video{}).Where("id = ?", id).UpdateColumn("deleted_at", now).Error
+	})
+}
+
+// syncGeneratorPrimaryVideo ตั้ง video หลักของบทความ generator ที่มี videoID อยู่ใน article_videos
+// = video ลำดับแรกที่ยังไม่ถูก detach (ตอนสร้าง generator ใช้ video ลำดับ 1 เป็น video หลัก)
+// เรียกหลัง detach/คืนแถว ทำให้ restore ได้ video หลักเดิมกลับมา
+func syncGeneratorPrimaryVideo(tx *gorm.DB, videoID uuid.UUID) error {
+	return tx.Exec(`
+		UPDATE articles SET video_id = (
+			SELECT av.video_id FROM article_videos av
+			WHERE av.article_id = articles.id AND av.deleted_at IS NULL
+			ORDER BY av.position LIMIT 1
+		)
+		WHERE origin = ?
+		AND id IN (SELECT article_id FROM article_videos WHERE video_id = ?)
+		AND EXISTS (SELECT 1 FROM article_videos av WHERE av.article_id = articles.id AND av.deleted_at IS NULL)
+	`, models.ArticleOriginGenerator, videoID).Error
 }
 
 func (r *videoRepositoryImpl) GetWithRelations(ctx context.Context, id uuid.UUID) (*models.Video, error) {
@@ -63,8 +98,71 @@ func (r *videoRepositoryImpl) List(ctx context.Context, params repositories.Vide
 	var videos []models.Video
 	var total int64
 
-	query := r.db.WithContext(ctx).Model(&models.Video{})
+	query := r.filterVideos(r.db.WithContext(ctx).Model(&models.Video{}), params)
+
+	// Count
+	query.Count(&total)
+
+	// Sort
+	orderBy := "created_at"
+	if params.SortBy != "" {
+		// Map sort field names to actual column names
+		switch params.SortBy {
+		case "date":
+			orderBy = "release_date"
+		case "created_at":
+			orderBy = "created_at"
+		case "views":
+			orderBy = "views"
+		default:
+			orderBy = "created_at"
+		}
+	}
+	order := "DESC"
+	if params.Order != "" {
+		order = strings.ToUpper(params.Order)
+	}
+	query = query.Order(orderBy + " " + order)
+
+	// Pagination
+	query = query.Offset(params.Offset).Limit(params.Limit)
 
+	// Preload relations
+	query = query.Preload("Categories").Preload("Maker").Preload("Translations").Preload("Casts").Preload("Casts.Translations")
+
+	err := query.Find(&videos).Error
+	return videos, total, err
+}
+
+func (r *videoRepositoryImpl) ListAfter(ctx context.Context, params repositories.VideoListParams, after *repositories.Keyset) ([]models.Video, error) {
+	var videos []models.Video
+
+	query := r.filterVideos(r.db.WithContext(ctx).Model(&models.Video{}), params)
+
+	// sort key ต้องไม่เป็น NULL ไม่งั้นเทียบ (sort, id) ไม่ได้ จึงแทน release_date ว่างด้วย VideoNoReleaseDate
+	sortExpr := "videos.created_at"
+	switch params.SortBy {
+	case "date":
+		sortExpr = "COALESCE(videos.release_date, DATE '" + repositories.VideoNoReleaseDate.Format("2006-01-02") + "')"
+		if after != nil {
+			// ส่งเป็น string ให้ Postgres ตีความเป็น date (time.Time จะกลายเป็น timestamptz ตาม timezone ของ session)
+			if t, ok := after.Value.(time.Time); ok {
+				after = &repositories.Keyset{Value: t.Format("2006-01-02"), ID: after.ID}
+			}
+		}
+	case "views":
+		sortExpr = "videos.views"
+	}
+
+	query = keysetPage(query, sortExpr, "videos.id", !strings.EqualFold(params.Order, "asc"), after, params.Limit)
+
+	err := query.Preload("Categories").Preload("Maker").Preload("Translations").Preload("Casts").Preload("Casts.Translations").
+		Find(&videos).Error
+	return videos, err
+}
+
+// filterVideos ใส่ filter ของ VideoListParams (ใช้ร่วมกับ List และ ListIDs ของ bulk edit)
+func (r *videoRepositoryImpl) filterVideos(query *gorm.DB, params repositories.VideoListParams) *gorm.DB {
 	// Filters
 	if params.Category != "" {
 		// Filter by category slug using many2many join table
@@ -77,6 +175,12 @@ func (r *videoRepositoryImpl) List(ctx context.Context, params repositories.Vide
 	if params.MakerID != nil {
 		query = query.Where("maker_id = ?", params.MakerID)
 	}
+	if params.CastID != nil {
+		query = query.Where("id IN (?)", r.db.Table("video_casts").Select("video_id").Where("cast_id = ?", params.CastID))
+	}
+	if params.TagID != nil {
+		query = query.Where("id IN (?)", r.db.Table("video_tags").Select("video_id").Where("tag_id = ?", params.TagID))
+	}
 	if len(params.AutoTags) > 0 {
 		query = query.Where("auto_tags && ?", pq.Array(params.AutoTags))
 	}
@@ -93,45 +197,16 @@ func (r *videoRepositoryImpl) List(ctx context.Context, params repositories.Vide
 		query = query.Where("id IN (?) OR code ILIKE ?", subQuery, "%"+params.Search+"%")
 	}
 
-	// Filter videos without Thai title
-	if params.MissingTh {
+	// Filter videos without title in MissingLang
+	if params.MissingLang != "" {
 		subQuery := r.db.Model(&models.VideoTranslation{}).
 			Select("video_id").
-			Where("lang = 'th'").
+			Where("lang = ?", params.MissingLang).
 			Where("title IS NOT NULL AND title != ''")
 		query = query.Where("id NOT IN (?)", subQuery)
 	}
 
-	// Count
-	query.Count(&total)
-
-	// Sort
-	orderBy := "created_at"
-	if params.SortBy != "" {
-		// Map sort field names to actual column names
-		switch params.SortBy {
-		case "date":
-			orderBy = "release_date"
-		case "created_at":
-			orderBy = "created_at"
-		default:
-			orderBy = "created_at"
-		}
-	}
-	order := "DESC"
-	if params.Order != "" {
-		order = strings.ToUpper(params.Order)
-	}
-	query = query.Order(orderBy + " " + order)
-
-	// Pagination
-	query = query.Offset(params.Offset).Limit(params.Limit)
-
-	// Preload relations
-	query = query.Preload("Categories").Preload("Maker").Preload("Translations").Preload("Casts").Preload("Casts.Translations")
-
-	err := query.Find(&videos).Error
-	return videos, total, err
+	return query
 }
 
 func (r *videoRepositoryImpl) CreateTranslation(ctx context.Context, trans *models.VideoTranslation) error {
@@ -403,3 +478,7 @@ func (r *videoRepositoryImpl) GetTitlesByIDs(ctx context.Context, ids []uuid.UUI
 
 	return titleMap, nil
 }
+
+func (r *videoRepositoryImpl) IncrementViews(ctx context.Context, counts map[uuid.UUID]int64) error {
+	return incrementViewColumn(ctx, r.db, "videos", "views", counts)
+}
@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// viewCountUpdateChunk - จำนวน row ต่อ 1 UPDATE statement
+const viewCountUpdateChunk = 1000
+
+// incrementViewColumn บวกค่าใน column ตาม map[id]delta ใน transaction เดียว
+// id ที่ไม่มีใน table จะถูกข้ามไปเอง (UPDATE ไม่ match)
+func incrementViewColumn(ctx context.Context, db *gorm.DB, table string, column string, counts map[uuid.UUID]int64) error {
+	if len(counts) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(counts))
+	deltas := make([]int64, 0, len(counts))
+	for id, delta := range counts {
+		ids = append(ids, id.String())
+		deltas = append(deltas, delta)
+	}
+
+	sql := fmt.Sprintf(`
+		UPDATE %[1]s AS t
+		SET %[2]s = t.%[2]s + v.delta
+		FROM (SELECT unnest(?::uuid[]) AS id, unnest(?::bigint[]) AS delta) AS v
+		WHERE t.id = v.id`, table, column)
+
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(ids); start += viewCountUpdateChunk {
+			end := start + viewCountUpdateChunk
+			if end > len(ids) {
+				end = len(ids)
+			}
+			if err := tx.Exec(sql, pq.Array(ids[start:end]), pq.Array(deltas[start:end])).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
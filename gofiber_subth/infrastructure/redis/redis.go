@@ -73,6 +73,28 @@ func (r *RedisClient) TTL(ctx context.Context, key string) (time.Duration, error
 	return r.client.TTL(ctx, key).Result()
 }
 
+// SetAdd เพิ่ม members เข้า set และต่ออายุ key
+func (r *RedisClient) SetAdd(ctx context.Context, key string, expiration time.Duration, members ...string) error {
+	if len(members) == 0 {
+		return nil
+	}
+	values := make([]interface{}, len(members))
+	for i, m := range members {
+		values[i] = m
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.SAdd(ctx, key, values...)
+	pipe.Expire(ctx, key, expiration)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// SetMembers returns ทุก member ใน set (key ไม่มี = slice ว่าง)
+func (r *RedisClient) SetMembers(ctx context.Context, key string) ([]string, error) {
+	return r.client.SMembers(ctx, key).Result()
+}
+
 func (r *RedisClient) Ping(ctx context.Context) error {
 	return r.client.Ping(ctx).Err()
 }
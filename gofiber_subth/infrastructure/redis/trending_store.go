@@ -0,0 +1,109 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// TrendingPrefix - sorted set ของคะแนน trending (trending:kind:window:lang, member = id)
+	TrendingPrefix = "trending:"
+	// TrendingUpdatedAtKey - เวลาที่คำนวณรอบล่าสุดเสร็จ (unix)
+	TrendingUpdatedAtKey = "trending_meta:updated_at"
+)
+
+// TrendingEntry - 1 member ใน sorted set
+type TrendingEntry struct {
+	ID    uuid.UUID
+	Score float64
+}
+
+// TrendingStore - เก็บอันดับ trending ที่คำนวณแล้วใน Redis sorted set
+type TrendingStore struct {
+	client *redis.Client
+}
+
+func NewTrendingStore(redisClient *RedisClient) *TrendingStore {
+	return &TrendingStore{
+		client: redisClient.client,
+	}
+}
+
+// GetKey สร้าง key ของ sorted set
+func (t *TrendingStore) GetKey(kind, window, lang string) string {
+	return fmt.Sprintf("%s%s:%s:%s", TrendingPrefix, kind, window, lang)
+}
+
+// Replace แทนที่อันดับทั้งชุดแบบ atomic (เขียนลง key ชั่วคราวแล้ว RENAME ทับ)
+// ผู้อ่านจะเห็นชุดเก่าหรือชุดใหม่ทั้งชุด ไม่เห็นครึ่งๆ
+func (t *TrendingStore) Replace(ctx context.Context, key string, entries []TrendingEntry, ttl time.Duration) error {
+	if len(entries) == 0 {
+		return t.client.Del(ctx, key).Err()
+	}
+
+	members := make([]redis.Z, len(entries))
+	for i, e := range entries {
+		members[i] = redis.Z{Score: e.Score, Member: e.ID.String()}
+	}
+
+	buildingKey := key + ":building"
+	_, err := t.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, buildingKey)
+		pipe.ZAdd(ctx, buildingKey, members...)
+		pipe.Expire(ctx, buildingKey, ttl)
+		pipe.Rename(ctx, buildingKey, key)
+		return nil
+	})
+	return err
+}
+
+// Top คืน member ตามคะแนนมากก่อน + จำนวนทั้งหมดใน set
+func (t *TrendingStore) Top(ctx context.Context, key string, offset, limit int) ([]TrendingEntry, int64, error) {
+	total, err := t.client.ZCard(ctx, key).Result()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	raw, err := t.client.ZRevRangeWithScores(ctx, key, int64(offset), int64(offset+limit-1)).Result()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	entries := make([]TrendingEntry, 0, len(raw))
+	for _, z := range raw {
+		member, ok := z.Member.(string)
+		if !ok {
+			continue
+		}
+		id, err := uuid.Parse(member)
+		if err != nil {
+			continue // Skip invalid members
+		}
+		entries = append(entries, TrendingEntry{ID: id, Score: z.Score})
+	}
+
+	return entries, total, nil
+}
+
+// MarkUpdated บันทึกเวลาที่คำนวณเสร็จ
+func (t *TrendingStore) MarkUpdated(ctx context.Context, at time.Time, ttl time.Duration) error {
+	return t.client.Set(ctx, TrendingUpdatedAtKey, at.Unix(), ttl).Err()
+}
+
+// UpdatedAt คืนเวลาที่คำนวณรอบล่าสุด (nil = ยังไม่เคยคำนวณหรือหมดอายุ)
+func (t *TrendingStore) UpdatedAt(ctx context.Context) (*time.Time, error) {
+	unix, err := t.client.Get(ctx, TrendingUpdatedAtKey).Int64()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	at := time.Unix(unix, 0)
+	return &at, nil
+}
@@ -0,0 +1,117 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gofiber-template/pkg/logger"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// ViewCountsPrefix - hash ของยอดวิวที่ยังไม่ flush (field = id, value = จำนวน)
+	ViewCountsPrefix = "view_counts:"
+	// ViewFlushingSuffix - hash ที่ถูกย้ายออกมาระหว่าง flush (ยังไม่ลง DB)
+	ViewFlushingSuffix = ":flushing"
+	// ViewDedupPrefix - key กันนับซ้ำ (target:id:visitor)
+	ViewDedupPrefix = "view_dedup:"
+)
+
+// View targets
+const (
+	ViewTargetArticle = "article"
+	ViewTargetVideo   = "video"
+)
+
+// ViewCounter - buffer ยอดวิวใน Redis ก่อน flush ลง Postgres เป็น batch
+type ViewCounter struct {
+	client *redis.Client
+}
+
+func NewViewCounter(redisClient *RedisClient) *ViewCounter {
+	return &ViewCounter{
+		client: redisClient.client,
+	}
+}
+
+// ========== Deduplication ==========
+
+// GetDedupKey สร้าง key สำหรับกันนับซ้ำ (target:id:visitor)
+func (v *ViewCounter) GetDedupKey(target string, id uuid.UUID, visitorID string) string {
+	return fmt.Sprintf("%s%s:%s:%s", ViewDedupPrefix, target, id.String(), visitorID)
+}
+
+// MarkViewed ตั้ง dedup key ถ้ายังไม่มี
+// returns: true ถ้าเป็นการดูครั้งแรกใน window (ควรนับ), false ถ้าเพิ่งนับไปแล้ว
+func (v *ViewCounter) MarkViewed(ctx context.Context, key string, window time.Duration) bool {
+	ok, err := v.client.SetNX(ctx, key, "1", window).Result()
+	if err != nil {
+		logger.WarnContext(ctx, "Failed to set view dedup key", "error", err, "key", key)
+		return true // Count on error
+	}
+	return ok
+}
+
+// ========== Counters ==========
+
+// Increment เพิ่มยอดวิวที่รอ flush ของ id นี้
+func (v *ViewCounter) Increment(ctx context.Context, target string, id uuid.UUID) error {
+	return v.client.HIncrBy(ctx, ViewCountsPrefix+target, id.String(), 1).Err()
+}
+
+// Drain ย้ายยอดวิวที่รอ flush ออกมาเป็น hash แยก แล้วคืนค่าทั้งหมด
+// ยอดที่เข้ามาระหว่าง flush จะไปลง hash ใหม่ ไม่หาย
+// ถ้ารอบก่อน flush ไม่สำเร็จ (ยังมี hash flushing ค้าง) จะคืนของเดิมก่อนเพื่อไม่ให้นับซ้ำ/หาย
+// ต้องเรียก Ack หลังเขียน DB สำเร็จ
+func (v *ViewCounter) Drain(ctx context.Context, target string) (map[uuid.UUID]int64, error) {
+	key := ViewCountsPrefix + target
+	flushingKey := key + ViewFlushingSuffix
+
+	exists, err := v.client.Exists(ctx, flushingKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	if exists == 0 {
+		if err := v.client.Rename(ctx, key, flushingKey).Err(); err != nil {
+			if strings.Contains(err.Error(), "no such key") {
+				return map[uuid.UUID]int64{}, nil
+			}
+			return nil, err
+		}
+	}
+
+	raw, err := v.client.HGetAll(ctx, flushingKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[uuid.UUID]int64, len(raw))
+	for field, value := range raw {
+		id, err := uuid.Parse(field)
+		if err != nil {
+			continue // Skip invalid fields
+		}
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || n <= 0 {
+			continue
+		}
+		counts[id] = n
+	}
+
+	return counts, nil
+}
+
+// Ack ลบ hash flushing หลังเขียน DB สำเร็จ
+func (v *ViewCounter) Ack(ctx context.Context, target string) error {
+	return v.client.Del(ctx, ViewCountsPrefix+target+ViewFlushingSuffix).Err()
+}
+
+// Pending คืนจำนวน id ที่มียอดวิวรอ flush
+func (v *ViewCounter) Pending(ctx context.Context, target string) (int64, error) {
+	return v.client.HLen(ctx, ViewCountsPrefix+target).Result()
+}
@@ -151,6 +151,21 @@ func (r *R2Adapter) GetURL(path string) string {
 	return fmt.Sprintf("%s/%s", r.publicURL, path)
 }
 
+// GetFile implements ports.Storage
+func (r *R2Adapter) GetFile(ctx context.Context, path string) (io.ReadCloser, error) {
+	path = strings.TrimPrefix(path, "/")
+
+	result, err := r.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(r.bucket),
+		Key:    aws.String(path),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("R2 get file failed: %w", err)
+	}
+
+	return result.Body, nil
+}
+
 // Exists implements ports.Storage
 func (r *R2Adapter) Exists(ctx context.Context, path string) (bool, error) {
 	path = strings.TrimPrefix(path, "/")
@@ -76,6 +76,7 @@ func (h *ActivityLogHandler) LogActivity(c *fiber.Ctx) error {
 // @Produce json
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
+// @Param cursor query string false "Opaque cursor from meta.nextCursor (empty = first page); switches to cursor pagination"
 // @Success 200 {object} utils.PaginatedResponse
 // @Failure 401 {object} utils.ErrorResponse
 // @Router /activity/me [get]
@@ -100,6 +101,19 @@ func (h *ActivityLogHandler) GetMyHistory(c *fiber.Ctx) error {
 		limit = 20
 	}
 
+	// ?cursor= → keyset pagination (page/limit เดิมยังใช้ได้)
+	if utils.UseCursor(c) {
+		logs, nextCursor, err := h.service.GetUserHistoryAfter(ctx, userID, c.Query("cursor"), limit)
+		if err != nil {
+			if err == utils.ErrInvalidCursor {
+				return utils.BadRequestResponse(c, "Invalid cursor")
+			}
+			logger.ErrorContext(ctx, "Failed to get activity history", "error", err, "user_id", userID)
+			return utils.InternalServerErrorResponse(c)
+		}
+		return utils.CursorPaginatedSuccessResponse(c, logs, nextCursor, limit)
+	}
+
 	// Get history
 	logs, total, err := h.service.GetUserHistory(ctx, userID, page, limit)
 	if err != nil {
@@ -158,6 +172,7 @@ func (h *ActivityLogHandler) GetPageViews(c *fiber.Ctx) error {
 // @Produce json
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
+// @Param cursor query string false "Opaque cursor from meta.nextCursor (empty = first page); switches to cursor pagination"
 // @Param pageType query string false "Filter by page type"
 // @Success 200 {object} utils.PaginatedResponse
 // @Failure 401 {object} utils.ErrorResponse
@@ -177,6 +192,19 @@ func (h *ActivityLogHandler) GetAllActivity(c *fiber.Ctx) error {
 		limit = 20
 	}
 
+	// ?cursor= → keyset pagination (page/limit เดิมยังใช้ได้)
+	if utils.UseCursor(c) {
+		logs, nextCursor, err := h.service.GetAllActivityAfter(ctx, pageType, c.Query("cursor"), limit)
+		if err != nil {
+			if err == utils.ErrInvalidCursor {
+				return utils.BadRequestResponse(c, "Invalid cursor")
+			}
+			logger.ErrorContext(ctx, "Failed to get all activity logs", "error", err)
+			return utils.InternalServerErrorResponse(c)
+		}
+		return utils.CursorPaginatedSuccessResponse(c, logs, nextCursor, limit)
+	}
+
 	// Get all activity logs
 	logs, total, err := h.service.GetAllActivity(ctx, pageType, page, limit)
 	if err != nil {
@@ -195,6 +223,7 @@ func (h *ActivityLogHandler) GetAllActivity(c *fiber.Ctx) error {
 // @Param id path string true "User ID"
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
+// @Param cursor query string false "Opaque cursor from meta.nextCursor (empty = first page); switches to cursor pagination"
 // @Success 200 {object} utils.PaginatedResponse
 // @Failure 401 {object} utils.ErrorResponse
 // @Router /users/{id}/activity [get]
@@ -219,6 +248,19 @@ func (h *ActivityLogHandler) GetUserActivity(c *fiber.Ctx) error {
 		limit = 20
 	}
 
+	// ?cursor= → keyset pagination (page/limit เดิมยังใช้ได้)
+	if utils.UseCursor(c) {
+		logs, nextCursor, err := h.service.GetUserHistoryAfter(ctx, userID, c.Query("cursor"), limit)
+		if err != nil {
+			if err == utils.ErrInvalidCursor {
+				return utils.BadRequestResponse(c, "Invalid cursor")
+			}
+			logger.ErrorContext(ctx, "Failed to get user activity logs", "error", err, "user_id", userID)
+			return utils.InternalServerErrorResponse(c)
+		}
+		return utils.CursorPaginatedSuccessResponse(c, logs, nextCursor, limit)
+	}
+
 	// Get user activity logs
 	logs, total, err := h.service.GetUserHistory(ctx, userID, page, limit)
 	if err != nil {
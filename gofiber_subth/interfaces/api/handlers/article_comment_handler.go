@@ -86,6 +86,7 @@ func (h *ArticleCommentHandler) Create(c *fiber.Ctx) error {
 // @Param id path string true "Article ID"
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
+// @Param cursor query string false "Opaque cursor from meta.nextCursor (empty = first page); switches to cursor pagination"
 // @Success 200 {object} utils.PaginatedResponse
 // @Failure 400 {object} utils.ErrorResponse
 // @Router /articles/{id}/comments [get]
@@ -108,6 +109,18 @@ func (h *ArticleCommentHandler) List(c *fiber.Ctx) error {
 		limit = 20
 	}
 
+	if utils.UseCursor(c) {
+		comments, nextCursor, err := h.service.ListByArticleAfter(ctx, articleID, c.Query("cursor"), limit)
+		if err != nil {
+			if err == utils.ErrInvalidCursor {
+				return utils.BadRequestResponse(c, "Invalid cursor")
+			}
+			logger.ErrorContext(ctx, "Failed to list article comments", "error", err, "article_id", articleID)
+			return utils.InternalServerErrorResponse(c)
+		}
+		return utils.CursorPaginatedSuccessResponse(c, comments, nextCursor, limit)
+	}
+
 	comments, total, err := h.service.ListByArticle(ctx, articleID, page, limit)
 	if err != nil {
 		logger.ErrorContext(ctx, "Failed to list article comments", "error", err, "article_id", articleID)
@@ -0,0 +1,247 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/services"
+	"gofiber-template/pkg/logger"
+	"gofiber-template/pkg/utils"
+)
+
+type ArticleCoverageHandler struct {
+	service services.ArticleCoverageService
+}
+
+func NewArticleCoverageHandler(service services.ArticleCoverageService) *ArticleCoverageHandler {
+	return &ArticleCoverageHandler{service: service}
+}
+
+// coverageFilterError - slug ของ filter ไม่พบ
+func coverageFilterError(c *fiber.Ctx, err error) (bool, error) {
+	switch err.Error() {
+	case "maker not found":
+		return true, utils.NotFoundResponse(c, "Maker not found")
+	case "cast not found":
+		return true, utils.NotFoundResponse(c, "Cast not found")
+	case "category not found":
+		return true, utils.NotFoundResponse(c, "Category not found")
+	}
+	return false, nil
+}
+
+// GetCoverage รายงานบทความที่ยังขาด (Admin)
+// GET /api/v1/articles/coverage?maker=&cast=&category=&seo_status=
+// @Summary Article coverage report
+// @Description จำนวน video ที่มี/ไม่มีบทความต่อภาษาและ type + cast/maker ที่ยังไม่มีบทความรวม
+// @Tags Articles
+// @Produce json
+// @Success 200 {object} utils.Response{data=dto.ArticleCoverageResponse}
+// @Failure 404 {object} utils.ErrorResponse
+// @Router /articles/coverage [get]
+func (h *ArticleCoverageHandler) GetCoverage(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var params dto.ArticleCoverageParams
+	if err := c.QueryParser(&params); err != nil {
+		logger.WarnContext(ctx, "Invalid query parameters", "error", err)
+		return utils.BadRequestResponse(c, "Invalid query parameters")
+	}
+
+	if err := utils.ValidateStruct(&params); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	report, err := h.service.GetCoverage(ctx, &params)
+	if err != nil {
+		if handled, resp := coverageFilterError(c, err); handled {
+			return resp
+		}
+		logger.ErrorContext(ctx, "Failed to get article coverage", "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, report)
+}
+
+// ListMissingVideos video ที่ยังไม่มีบทความในภาษาที่เลือก (Admin)
+// GET /api/v1/articles/coverage/missing?lang=th&maker=&cast=&category=&seo_status=
+func (h *ArticleCoverageHandler) ListMissingVideos(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var params dto.ArticleCoverageMissingParams
+	if err := c.QueryParser(&params); err != nil {
+		logger.WarnContext(ctx, "Invalid query parameters", "error", err)
+		return utils.BadRequestResponse(c, "Invalid query parameters")
+	}
+
+	if err := utils.ValidateStruct(&params); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	videos, total, err := h.service.ListMissingVideos(ctx, &params)
+	if err != nil {
+		if handled, resp := coverageFilterError(c, err); handled {
+			return resp
+		}
+		logger.ErrorContext(ctx, "Failed to list videos missing articles", "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.PaginatedSuccessResponse(c, videos, total, params.Page, params.Limit)
+}
+
+// EnqueueMissing เพิ่ม video ที่ยังไม่มีบทความเข้าคิว (Admin)
+// POST /api/v1/articles/coverage/enqueue
+func (h *ArticleCoverageHandler) EnqueueMissing(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.EnqueueCoverageRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			logger.WarnContext(ctx, "Invalid request body", "error", err)
+			return utils.BadRequestResponse(c, "Invalid request body")
+		}
+	}
+
+	if err := utils.ValidateStruct(&req); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	result, err := h.service.EnqueueMissing(ctx, &req)
+	if err != nil {
+		if handled, resp := coverageFilterError(c, err); handled {
+			return resp
+		}
+		logger.ErrorContext(ctx, "Failed to enqueue videos missing articles", "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, result)
+}
+
+// ListQueue รายการในคิว "needs article" (Admin)
+// GET /api/v1/articles/queue?status=pending&lang=th
+func (h *ArticleCoverageHandler) ListQueue(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var params dto.ArticleQueueListParams
+	if err := c.QueryParser(&params); err != nil {
+		logger.WarnContext(ctx, "Invalid query parameters", "error", err)
+		return utils.BadRequestResponse(c, "Invalid query parameters")
+	}
+
+	if err := utils.ValidateStruct(&params); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	items, total, err := h.service.ListQueue(ctx, &params)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list article queue", "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.PaginatedSuccessResponse(c, items, total, params.Page, params.Limit)
+}
+
+// ClaimQueueItems worker รับงานจากคิว (Internal)
+// POST /api/v1/articles/queue/claim
+// lease หมดอายุโดยไม่ ingest = งานกลับเข้าคิวให้ worker อื่นรับ
+func (h *ArticleCoverageHandler) ClaimQueueItems(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.ClaimArticleQueueRequest
+	if err := c.BodyParser(&req); err != nil {
+		logger.WarnContext(ctx, "Invalid request body", "error", err)
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+
+	if err := utils.ValidateStruct(&req); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	items, err := h.service.ClaimQueueItems(ctx, &req)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to claim article queue items", "worker_id", req.WorkerID, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, items)
+}
+
+// ExtendLease ต่อเวลา lease (Internal)
+// POST /api/v1/articles/queue/:id/extend
+func (h *ArticleCoverageHandler) ExtendLease(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, req, resp := h.parseLeaseRequest(c)
+	if req == nil {
+		return resp
+	}
+
+	item, err := h.service.ExtendLease(ctx, id, req)
+	if err != nil {
+		if err.Error() == "lease not found" {
+			return utils.ConflictResponse(c, "Lease expired or not held by this worker")
+		}
+		logger.ErrorContext(ctx, "Failed to extend article queue lease", "item_id", id, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, item)
+}
+
+// ReleaseLease คืนงานเข้าคิว (Internal) เช่น worker เขียนไม่สำเร็จ
+// POST /api/v1/articles/queue/:id/release
+func (h *ArticleCoverageHandler) ReleaseLease(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, req, resp := h.parseLeaseRequest(c)
+	if req == nil {
+		return resp
+	}
+
+	if err := h.service.ReleaseLease(ctx, id, req); err != nil {
+		if err.Error() == "lease not found" {
+			return utils.ConflictResponse(c, "Lease expired or not held by this worker")
+		}
+		logger.ErrorContext(ctx, "Failed to release article queue lease", "item_id", id, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, fiber.Map{"message": "Lease released"})
+}
+
+// parseLeaseRequest - req = nil เมื่อ request ไม่ถูกต้อง (resp คือ error response ที่ส่งไปแล้ว)
+func (h *ArticleCoverageHandler) parseLeaseRequest(c *fiber.Ctx) (uuid.UUID, *dto.ArticleQueueLeaseRequest, error) {
+	ctx := c.UserContext()
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return uuid.Nil, nil, utils.BadRequestResponse(c, "Invalid queue item ID")
+	}
+
+	var req dto.ArticleQueueLeaseRequest
+	if err := c.BodyParser(&req); err != nil {
+		logger.WarnContext(ctx, "Invalid request body", "error", err)
+		return uuid.Nil, nil, utils.BadRequestResponse(c, "Invalid request body")
+	}
+
+	if err := utils.ValidateStruct(&req); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return uuid.Nil, nil, utils.ValidationErrorResponse(c, errors)
+	}
+
+	return id, &req, nil
+}
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/services"
+	"gofiber-template/pkg/logger"
+	"gofiber-template/pkg/utils"
+)
+
+// feedContentTypes - Content-Type ตาม format
+var feedContentTypes = map[string]string{
+	dto.ArticleFeedRSS:  "application/rss+xml; charset=utf-8",
+	dto.ArticleFeedAtom: "application/atom+xml; charset=utf-8",
+}
+
+type ArticleFeedHandler struct {
+	feedService services.ArticleFeedService
+}
+
+func NewArticleFeedHandler(feedService services.ArticleFeedService) *ArticleFeedHandler {
+	return &ArticleFeedHandler{
+		feedService: feedService,
+	}
+}
+
+// GetLatestFeed - RSS/Atom ของบทความล่าสุด (Public)
+// GET /api/v1/articles/feed/:format?type=review&lang=th
+func (h *ArticleFeedHandler) GetLatestFeed(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var params dto.ArticleFeedParams
+	if err := c.QueryParser(&params); err != nil {
+		logger.WarnContext(ctx, "Invalid query parameters", "error", err)
+		return utils.BadRequestResponse(c, "Invalid query parameters")
+	}
+
+	feed, err := h.feedService.GetLatestFeed(ctx, c.Params("format"), &params)
+	return h.sendFeed(c, feed, err)
+}
+
+// GetCastFeed - RSS/Atom ของบทความตามนักแสดง (Public)
+// GET /api/v1/articles/cast/:slug/feed/:format
+func (h *ArticleFeedHandler) GetCastFeed(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var params dto.ArticleFeedParams
+	if err := c.QueryParser(&params); err != nil {
+		logger.WarnContext(ctx, "Invalid query parameters", "error", err)
+		return utils.BadRequestResponse(c, "Invalid query parameters")
+	}
+
+	feed, err := h.feedService.GetCastFeed(ctx, c.Params("slug"), c.Params("format"), &params)
+	return h.sendFeed(c, feed, err)
+}
+
+// GetTagFeed - RSS/Atom ของบทความตาม Tag (Public)
+// GET /api/v1/articles/tag/:slug/feed/:format
+func (h *ArticleFeedHandler) GetTagFeed(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var params dto.ArticleFeedParams
+	if err := c.QueryParser(&params); err != nil {
+		logger.WarnContext(ctx, "Invalid query parameters", "error", err)
+		return utils.BadRequestResponse(c, "Invalid query parameters")
+	}
+
+	feed, err := h.feedService.GetTagFeed(ctx, c.Params("slug"), c.Params("format"), &params)
+	return h.sendFeed(c, feed, err)
+}
+
+// GetMakerFeed - RSS/Atom ของบทความตามค่าย (Public)
+// GET /api/v1/articles/maker/:slug/feed/:format
+func (h *ArticleFeedHandler) GetMakerFeed(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var params dto.ArticleFeedParams
+	if err := c.QueryParser(&params); err != nil {
+		logger.WarnContext(ctx, "Invalid query parameters", "error", err)
+		return utils.BadRequestResponse(c, "Invalid query parameters")
+	}
+
+	feed, err := h.feedService.GetMakerFeed(ctx, c.Params("slug"), c.Params("format"), &params)
+	return h.sendFeed(c, feed, err)
+}
+
+// sendFeed ตั้ง cache headers แล้วส่ง XML (ตอบ 304 ถ้า client มีฉบับล่าสุดแล้ว)
+func (h *ArticleFeedHandler) sendFeed(c *fiber.Ctx, feed *dto.ArticleFeedResponse, err error) error {
+	if err != nil {
+		switch err.Error() {
+		case "invalid feed format":
+			return utils.BadRequestResponse(c, "Feed format must be rss or atom")
+		case "invalid article type":
+			return utils.BadRequestResponse(c, "Invalid article type")
+		case "invalid language":
+			return utils.BadRequestResponse(c, "Language must be th or en")
+		}
+		logger.ErrorContext(c.UserContext(), "Failed to get article feed", "path", c.Path(), "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	lastModified := feed.UpdatedAt
+	if lastModified.IsZero() {
+		lastModified = time.Now()
+	}
+
+	c.Set("Content-Type", feedContentTypes[feed.Format])
+	c.Set("Cache-Control", "public, max-age=900, s-maxage=1800")
+	c.Set("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+
+	if c.Fresh() {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+	return c.SendString(feed.Data)
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/services"
+	"gofiber-template/pkg/logger"
+	"gofiber-template/pkg/utils"
+)
+
+type ArticleGeneratorHandler struct {
+	service services.ArticleGeneratorService
+}
+
+func NewArticleGeneratorHandler(service services.ArticleGeneratorService) *ArticleGeneratorHandler {
+	return &ArticleGeneratorHandler{service: service}
+}
+
+// GenerateArticle สร้างบทความ ranking/best-of/guide จาก catalogue (Admin)
+// POST /api/v1/articles/generate
+// @Summary Generate article from catalogue
+// @Description สร้าง draft จากข้อมูล cast/maker/tag (สั่งซ้ำ = เขียนทับ draft เดิม)
+// @Tags Articles
+// @Accept json
+// @Produce json
+// @Param request body dto.GenerateArticleRequest true "Generator options"
+// @Success 201 {object} utils.Response{data=dto.GenerateArticleResponse}
+// @Failure 400 {object} utils.ErrorResponse
+// @Failure 404 {object} utils.ErrorResponse
+// @Failure 409 {object} utils.ErrorResponse
+// @Router /articles/generate [post]
+func (h *ArticleGeneratorHandler) GenerateArticle(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.GenerateArticleRequest
+	if err := c.BodyParser(&req); err != nil {
+		logger.WarnContext(ctx, "Invalid request body", "error", err)
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+
+	if err := utils.ValidateStruct(&req); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	result, err := h.service.GenerateArticle(ctx, &req)
+	if err != nil {
+		switch err.Error() {
+		case "cast not found":
+			return utils.NotFoundResponse(c, "Cast not found")
+		case "maker not found":
+			return utils.NotFoundResponse(c, "Maker not found")
+		case "tag not found":
+			return utils.NotFoundResponse(c, "Tag not found")
+		case "not enough videos":
+			return utils.BadRequestResponse(c, "Not enough reviewed videos to generate this article")
+		case "article already exists":
+			return utils.ConflictResponse(c, "An article with this slug already exists and is not a generated draft")
+		}
+		logger.ErrorContext(ctx, "Failed to generate article", "kind", req.Kind, "slug", req.Slug, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	if !result.Created {
+		return utils.SuccessResponse(c, result)
+	}
+	return utils.CreatedResponse(c, result)
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"errors"
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
@@ -9,6 +10,7 @@ import (
 	"gofiber-template/domain/dto"
 	"gofiber-template/domain/services"
 	"gofiber-template/pkg/logger"
+	"gofiber-template/pkg/schema"
 	"gofiber-template/pkg/utils"
 )
 
@@ -42,6 +44,20 @@ func (h *ArticleHandler) IngestArticle(c *fiber.Ctx) error {
 		return utils.ValidationErrorResponse(c, errors)
 	}
 
+	// ตรวจ content ทั้งก้อนตาม schema ของ type (default: review)
+	articleType := req.Type
+	if articleType == "" {
+		articleType = "review"
+	}
+	if err := schema.ValidateArticleContent(articleType, rawBody); err != nil {
+		var schemaErr *schema.ValidationError
+		if errors.As(err, &schemaErr) {
+			logger.WarnContext(ctx, "Article content does not match schema", "video_id", req.VideoID, "type", articleType, "errors", schemaErr.Errors)
+			return utils.ValidationErrorResponse(c, schemaErr.Errors)
+		}
+		return utils.BadRequestResponse(c, "Invalid article content")
+	}
+
 	article, err := h.articleService.IngestArticle(ctx, &req, rawBody)
 	if err != nil {
 		switch err.Error() {
@@ -120,6 +136,11 @@ func (h *ArticleHandler) GetStats(c *fiber.Ctx) error {
 func (h *ArticleHandler) UpdateStatus(c *fiber.Ctx) error {
 	ctx := c.UserContext()
 
+	user, err := utils.GetUserFromContext(c)
+	if err != nil {
+		return utils.UnauthorizedResponse(c, "Unauthorized")
+	}
+
 	id, err := uuid.Parse(c.Params("id"))
 	if err != nil {
 		return utils.BadRequestResponse(c, "Invalid article ID")
@@ -137,12 +158,16 @@ func (h *ArticleHandler) UpdateStatus(c *fiber.Ctx) error {
 		return utils.ValidationErrorResponse(c, errors)
 	}
 
-	if err := h.articleService.UpdateStatus(ctx, id, &req); err != nil {
+	if err := h.articleService.UpdateStatus(ctx, id, user.ID, &req); err != nil {
 		switch err.Error() {
 		case "article not found":
 			return utils.NotFoundResponse(c, "Article not found")
 		case "scheduledAt is required for scheduled status":
 			return utils.BadRequestResponse(c, "scheduledAt is required for scheduled status")
+		case "invalid status transition":
+			return utils.ConflictResponse(c, "Invalid status transition")
+		case "approval required":
+			return utils.ConflictResponse(c, "Article must be approved in review before scheduling or publishing")
 		}
 		logger.ErrorContext(ctx, "Failed to update article status", "article_id", id, "error", err)
 		return utils.InternalServerErrorResponse(c)
@@ -157,6 +182,11 @@ func (h *ArticleHandler) UpdateStatus(c *fiber.Ctx) error {
 func (h *ArticleHandler) BulkSchedule(c *fiber.Ctx) error {
 	ctx := c.UserContext()
 
+	user, err := utils.GetUserFromContext(c)
+	if err != nil {
+		return utils.UnauthorizedResponse(c, "Unauthorized")
+	}
+
 	var req dto.BulkScheduleRequest
 	if err := c.BodyParser(&req); err != nil {
 		logger.WarnContext(ctx, "Invalid request body", "error", err)
@@ -169,21 +199,120 @@ func (h *ArticleHandler) BulkSchedule(c *fiber.Ctx) error {
 		return utils.ValidationErrorResponse(c, errors)
 	}
 
-	if err := h.articleService.BulkSchedule(ctx, &req); err != nil {
-		if err.Error() == "no valid article IDs provided" {
+	count, err := h.articleService.BulkSchedule(ctx, user.ID, &req)
+	if err != nil {
+		switch err.Error() {
+		case "no valid article IDs provided":
 			return utils.BadRequestResponse(c, "No valid article IDs provided")
+		case "article status changed":
+			return utils.ConflictResponse(c, "Some articles changed status while scheduling, please retry")
 		}
 		logger.ErrorContext(ctx, "Failed to bulk schedule articles", "count", len(req.ArticleIDs), "error", err)
 		return utils.InternalServerErrorResponse(c)
 	}
 
-	logger.InfoContext(ctx, "Articles bulk scheduled", "count", len(req.ArticleIDs))
+	logger.InfoContext(ctx, "Articles bulk scheduled", "count", count, "requested", len(req.ArticleIDs))
 	return utils.SuccessResponse(c, fiber.Map{
 		"message": "Articles scheduled successfully",
-		"count":   len(req.ArticleIDs),
+		"count":   count,
+		"skipped": len(req.ArticleIDs) - count,
 	})
 }
 
+// PreviewSchedulePlan - ดูตารางเวลา publish ที่ planner เสนอ (ยังไม่บันทึก)
+// POST /api/v1/articles/schedule-plan/preview
+func (h *ArticleHandler) PreviewSchedulePlan(c *fiber.Ctx) error {
+	return h.planSchedule(c, false)
+}
+
+// ApplySchedulePlan - ตั้งเวลา publish ตาม planner และเก็บ policy ไว้ใช้ rebalance
+// POST /api/v1/articles/schedule-plan/apply
+func (h *ArticleHandler) ApplySchedulePlan(c *fiber.Ctx) error {
+	return h.planSchedule(c, true)
+}
+
+func (h *ArticleHandler) planSchedule(c *fiber.Ctx, apply bool) error {
+	ctx := c.UserContext()
+
+	user, err := utils.GetUserFromContext(c)
+	if err != nil {
+		return utils.UnauthorizedResponse(c, "Unauthorized")
+	}
+
+	var req dto.SchedulePlanRequest
+	if err := c.BodyParser(&req); err != nil {
+		logger.WarnContext(ctx, "Invalid request body", "error", err)
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+
+	if err := utils.ValidateStruct(&req); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	plan, err := h.articleService.PlanSchedule(ctx, user.ID, &req, apply)
+	if err != nil {
+		switch err.Error() {
+		case "no valid article IDs provided":
+			return utils.BadRequestResponse(c, "No valid article IDs provided")
+		case "invalid publish window":
+			return utils.BadRequestResponse(c, "windowEnd must be after windowStart (HH:MM)")
+		case "invalid timezone":
+			return utils.BadRequestResponse(c, "Invalid timezone")
+		case "article status changed":
+			return utils.ConflictResponse(c, "Some articles changed status while planning, please retry")
+		}
+		logger.ErrorContext(ctx, "Failed to plan article schedule", "count", len(req.ArticleIDs), "apply", apply, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, plan)
+}
+
+// RebalanceSchedule - วางบทความที่ planner ตั้งไว้ใหม่ตาม policy ล่าสุด
+// POST /api/v1/articles/schedule-plan/rebalance?dryRun=true
+func (h *ArticleHandler) RebalanceSchedule(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var params dto.RebalanceScheduleParams
+	if err := c.QueryParser(&params); err != nil {
+		logger.WarnContext(ctx, "Invalid query parameters", "error", err)
+		return utils.BadRequestResponse(c, "Invalid query parameters")
+	}
+
+	plan, err := h.articleService.RebalanceSchedule(ctx, params.DryRun)
+	if err != nil {
+		switch err.Error() {
+		case "schedule policy not found":
+			return utils.NotFoundResponse(c, "No schedule plan has been applied yet")
+		case "article status changed":
+			return utils.ConflictResponse(c, "Some articles changed status while rebalancing, please retry")
+		}
+		logger.ErrorContext(ctx, "Failed to rebalance article schedule", "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, plan)
+}
+
+// GetSchedulePolicy - policy ของ planner ที่ apply ล่าสุด
+// GET /api/v1/articles/schedule-plan/policy
+func (h *ArticleHandler) GetSchedulePolicy(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	policy, err := h.articleService.GetSchedulePolicy(ctx)
+	if err != nil {
+		if err.Error() == "schedule policy not found" {
+			return utils.NotFoundResponse(c, "No schedule plan has been applied yet")
+		}
+		logger.ErrorContext(ctx, "Failed to get schedule policy", "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, policy)
+}
+
 // DeleteArticle - ลบบทความ
 // DELETE /api/v1/articles/:id
 func (h *ArticleHandler) DeleteArticle(c *fiber.Ctx) error {
@@ -206,6 +335,522 @@ func (h *ArticleHandler) DeleteArticle(c *fiber.Ctx) error {
 	return utils.SuccessResponse(c, fiber.Map{"message": "Article deleted successfully"})
 }
 
+// ========================================
+// Admin Edit & Revisions
+// ========================================
+
+// UpdateArticle - แก้ไขบทความ (Admin) บันทึก revision ทุกครั้ง
+// PUT /api/v1/articles/:id
+func (h *ArticleHandler) UpdateArticle(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	user, err := utils.GetUserFromContext(c)
+	if err != nil {
+		return utils.UnauthorizedResponse(c, "Unauthorized")
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid article ID")
+	}
+
+	var req dto.UpdateArticleRequest
+	if err := c.BodyParser(&req); err != nil {
+		logger.WarnContext(ctx, "Invalid request body", "error", err)
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+
+	if err := utils.ValidateStruct(&req); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	article, err := h.articleService.UpdateArticle(ctx, id, user.ID, &req)
+	if err != nil {
+		var schemaErr *schema.ValidationError
+		if errors.As(err, &schemaErr) {
+			return utils.ValidationErrorResponse(c, schemaErr.Errors)
+		}
+		switch err.Error() {
+		case "article not found":
+			return utils.NotFoundResponse(c, "Article not found")
+		case "invalid content":
+			return utils.BadRequestResponse(c, "Content must be a JSON object")
+		case "slug already exists":
+			return utils.ConflictResponse(c, "Slug already exists")
+		}
+		logger.ErrorContext(ctx, "Failed to update article", "article_id", id, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, article)
+}
+
+// ListRevisions - รายการ revision ของบทความ (Admin)
+// GET /api/v1/articles/:id/revisions
+func (h *ArticleHandler) ListRevisions(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid article ID")
+	}
+
+	var params dto.ArticleRevisionListParams
+	if err := c.QueryParser(&params); err != nil {
+		logger.WarnContext(ctx, "Invalid query parameters", "error", err)
+		return utils.BadRequestResponse(c, "Invalid query parameters")
+	}
+
+	revisions, total, err := h.articleService.ListRevisions(ctx, id, &params)
+	if err != nil {
+		if err.Error() == "article not found" {
+			return utils.NotFoundResponse(c, "Article not found")
+		}
+		logger.ErrorContext(ctx, "Failed to list article revisions", "article_id", id, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.PaginatedSuccessResponse(c, revisions, total, params.Page, params.Limit)
+}
+
+// GetRevision - ดู snapshot ของ revision (Admin)
+// GET /api/v1/articles/:id/revisions/:revision
+func (h *ArticleHandler) GetRevision(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid article ID")
+	}
+
+	revision, err := c.ParamsInt("revision")
+	if err != nil || revision < 1 {
+		return utils.BadRequestResponse(c, "Invalid revision number")
+	}
+
+	rev, err := h.articleService.GetRevision(ctx, id, revision)
+	if err != nil {
+		if err.Error() == "revision not found" {
+			return utils.NotFoundResponse(c, "Revision not found")
+		}
+		logger.ErrorContext(ctx, "Failed to get article revision", "article_id", id, "revision", revision, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, rev)
+}
+
+// DiffRevisions - เทียบ 2 revisions (Admin)
+// GET /api/v1/articles/:id/revisions/diff?from=1&to=3 (ไม่ส่ง to = เทียบกับล่าสุด)
+func (h *ArticleHandler) DiffRevisions(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid article ID")
+	}
+
+	var params dto.ArticleRevisionDiffParams
+	if err := c.QueryParser(&params); err != nil {
+		logger.WarnContext(ctx, "Invalid query parameters", "error", err)
+		return utils.BadRequestResponse(c, "Invalid query parameters")
+	}
+
+	if err := utils.ValidateStruct(&params); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	diff, err := h.articleService.DiffRevisions(ctx, id, params.From, params.To)
+	if err != nil {
+		if err.Error() == "revision not found" {
+			return utils.NotFoundResponse(c, "Revision not found")
+		}
+		logger.ErrorContext(ctx, "Failed to diff article revisions", "article_id", id, "from", params.From, "to", params.To, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, diff)
+}
+
+// RestoreRevision - กู้คืนบทความจาก revision เก่า (Admin)
+// POST /api/v1/articles/:id/revisions/:revision/restore
+func (h *ArticleHandler) RestoreRevision(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	user, err := utils.GetUserFromContext(c)
+	if err != nil {
+		return utils.UnauthorizedResponse(c, "Unauthorized")
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid article ID")
+	}
+
+	revision, err := c.ParamsInt("revision")
+	if err != nil || revision < 1 {
+		return utils.BadRequestResponse(c, "Invalid revision number")
+	}
+
+	article, err := h.articleService.RestoreRevision(ctx, id, revision, user.ID)
+	if err != nil {
+		var schemaErr *schema.ValidationError
+		if errors.As(err, &schemaErr) {
+			return utils.ValidationErrorResponse(c, schemaErr.Errors)
+		}
+		switch err.Error() {
+		case "article not found":
+			return utils.NotFoundResponse(c, "Article not found")
+		case "revision not found":
+			return utils.NotFoundResponse(c, "Revision not found")
+		case "slug already exists":
+			return utils.ConflictResponse(c, "Slug of this revision is now used by another article")
+		}
+		logger.ErrorContext(ctx, "Failed to restore article revision", "article_id", id, "revision", revision, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	logger.InfoContext(ctx, "Article revision restored", "article_id", id, "revision", revision)
+	return utils.SuccessResponse(c, article)
+}
+
+// ========================================
+// Slug Redirects (Admin)
+// ========================================
+
+// ListRedirects - รายการ redirect ของ slug เก่า (Admin)
+// GET /api/v1/articles/redirects?lang=th&search=
+func (h *ArticleHandler) ListRedirects(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var params dto.ArticleRedirectListParams
+	if err := c.QueryParser(&params); err != nil {
+		logger.WarnContext(ctx, "Invalid query parameters", "error", err)
+		return utils.BadRequestResponse(c, "Invalid query parameters")
+	}
+
+	redirects, total, err := h.articleService.ListRedirects(ctx, &params)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list article redirects", "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.PaginatedSuccessResponse(c, redirects, total, params.Page, params.Limit)
+}
+
+// CreateRedirect - เพิ่ม redirect เอง (Admin)
+// POST /api/v1/articles/redirects
+func (h *ArticleHandler) CreateRedirect(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	user, err := utils.GetUserFromContext(c)
+	if err != nil {
+		return utils.UnauthorizedResponse(c, "Unauthorized")
+	}
+
+	var req dto.CreateArticleRedirectRequest
+	if err := c.BodyParser(&req); err != nil {
+		logger.WarnContext(ctx, "Invalid request body", "error", err)
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+
+	if err := utils.ValidateStruct(&req); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	redirect, err := h.articleService.CreateRedirect(ctx, user.ID, &req)
+	if err != nil {
+		switch err.Error() {
+		case "article not found":
+			return utils.NotFoundResponse(c, "Article not found")
+		case "slug is in use":
+			return utils.ConflictResponse(c, "Slug is still used by an article")
+		}
+		logger.ErrorContext(ctx, "Failed to create article redirect", "from_slug", req.FromSlug, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.CreatedResponse(c, redirect)
+}
+
+// DeleteRedirect - ลบ redirect (Admin)
+// DELETE /api/v1/articles/redirects/:redirectId
+func (h *ArticleHandler) DeleteRedirect(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := uuid.Parse(c.Params("redirectId"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid redirect ID")
+	}
+
+	if err := h.articleService.DeleteRedirect(ctx, id); err != nil {
+		if err.Error() == "redirect not found" {
+			return utils.NotFoundResponse(c, "Redirect not found")
+		}
+		logger.ErrorContext(ctx, "Failed to delete article redirect", "redirect_id", id, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, fiber.Map{"message": "Redirect deleted successfully"})
+}
+
+// ========================================
+// Content Validation (Admin)
+// ========================================
+
+// ValidateStoredContent - ตรวจ content ของบทความที่เก็บไว้แล้วกับ schema ปัจจุบัน
+// POST /api/v1/articles/content/validate
+// Body (optional): {"type": "review", "status": "published"}
+func (h *ArticleHandler) ValidateStoredContent(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.ArticleContentCheckRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			logger.WarnContext(ctx, "Invalid request body", "error", err)
+			return utils.BadRequestResponse(c, "Invalid request body")
+		}
+	}
+
+	if err := utils.ValidateStruct(&req); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	result, err := h.articleService.ValidateStoredContent(ctx, &req)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to validate stored article content", "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, result)
+}
+
+// AssignReviewer - มอบหมาย reviewer และส่งบทความเข้า in_review (Admin)
+// POST /api/v1/articles/:id/review/assign
+func (h *ArticleHandler) AssignReviewer(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	user, err := utils.GetUserFromContext(c)
+	if err != nil {
+		return utils.UnauthorizedResponse(c, "Unauthorized")
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid article ID")
+	}
+
+	var req dto.AssignReviewerRequest
+	if err := c.BodyParser(&req); err != nil {
+		logger.WarnContext(ctx, "Invalid request body", "error", err)
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+
+	if err := utils.ValidateStruct(&req); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	article, err := h.articleService.AssignReviewer(ctx, id, user.ID, &req)
+	if err != nil {
+		switch err.Error() {
+		case "article not found":
+			return utils.NotFoundResponse(c, "Article not found")
+		case "reviewer not found":
+			return utils.NotFoundResponse(c, "Reviewer not found")
+		case "invalid status transition":
+			return utils.ConflictResponse(c, "Only draft or in-review articles can be assigned")
+		}
+		logger.ErrorContext(ctx, "Failed to assign reviewer", "article_id", id, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, article)
+}
+
+// DecideReview - approve / reject บทความที่อยู่ใน review (Admin)
+// POST /api/v1/articles/:id/review/decision
+// Body: {"decision": "approve|reject", "notes": "..."} (reject ต้องมี notes)
+func (h *ArticleHandler) DecideReview(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	user, err := utils.GetUserFromContext(c)
+	if err != nil {
+		return utils.UnauthorizedResponse(c, "Unauthorized")
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid article ID")
+	}
+
+	var req dto.ReviewDecisionRequest
+	if err := c.BodyParser(&req); err != nil {
+		logger.WarnContext(ctx, "Invalid request body", "error", err)
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+
+	if err := utils.ValidateStruct(&req); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	article, err := h.articleService.DecideReview(ctx, id, user.ID, &req)
+	if err != nil {
+		switch err.Error() {
+		case "article not found":
+			return utils.NotFoundResponse(c, "Article not found")
+		case "article not in review":
+			return utils.ConflictResponse(c, "Article is not in review")
+		case "not assigned reviewer":
+			return utils.ForbiddenResponse(c, "Only the assigned reviewer can decide this review")
+		}
+		logger.ErrorContext(ctx, "Failed to decide review", "article_id", id, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, article)
+}
+
+// ListStatusHistory - ประวัติการเปลี่ยนสถานะ (Admin)
+// GET /api/v1/articles/:id/status-history
+func (h *ArticleHandler) ListStatusHistory(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid article ID")
+	}
+
+	var params dto.ArticleStatusHistoryParams
+	if err := c.QueryParser(&params); err != nil {
+		logger.WarnContext(ctx, "Invalid query parameters", "error", err)
+		return utils.BadRequestResponse(c, "Invalid query parameters")
+	}
+
+	history, total, err := h.articleService.ListStatusHistory(ctx, id, &params)
+	if err != nil {
+		if err.Error() == "article not found" {
+			return utils.NotFoundResponse(c, "Article not found")
+		}
+		logger.ErrorContext(ctx, "Failed to list article status history", "article_id", id, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.PaginatedSuccessResponse(c, history, total, params.Page, params.Limit)
+}
+
+// ListReviewQueue - คิวบทความที่รอ review (Admin)
+// GET /api/v1/articles/review-queue?mine=true&type=review&lang=th&min_score=5&sort=oldest
+func (h *ArticleHandler) ListReviewQueue(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	user, err := utils.GetUserFromContext(c)
+	if err != nil {
+		return utils.UnauthorizedResponse(c, "Unauthorized")
+	}
+
+	var params dto.ReviewQueueParams
+	if err := c.QueryParser(&params); err != nil {
+		logger.WarnContext(ctx, "Invalid query parameters", "error", err)
+		return utils.BadRequestResponse(c, "Invalid query parameters")
+	}
+
+	if err := utils.ValidateStruct(&params); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	articles, total, err := h.articleService.ListReviewQueue(ctx, user.ID, &params)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list review queue", "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.PaginatedSuccessResponse(c, articles, total, params.Page, params.Limit)
+}
+
+// CreatePreviewToken - ออก preview token ของบทความ (Admin)
+// POST /api/v1/articles/:id/preview-tokens
+// Body (optional): {"ttlMinutes": 60}
+func (h *ArticleHandler) CreatePreviewToken(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid article ID")
+	}
+
+	var req dto.CreateArticlePreviewRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			logger.WarnContext(ctx, "Invalid request body", "error", err)
+			return utils.BadRequestResponse(c, "Invalid request body")
+		}
+	}
+
+	if err := utils.ValidateStruct(&req); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	result, err := h.articleService.CreatePreviewToken(ctx, id, &req)
+	if err != nil {
+		switch err.Error() {
+		case "article not found":
+			return utils.NotFoundResponse(c, "Article not found")
+		case "cache not available":
+			return utils.BadRequestResponse(c, "Cache not available")
+		}
+		logger.ErrorContext(ctx, "Failed to create preview token", "article_id", id, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.CreatedResponse(c, result)
+}
+
+// RevokePreviewTokens - ยกเลิก preview token (Admin)
+// DELETE /api/v1/articles/:id/preview-tokens (ทั้งหมด)
+// DELETE /api/v1/articles/:id/preview-tokens/:tokenId (ทีละ token)
+func (h *ArticleHandler) RevokePreviewTokens(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid article ID")
+	}
+
+	tokenID := c.Params("tokenId")
+	if tokenID != "" {
+		if _, err := uuid.Parse(tokenID); err != nil {
+			return utils.BadRequestResponse(c, "Invalid token ID")
+		}
+	}
+
+	result, err := h.articleService.RevokePreviewTokens(ctx, id, tokenID)
+	if err != nil {
+		if err.Error() == "cache not available" {
+			return utils.BadRequestResponse(c, "Cache not available")
+		}
+		logger.ErrorContext(ctx, "Failed to revoke preview tokens", "article_id", id, "token_id", tokenID, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, result)
+}
+
 // ========================================
 // Public API (for nextjs_subth)
 // ========================================
@@ -267,9 +912,98 @@ func (h *ArticleHandler) GetPublishedArticleByType(c *fiber.Ctx) error {
 		return utils.InternalServerErrorResponse(c)
 	}
 
+	// slug เก่า หรือ slug ของภาษาอื่น → 301 ไป canonical type/slug
+	if article.Redirect != nil {
+		return utils.RedirectResponse(c, article.Redirect.Path, article)
+	}
+
+	return utils.SuccessResponse(c, article)
+}
+
+// GetPreviewArticle - ดูบทความผ่าน preview token ได้ทุกสถานะ (Public)
+// GET /api/v1/articles/preview/:token
+func (h *ArticleHandler) GetPreviewArticle(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	// หน้า preview ห้ามถูก index หรือ cache ที่ CDN/browser
+	c.Set("X-Robots-Tag", "noindex, nofollow")
+	c.Set(fiber.HeaderCacheControl, "no-store")
+
+	article, err := h.articleService.GetPreviewArticle(ctx, c.Params("token"))
+	if err != nil {
+		switch err.Error() {
+		case "invalid preview token":
+			return utils.UnauthorizedResponse(c, "Invalid or expired preview token")
+		case "article not found":
+			return utils.NotFoundResponse(c, "Article not found")
+		case "cache not available":
+			return utils.BadRequestResponse(c, "Cache not available")
+		}
+		logger.ErrorContext(ctx, "Failed to get preview article", "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
 	return utils.SuccessResponse(c, article)
 }
 
+// SearchPublishedArticles - ค้นหาบทความแบบ full-text พร้อม snippet และ facets ตาม type (Public)
+// GET /api/v1/articles/public/search?q=...&lang=th|en&type=review&page=1&limit=20
+func (h *ArticleHandler) SearchPublishedArticles(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var params dto.ArticleSearchParams
+	if err := c.QueryParser(&params); err != nil {
+		logger.WarnContext(ctx, "Invalid query parameters", "error", err)
+		return utils.BadRequestResponse(c, "Invalid query parameters")
+	}
+
+	if err := utils.ValidateStruct(&params); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	result, err := h.articleService.SearchPublishedArticles(ctx, &params)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to search articles", "query", params.Q, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, result)
+}
+
+// GetRelatedArticles - บทความที่เกี่ยวข้อง ("อ่านต่อ") ของบทความ (Public)
+// GET /api/v1/articles/:type/:slug/related?lang=th|en&limit=8
+func (h *ArticleHandler) GetRelatedArticles(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	articleType := extractArticleTypeFromPath(c.Path())
+	slug := c.Params("slug")
+	if articleType == "" {
+		return utils.BadRequestResponse(c, "Article type is required")
+	}
+
+	var params dto.RelatedArticleParams
+	if err := c.QueryParser(&params); err != nil {
+		logger.WarnContext(ctx, "Invalid query parameters", "error", err)
+		return utils.BadRequestResponse(c, "Invalid query parameters")
+	}
+
+	articles, err := h.articleService.GetRelatedArticles(ctx, articleType, slug, &params)
+	if err != nil {
+		switch err.Error() {
+		case "invalid article type":
+			return utils.BadRequestResponse(c, "Invalid article type")
+		case "article not found":
+			return utils.NotFoundResponse(c, "Article not found")
+		}
+		logger.ErrorContext(ctx, "Failed to get related articles", "type", articleType, "slug", slug, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, articles)
+}
+
 // extractArticleTypeFromPath extracts the article type from URL path
 // e.g., /api/v1/articles/review/slug -> review
 func extractArticleTypeFromPath(path string) string {
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"bufio"
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/services"
+	"gofiber-template/pkg/logger"
+	"gofiber-template/pkg/utils"
+)
+
+type CatalogHandler struct {
+	catalogService services.CatalogService
+}
+
+func NewCatalogHandler(catalogService services.CatalogService) *CatalogHandler {
+	return &CatalogHandler{
+		catalogService: catalogService,
+	}
+}
+
+// ExportCatalog godoc
+// @Summary Stream the full video catalogue as JSONL or CSV (admin)
+// @Description 1 แถวต่อ video พร้อม titles, maker, casts, tags, categories, reel และสถานะบทความ; since = incremental (รวม video ที่ถูกลบ deleted=true และ video ที่ถูก merge deleted=true + mergedInto)
+// @Tags catalog
+// @Produce application/x-ndjson
+// @Produce text/csv
+// @Produce application/gzip
+// @Security BearerAuth
+// @Param format query string false "File format" Enums(jsonl, csv) default(jsonl)
+// @Param gzip query bool false "Gzip compress the file"
+// @Param since query string false "Only videos updated at or after this time (RFC3339, use updatedAt of the last row)"
+// @Param maker_id query string false "Maker ID"
+// @Param cast_id query string false "Cast ID"
+// @Param tag_id query string false "Tag ID"
+// @Param category query string false "Category slug"
+// @Param seo_status query string false "SEO status" Enums(pending, draft, published)
+// @Param has_reel query string false "Has reel" Enums(true, false)
+// @Success 200 {string} string "File"
+// @Router /api/v1/catalog/export [get]
+func (h *CatalogHandler) ExportCatalog(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.CatalogExportRequest
+	if err := c.QueryParser(&req); err != nil {
+		logger.WarnContext(ctx, "Invalid query parameters", "error", err)
+		return utils.BadRequestResponse(c, "Invalid query parameters")
+	}
+
+	if err := utils.ValidateStruct(&req); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	// ตรวจก่อนเริ่ม stream - หลังจากนี้ error ได้แค่ log (status 200 ถูกส่งไปแล้ว)
+	if err := h.catalogService.Validate(&req); err != nil {
+		switch err.Error() {
+		case "invalid since":
+			return utils.BadRequestResponse(c, "since must be an RFC3339 timestamp")
+		case "invalid filter ID":
+			return utils.BadRequestResponse(c, "Invalid filter ID")
+		}
+		return utils.BadRequestResponse(c, "Invalid export filter")
+	}
+
+	filename, contentType := h.catalogService.Filename(&req)
+	c.Set("Content-Type", contentType)
+	c.Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Set("Cache-Control", "no-store")
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		result, err := h.catalogService.Export(ctx, &req, w)
+		if err != nil {
+			logger.ErrorContext(ctx, "Catalog export stream aborted", "error", err)
+			return
+		}
+		logger.InfoContext(ctx, "Catalog export stream finished", "count", result.Count, "last_updated_at", result.UpdatedAt)
+	})
+	return nil
+}
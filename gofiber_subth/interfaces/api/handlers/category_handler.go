@@ -30,13 +30,21 @@ func NewCategoryHandler(categoryRepo repositories.CategoryRepository) *CategoryH
 // @Tags categories
 // @Produce json
 // @Param lang query string false "Language code (th, en)"
+// @Param missing_lang query string false "Only categories without name in this language (en, th, ja)"
 // @Success 200 {object} utils.Response
 // @Router /api/v1/categories [get]
 func (h *CategoryHandler) ListCategories(c *fiber.Ctx) error {
 	ctx := c.UserContext()
 	lang := c.Query("lang", "en") // default เป็นภาษาอังกฤษ
 
-	categories, err := h.categoryRepo.List(ctx)
+	missingLang := c.Query("missing_lang")
+	switch missingLang {
+	case "", "en", "th", "ja":
+	default:
+		return utils.BadRequestResponse(c, "missing_lang must be one of en, th, ja")
+	}
+
+	categories, err := h.categoryRepo.List(ctx, repositories.CategoryListParams{MissingLang: missingLang})
 	if err != nil {
 		logger.ErrorContext(ctx, "Failed to list categories", "error", err)
 		return utils.InternalServerErrorResponse(c)
@@ -0,0 +1,440 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/services"
+	"gofiber-template/pkg/logger"
+	"gofiber-template/pkg/utils"
+)
+
+type CollectionHandler struct {
+	collectionService services.CollectionService
+}
+
+func NewCollectionHandler(collectionService services.CollectionService) *CollectionHandler {
+	return &CollectionHandler{
+		collectionService: collectionService,
+	}
+}
+
+// CreateCollection godoc
+// @Summary Create a new collection
+// @Tags collections
+// @Accept json
+// @Produce json
+// @Param collection body dto.CreateCollectionRequest true "Collection data"
+// @Success 201 {object} utils.Response{data=dto.CollectionDetailResponse}
+// @Router /api/v1/collections [post]
+func (h *CollectionHandler) CreateCollection(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.CreateCollectionRequest
+	if err := c.BodyParser(&req); err != nil {
+		logger.WarnContext(ctx, "Invalid request body", "error", err)
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+
+	if err := utils.ValidateStruct(&req); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	collection, err := h.collectionService.CreateCollection(ctx, &req)
+	if err != nil {
+		switch err.Error() {
+		case "collection already exists":
+			return utils.ConflictResponse(c, "Collection already exists")
+		case "invalid language":
+			return utils.BadRequestResponse(c, "Invalid translation language")
+		}
+		logger.ErrorContext(ctx, "Failed to create collection", "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	logger.InfoContext(ctx, "Collection created", "collection_id", collection.ID)
+	return utils.CreatedResponse(c, collection)
+}
+
+// UpdateCollection godoc
+// @Summary Update collection
+// @Tags collections
+// @Accept json
+// @Produce json
+// @Param id path string true "Collection ID"
+// @Param collection body dto.UpdateCollectionRequest true "Collection data"
+// @Success 200 {object} utils.Response{data=dto.CollectionDetailResponse}
+// @Router /api/v1/collections/{id} [put]
+func (h *CollectionHandler) UpdateCollection(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid collection ID")
+	}
+
+	var req dto.UpdateCollectionRequest
+	if err := c.BodyParser(&req); err != nil {
+		logger.WarnContext(ctx, "Invalid request body", "error", err)
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+
+	if err := utils.ValidateStruct(&req); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	collection, err := h.collectionService.UpdateCollection(ctx, id, &req)
+	if err != nil {
+		switch err.Error() {
+		case "collection not found":
+			return utils.NotFoundResponse(c, "Collection not found")
+		case "collection already exists":
+			return utils.ConflictResponse(c, "Collection already exists")
+		case "invalid language":
+			return utils.BadRequestResponse(c, "Invalid translation language")
+		}
+		logger.ErrorContext(ctx, "Failed to update collection", "collection_id", id, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	logger.InfoContext(ctx, "Collection updated", "collection_id", id)
+	return utils.SuccessResponse(c, collection)
+}
+
+// DeleteCollection godoc
+// @Summary Delete collection (videos are kept)
+// @Tags collections
+// @Produce json
+// @Param id path string true "Collection ID"
+// @Success 200 {object} utils.Response
+// @Router /api/v1/collections/{id} [delete]
+func (h *CollectionHandler) DeleteCollection(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid collection ID")
+	}
+
+	if err := h.collectionService.DeleteCollection(ctx, id); err != nil {
+		if err.Error() == "collection not found" {
+			return utils.NotFoundResponse(c, "Collection not found")
+		}
+		logger.ErrorContext(ctx, "Failed to delete collection", "collection_id", id, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	logger.InfoContext(ctx, "Collection deleted", "collection_id", id)
+	return utils.SuccessResponse(c, fiber.Map{"message": "Collection deleted successfully"})
+}
+
+// ReorderCollections godoc
+// @Summary Reorder homepage collection sections
+// @Tags collections
+// @Accept json
+// @Produce json
+// @Param request body dto.ReorderCollectionsRequest true "Collection IDs in new order"
+// @Success 200 {object} utils.Response
+// @Router /api/v1/collections/reorder [put]
+func (h *CollectionHandler) ReorderCollections(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.ReorderCollectionsRequest
+	if err := c.BodyParser(&req); err != nil {
+		logger.WarnContext(ctx, "Invalid request body", "error", err)
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+
+	if err := utils.ValidateStruct(&req); err != nil {
+		errs := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errs)
+		return utils.ValidationErrorResponse(c, errs)
+	}
+
+	if err := h.collectionService.ReorderCollections(ctx, &req); err != nil {
+		logger.ErrorContext(ctx, "Failed to reorder collections", "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, fiber.Map{"message": "Collections reordered successfully"})
+}
+
+// SetCollectionVideos godoc
+// @Summary Replace collection videos (in order)
+// @Tags collections
+// @Accept json
+// @Produce json
+// @Param id path string true "Collection ID"
+// @Param videos body dto.SetGroupVideosRequest true "Video IDs in display order"
+// @Success 200 {object} utils.Response{data=dto.CollectionDetailResponse}
+// @Router /api/v1/collections/{id}/videos [put]
+func (h *CollectionHandler) SetCollectionVideos(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid collection ID")
+	}
+
+	var req dto.SetGroupVideosRequest
+	if err := c.BodyParser(&req); err != nil {
+		logger.WarnContext(ctx, "Invalid request body", "error", err)
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+
+	if err := utils.ValidateStruct(&req); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	collection, err := h.collectionService.SetCollectionVideos(ctx, id, &req)
+	if err != nil {
+		switch err.Error() {
+		case "collection not found":
+			return utils.NotFoundResponse(c, "Collection not found")
+		case "video not found":
+			return utils.BadRequestResponse(c, "One or more videos not found")
+		case "duplicate video":
+			return utils.BadRequestResponse(c, "Duplicate video in list")
+		}
+		logger.ErrorContext(ctx, "Failed to set collection videos", "collection_id", id, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, collection)
+}
+
+// GetCollection godoc
+// @Summary Get collection by ID (admin, includes drafts)
+// @Tags collections
+// @Produce json
+// @Param id path string true "Collection ID"
+// @Param lang query string false "Language" Enums(en, th, ja)
+// @Success 200 {object} utils.Response{data=dto.CollectionDetailResponse}
+// @Router /api/v1/collections/admin/{id} [get]
+func (h *CollectionHandler) GetCollection(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid collection ID")
+	}
+
+	lang := c.Query("lang", "en")
+
+	collection, err := h.collectionService.GetCollection(ctx, id, lang)
+	if err != nil {
+		if err.Error() == "collection not found" {
+			return utils.NotFoundResponse(c, "Collection not found")
+		}
+		logger.ErrorContext(ctx, "Failed to get collection", "collection_id", id, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, collection)
+}
+
+// ListCollectionVideos godoc
+// @Summary List collection videos in order (admin, includes drafts)
+// @Tags collections
+// @Produce json
+// @Param id path string true "Collection ID"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Param lang query string false "Language" Enums(en, th, ja)
+// @Success 200 {object} utils.PaginatedResponse{data=[]dto.VideoListItemResponse}
+// @Router /api/v1/collections/admin/{id}/videos [get]
+func (h *CollectionHandler) ListCollectionVideos(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid collection ID")
+	}
+
+	lang := c.Query("lang", "th")
+	page, limit := collectionVideosPage(c)
+
+	videos, total, err := h.collectionService.ListCollectionVideos(ctx, id, lang, page, limit)
+	if err != nil {
+		if err.Error() == "collection not found" {
+			return utils.NotFoundResponse(c, "Collection not found")
+		}
+		logger.ErrorContext(ctx, "Failed to list collection videos", "collection_id", id, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.PaginatedSuccessResponse(c, videos, total, page, limit)
+}
+
+// GetCollectionBySlug godoc
+// @Summary Get public collection by slug
+// @Tags collections
+// @Produce json
+// @Param slug path string true "Collection slug"
+// @Param lang query string false "Language" Enums(en, th, ja)
+// @Success 200 {object} utils.Response{data=dto.CollectionDetailResponse}
+// @Router /api/v1/collections/slug/{slug} [get]
+func (h *CollectionHandler) GetCollectionBySlug(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	slug := c.Params("slug")
+	lang := c.Query("lang", "en")
+
+	collection, err := h.collectionService.GetCollectionBySlug(ctx, slug, lang)
+	if err != nil {
+		if err.Error() == "collection not found" {
+			return utils.NotFoundResponse(c, "Collection not found")
+		}
+		logger.ErrorContext(ctx, "Failed to get collection by slug", "slug", slug, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, collection)
+}
+
+// ListCollectionVideosBySlug godoc
+// @Summary List videos of a public collection in order
+// @Tags collections
+// @Produce json
+// @Param slug path string true "Collection slug"
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Param lang query string false "Language" Enums(en, th, ja)
+// @Success 200 {object} utils.PaginatedResponse{data=[]dto.VideoListItemResponse}
+// @Router /api/v1/collections/slug/{slug}/videos [get]
+func (h *CollectionHandler) ListCollectionVideosBySlug(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	slug := c.Params("slug")
+	lang := c.Query("lang", "th")
+	page, limit := collectionVideosPage(c)
+
+	videos, total, err := h.collectionService.ListCollectionVideosBySlug(ctx, slug, lang, page, limit)
+	if err != nil {
+		if err.Error() == "collection not found" {
+			return utils.NotFoundResponse(c, "Collection not found")
+		}
+		logger.ErrorContext(ctx, "Failed to list collection videos", "slug", slug, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.PaginatedSuccessResponse(c, videos, total, page, limit)
+}
+
+// ListCollections godoc
+// @Summary List public collections
+// @Tags collections
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Param lang query string false "Language" Enums(en, th, ja)
+// @Param search query string false "Search by name"
+// @Success 200 {object} utils.PaginatedResponse{data=[]dto.CollectionResponse}
+// @Router /api/v1/collections [get]
+func (h *CollectionHandler) ListCollections(c *fiber.Ctx) error {
+	return h.listCollections(c, true)
+}
+
+// ListAllCollections godoc
+// @Summary List all collections including drafts (admin)
+// @Tags collections
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Param lang query string false "Language" Enums(en, th, ja)
+// @Param search query string false "Search by name"
+// @Success 200 {object} utils.PaginatedResponse{data=[]dto.CollectionResponse}
+// @Router /api/v1/collections/admin [get]
+func (h *CollectionHandler) ListAllCollections(c *fiber.Ctx) error {
+	return h.listCollections(c, false)
+}
+
+func (h *CollectionHandler) listCollections(c *fiber.Ctx, publicOnly bool) error {
+	ctx := c.UserContext()
+
+	var req dto.CollectionListRequest
+	if err := c.QueryParser(&req); err != nil {
+		logger.WarnContext(ctx, "Invalid query parameters", "error", err)
+		return utils.BadRequestResponse(c, "Invalid query parameters")
+	}
+
+	// Default values
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.Limit < 1 {
+		req.Limit = 20
+	}
+	if req.Limit > 100 {
+		req.Limit = 100
+	}
+	if req.Lang == "" {
+		req.Lang = "en"
+	}
+
+	collections, total, err := h.collectionService.ListCollections(ctx, &req, publicOnly)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list collections", "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.PaginatedSuccessResponse(c, collections, total, req.Page, req.Limit)
+}
+
+// GetHomeCollections godoc
+// @Summary Get public collections with videos (homepage sections)
+// @Tags collections
+// @Produce json
+// @Param limit query int false "Videos per collection" default(4)
+// @Param collections query int false "Number of collections (0 = all)" default(0)
+// @Param lang query string false "Language" Enums(en, th, ja)
+// @Success 200 {object} utils.Response{data=[]dto.CollectionWithVideosResponse}
+// @Router /api/v1/collections/home [get]
+func (h *CollectionHandler) GetHomeCollections(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.CollectionsHomeRequest
+	if err := c.QueryParser(&req); err != nil {
+		logger.WarnContext(ctx, "Invalid query parameters", "error", err)
+		return utils.BadRequestResponse(c, "Invalid query parameters")
+	}
+
+	// Set defaults (CollectionCount = 0 means all collections)
+	if req.LimitPerCollection <= 0 {
+		req.LimitPerCollection = 4
+	}
+	if req.LimitPerCollection > 20 {
+		req.LimitPerCollection = 20
+	}
+	if req.Lang == "" {
+		req.Lang = "th"
+	}
+
+	result, err := h.collectionService.GetHomeSections(ctx, &req)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to get home collections", "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, result)
+}
+
+// collectionVideosPage - page/limit ของ video ใน collection (default 20, สูงสุด 100)
+func collectionVideosPage(c *fiber.Ctx) (int, int) {
+	page := c.QueryInt("page", 1)
+	limit := c.QueryInt("limit", 20)
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+	return page, limit
+}
@@ -26,6 +26,7 @@ func NewFeedHandler(feedService services.FeedService) *FeedHandler {
 // @Produce json
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
+// @Param cursor query string false "Opaque cursor from meta.nextCursor (empty = first page); switches to cursor pagination"
 // @Param lang query string false "Language" Enums(en, th, ja)
 // @Success 200 {object} utils.PaginatedResponse{data=[]dto.FeedItemResponse}
 // @Router /api/v1/feed [get]
@@ -52,6 +53,19 @@ func (h *FeedHandler) GetFeed(c *fiber.Ctx) error {
 		userID = &user.ID
 	}
 
+	// ?cursor= → keyset pagination สำหรับ infinite scroll (page/limit เดิมยังใช้ได้)
+	if utils.UseCursor(c) {
+		items, nextCursor, err := h.feedService.GetFeedAfter(ctx, c.Query("cursor"), limit, lang, userID)
+		if err != nil {
+			if err == utils.ErrInvalidCursor {
+				return utils.BadRequestResponse(c, "Invalid cursor")
+			}
+			logger.ErrorContext(ctx, "Failed to get feed", "error", err)
+			return utils.InternalServerErrorResponse(c)
+		}
+		return utils.CursorPaginatedSuccessResponse(c, items, nextCursor, limit)
+	}
+
 	items, total, err := h.feedService.GetFeed(ctx, page, limit, lang, userID)
 	if err != nil {
 		logger.ErrorContext(ctx, "Failed to get feed", "error", err)
@@ -68,6 +82,7 @@ func (h *FeedHandler) GetFeed(c *fiber.Ctx) error {
 // @Produce json
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(10)
+// @Param cursor query string false "Opaque cursor from meta.nextCursor (empty = first page); switches to cursor pagination"
 // @Param lang query string false "Language" Enums(en, th, ja)
 // @Success 200 {object} utils.PaginatedResponse{data=[]dto.ReelItemResponse}
 // @Router /api/v1/reels [get]
@@ -94,6 +109,19 @@ func (h *FeedHandler) GetReels(c *fiber.Ctx) error {
 		userID = &user.ID
 	}
 
+	// ?cursor= → keyset pagination สำหรับ infinite scroll (page/limit เดิมยังใช้ได้)
+	if utils.UseCursor(c) {
+		items, nextCursor, err := h.feedService.GetReelsAfter(ctx, c.Query("cursor"), limit, lang, userID)
+		if err != nil {
+			if err == utils.ErrInvalidCursor {
+				return utils.BadRequestResponse(c, "Invalid cursor")
+			}
+			logger.ErrorContext(ctx, "Failed to get reels", "error", err)
+			return utils.InternalServerErrorResponse(c)
+		}
+		return utils.CursorPaginatedSuccessResponse(c, items, nextCursor, limit)
+	}
+
 	items, total, err := h.feedService.GetReels(ctx, page, limit, lang, userID)
 	if err != nil {
 		logger.ErrorContext(ctx, "Failed to get reels", "error", err)
@@ -32,6 +32,29 @@ type Services struct {
 	ArticleLikeService     services.ArticleLikeService
 	ArticleCommentService  services.ArticleCommentService
 	SiteSettingService     services.SiteSettingService
+	SitemapService         services.SitemapService
+	ArticleFeedService     services.ArticleFeedService
+	ViewCounterService     services.ViewCounterService
+
+	// Article generator (admin)
+	ArticleGeneratorService services.ArticleGeneratorService
+	ArticleCoverageService  services.ArticleCoverageService
+
+	// Trending (time-decayed activity scores)
+	TrendingService services.TrendingService
+
+	// Trash (soft-deleted videos, articles, reels)
+	TrashService services.TrashService
+
+	// Translations (bulk import/export)
+	TranslationService services.TranslationService
+
+	// Catalogue export (streaming JSONL/CSV)
+	CatalogService services.CatalogService
+
+	// Series & curated collections
+	SeriesService     services.SeriesService
+	CollectionService services.CollectionService
 }
 
 // Repositories contains repositories needed for handlers that don't use services
@@ -67,6 +90,29 @@ type Handlers struct {
 	ArticleLikeHandler     *ArticleLikeHandler
 	ArticleCommentHandler  *ArticleCommentHandler
 	SiteSettingHandler     *SiteSettingHandler
+	SitemapHandler         *SitemapHandler
+	ArticleFeedHandler     *ArticleFeedHandler
+	ViewCounterHandler     *ViewCounterHandler
+
+	// Article generator (admin)
+	ArticleGeneratorHandler *ArticleGeneratorHandler
+	ArticleCoverageHandler  *ArticleCoverageHandler
+
+	// Trending
+	TrendingHandler *TrendingHandler
+
+	// Trash
+	TrashHandler *TrashHandler
+
+	// Translations
+	TranslationHandler *TranslationHandler
+
+	// Catalogue export
+	CatalogHandler *CatalogHandler
+
+	// Series & collections
+	SeriesHandler     *SeriesHandler
+	CollectionHandler *CollectionHandler
 }
 
 // NewHandlers creates a new instance of Handlers with all dependencies
@@ -97,5 +143,22 @@ func NewHandlers(services *Services, repos *Repositories, googleConfig config.Go
 		ArticleLikeHandler:    NewArticleLikeHandler(services.ArticleLikeService, services.XPService),
 		ArticleCommentHandler: NewArticleCommentHandler(services.ArticleCommentService, services.XPService),
 		SiteSettingHandler:    NewSiteSettingHandler(services.SiteSettingService),
+		SitemapHandler:        NewSitemapHandler(services.SitemapService),
+		ArticleFeedHandler:    NewArticleFeedHandler(services.ArticleFeedService),
+		ViewCounterHandler:    NewViewCounterHandler(services.ViewCounterService),
+
+		ArticleGeneratorHandler: NewArticleGeneratorHandler(services.ArticleGeneratorService),
+		ArticleCoverageHandler:  NewArticleCoverageHandler(services.ArticleCoverageService),
+
+		TrendingHandler: NewTrendingHandler(services.TrendingService),
+
+		TrashHandler: NewTrashHandler(services.TrashService),
+
+		TranslationHandler: NewTranslationHandler(services.TranslationService),
+
+		CatalogHandler: NewCatalogHandler(services.CatalogService),
+
+		SeriesHandler:     NewSeriesHandler(services.SeriesService),
+		CollectionHandler: NewCollectionHandler(services.CollectionService),
 	}
 }
\ No newline at end of file
@@ -89,6 +89,7 @@ func (h *ReelCommentHandler) CreateComment(c *fiber.Ctx) error {
 // @Param id path string true "Reel ID"
 // @Param page query int false "Page number" default(1)
 // @Param limit query int false "Items per page" default(20)
+// @Param cursor query string false "Opaque cursor from meta.nextCursor (empty = first page); switches to cursor pagination"
 // @Success 200 {object} utils.PaginatedResponse
 // @Router /api/v1/reels/{id}/comments [get]
 func (h *ReelCommentHandler) ListComments(c *fiber.Ctx) error {
@@ -112,6 +113,18 @@ func (h *ReelCommentHandler) ListComments(c *fiber.Ctx) error {
 		limit = 20
 	}
 
+	// Cursor pagination (?cursor=, empty = first page)
+	if utils.UseCursor(c) {
+		comments, nextCursor, err := h.commentService.ListByReelAfter(ctx, reelID, c.Query("cursor"), limit)
+		if err != nil {
+			if err == utils.ErrInvalidCursor {
+				return utils.BadRequestResponse(c, "Invalid cursor")
+			}
+			return utils.InternalServerErrorResponse(c)
+		}
+		return utils.CursorPaginatedSuccessResponse(c, comments, nextCursor, limit)
+	}
+
 	// Get comments
 	comments, total, err := h.commentService.ListByReel(ctx, reelID, page, limit)
 	if err != nil {
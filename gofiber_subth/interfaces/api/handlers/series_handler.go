@@ -0,0 +1,280 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/services"
+	"gofiber-template/pkg/logger"
+	"gofiber-template/pkg/utils"
+)
+
+type SeriesHandler struct {
+	seriesService services.SeriesService
+}
+
+func NewSeriesHandler(seriesService services.SeriesService) *SeriesHandler {
+	return &SeriesHandler{
+		seriesService: seriesService,
+	}
+}
+
+// CreateSeries godoc
+// @Summary Create a new series
+// @Tags series
+// @Accept json
+// @Produce json
+// @Param series body dto.CreateSeriesRequest true "Series data"
+// @Success 201 {object} utils.Response{data=dto.SeriesDetailResponse}
+// @Router /api/v1/series [post]
+func (h *SeriesHandler) CreateSeries(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.CreateSeriesRequest
+	if err := c.BodyParser(&req); err != nil {
+		logger.WarnContext(ctx, "Invalid request body", "error", err)
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+
+	if err := utils.ValidateStruct(&req); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	series, err := h.seriesService.CreateSeries(ctx, &req)
+	if err != nil {
+		switch err.Error() {
+		case "series already exists":
+			return utils.ConflictResponse(c, "Series already exists")
+		case "invalid language":
+			return utils.BadRequestResponse(c, "Invalid translation language")
+		}
+		logger.ErrorContext(ctx, "Failed to create series", "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	logger.InfoContext(ctx, "Series created", "series_id", series.ID)
+	return utils.CreatedResponse(c, series)
+}
+
+// UpdateSeries godoc
+// @Summary Update series
+// @Tags series
+// @Accept json
+// @Produce json
+// @Param id path string true "Series ID"
+// @Param series body dto.UpdateSeriesRequest true "Series data"
+// @Success 200 {object} utils.Response{data=dto.SeriesDetailResponse}
+// @Router /api/v1/series/{id} [put]
+func (h *SeriesHandler) UpdateSeries(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid series ID")
+	}
+
+	var req dto.UpdateSeriesRequest
+	if err := c.BodyParser(&req); err != nil {
+		logger.WarnContext(ctx, "Invalid request body", "error", err)
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+
+	if err := utils.ValidateStruct(&req); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	series, err := h.seriesService.UpdateSeries(ctx, id, &req)
+	if err != nil {
+		switch err.Error() {
+		case "series not found":
+			return utils.NotFoundResponse(c, "Series not found")
+		case "series already exists":
+			return utils.ConflictResponse(c, "Series already exists")
+		case "invalid language":
+			return utils.BadRequestResponse(c, "Invalid translation language")
+		}
+		logger.ErrorContext(ctx, "Failed to update series", "series_id", id, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	logger.InfoContext(ctx, "Series updated", "series_id", id)
+	return utils.SuccessResponse(c, series)
+}
+
+// DeleteSeries godoc
+// @Summary Delete series (videos are kept)
+// @Tags series
+// @Produce json
+// @Param id path string true "Series ID"
+// @Success 200 {object} utils.Response
+// @Router /api/v1/series/{id} [delete]
+func (h *SeriesHandler) DeleteSeries(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid series ID")
+	}
+
+	if err := h.seriesService.DeleteSeries(ctx, id); err != nil {
+		if err.Error() == "series not found" {
+			return utils.NotFoundResponse(c, "Series not found")
+		}
+		logger.ErrorContext(ctx, "Failed to delete series", "series_id", id, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	logger.InfoContext(ctx, "Series deleted", "series_id", id)
+	return utils.SuccessResponse(c, fiber.Map{"message": "Series deleted successfully"})
+}
+
+// SetSeriesVideos godoc
+// @Summary Replace series episodes (in order)
+// @Tags series
+// @Accept json
+// @Produce json
+// @Param id path string true "Series ID"
+// @Param videos body dto.SetGroupVideosRequest true "Video IDs in episode order"
+// @Success 200 {object} utils.Response{data=dto.SeriesDetailResponse}
+// @Router /api/v1/series/{id}/videos [put]
+func (h *SeriesHandler) SetSeriesVideos(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid series ID")
+	}
+
+	var req dto.SetGroupVideosRequest
+	if err := c.BodyParser(&req); err != nil {
+		logger.WarnContext(ctx, "Invalid request body", "error", err)
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+
+	if err := utils.ValidateStruct(&req); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	series, err := h.seriesService.SetSeriesVideos(ctx, id, &req)
+	if err != nil {
+		switch err.Error() {
+		case "series not found":
+			return utils.NotFoundResponse(c, "Series not found")
+		case "video not found":
+			return utils.BadRequestResponse(c, "One or more videos not found")
+		case "duplicate video":
+			return utils.BadRequestResponse(c, "Duplicate video in list")
+		case "video already in another series":
+			return utils.ConflictResponse(c, "Video already in another series")
+		}
+		logger.ErrorContext(ctx, "Failed to set series videos", "series_id", id, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, series)
+}
+
+// GetSeries godoc
+// @Summary Get series by ID (with episodes)
+// @Tags series
+// @Produce json
+// @Param id path string true "Series ID"
+// @Param lang query string false "Language" Enums(en, th, ja)
+// @Success 200 {object} utils.Response{data=dto.SeriesDetailResponse}
+// @Router /api/v1/series/{id} [get]
+func (h *SeriesHandler) GetSeries(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid series ID")
+	}
+
+	lang := c.Query("lang", "en")
+
+	series, err := h.seriesService.GetSeries(ctx, id, lang)
+	if err != nil {
+		if err.Error() == "series not found" {
+			return utils.NotFoundResponse(c, "Series not found")
+		}
+		logger.ErrorContext(ctx, "Failed to get series", "series_id", id, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, series)
+}
+
+// GetSeriesBySlug godoc
+// @Summary Get series by slug (with episodes)
+// @Tags series
+// @Produce json
+// @Param slug path string true "Series slug"
+// @Param lang query string false "Language" Enums(en, th, ja)
+// @Success 200 {object} utils.Response{data=dto.SeriesDetailResponse}
+// @Router /api/v1/series/slug/{slug} [get]
+func (h *SeriesHandler) GetSeriesBySlug(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	slug := c.Params("slug")
+	lang := c.Query("lang", "en")
+
+	series, err := h.seriesService.GetSeriesBySlug(ctx, slug, lang)
+	if err != nil {
+		if err.Error() == "series not found" {
+			return utils.NotFoundResponse(c, "Series not found")
+		}
+		logger.ErrorContext(ctx, "Failed to get series by slug", "slug", slug, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, series)
+}
+
+// ListSeries godoc
+// @Summary List series with pagination
+// @Tags series
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Param lang query string false "Language" Enums(en, th, ja)
+// @Param search query string false "Search by name"
+// @Success 200 {object} utils.PaginatedResponse{data=[]dto.SeriesResponse}
+// @Router /api/v1/series [get]
+func (h *SeriesHandler) ListSeries(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.SeriesListRequest
+	if err := c.QueryParser(&req); err != nil {
+		logger.WarnContext(ctx, "Invalid query parameters", "error", err)
+		return utils.BadRequestResponse(c, "Invalid query parameters")
+	}
+
+	// Default values
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.Limit < 1 {
+		req.Limit = 20
+	}
+	if req.Limit > 100 {
+		req.Limit = 100
+	}
+	if req.Lang == "" {
+		req.Lang = "en"
+	}
+
+	series, total, err := h.seriesService.ListSeries(ctx, &req)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list series", "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.PaginatedSuccessResponse(c, series, total, req.Page, req.Limit)
+}
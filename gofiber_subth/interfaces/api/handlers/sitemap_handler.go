@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"gofiber-template/domain/services"
+	"gofiber-template/pkg/logger"
+	"gofiber-template/pkg/utils"
+)
+
+type SitemapHandler struct {
+	sitemapService services.SitemapService
+}
+
+func NewSitemapHandler(sitemapService services.SitemapService) *SitemapHandler {
+	return &SitemapHandler{
+		sitemapService: sitemapService,
+	}
+}
+
+// GetSitemap godoc
+// @Summary Get sitemap XML file
+// @Description Returns sitemap index (index.xml) or a child sitemap such as articles-1.xml
+// @Tags sitemaps
+// @Produce xml
+// @Param name path string true "Sitemap file name"
+// @Success 200 {string} string "XML"
+// @Router /api/v1/sitemaps/{name} [get]
+func (h *SitemapHandler) GetSitemap(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+	name := c.Params("name")
+
+	data, err := h.sitemapService.GetFile(ctx, name)
+	if err != nil {
+		if err.Error() == "sitemap not found" {
+			return utils.NotFoundResponse(c, "Sitemap not found")
+		}
+		logger.ErrorContext(ctx, "Failed to get sitemap", "name", name, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	c.Set("Content-Type", "application/xml; charset=utf-8")
+	c.Set("Cache-Control", "public, max-age=3600, s-maxage=3600")
+	return c.Send(data)
+}
+
+// RebuildSitemaps godoc
+// @Summary Rebuild all sitemaps now (Admin)
+// @Tags sitemaps
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} utils.Response{data=dto.SitemapBuildResponse}
+// @Router /api/v1/sitemaps/rebuild [post]
+func (h *SitemapHandler) RebuildSitemaps(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	result, err := h.sitemapService.Rebuild(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to rebuild sitemaps", "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, result)
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/services"
+	"gofiber-template/pkg/logger"
+	"gofiber-template/pkg/utils"
+)
+
+type TranslationHandler struct {
+	translationService services.TranslationService
+}
+
+func NewTranslationHandler(translationService services.TranslationService) *TranslationHandler {
+	return &TranslationHandler{
+		translationService: translationService,
+	}
+}
+
+// ExportTranslations godoc
+// @Summary Export translations as CSV or JSONL (admin)
+// @Description คอลัมน์ type,id,key,lang,source,text — แก้คอลัมน์ text แล้ว import กลับได้
+// @Tags translations
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Security BearerAuth
+// @Param type query string true "Entity type" Enums(video, cast, tag, category)
+// @Param lang query string true "Language" Enums(en, th, ja)
+// @Param format query string false "File format" Enums(csv, jsonl) default(csv)
+// @Param missing query bool false "Only entries without a translation"
+// @Success 200 {string} string "File"
+// @Router /api/v1/translations/export [get]
+func (h *TranslationHandler) ExportTranslations(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.TranslationExportRequest
+	if err := c.QueryParser(&req); err != nil {
+		logger.WarnContext(ctx, "Invalid query parameters", "error", err)
+		return utils.BadRequestResponse(c, "Invalid query parameters")
+	}
+
+	if err := utils.ValidateStruct(&req); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	file, err := h.translationService.Export(ctx, &req)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to export translations", "type", req.Type, "lang", req.Lang, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	c.Set("Content-Type", file.ContentType)
+	c.Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, file.Filename))
+	c.Set("X-Total-Count", fmt.Sprintf("%d", file.Count))
+	return c.Send(file.Data)
+}
+
+// ImportTranslations godoc
+// @Summary Import translations from CSV or JSONL (admin)
+// @Description รับ multipart field "file" หรือ raw body; มีแถวผิดแม้แถวเดียวจะไม่เขียนอะไรเลย, dry_run=true คืน diff อย่างเดียว
+// @Tags translations
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file false "Translation file"
+// @Param format query string false "File format (default from file extension, else csv)" Enums(csv, jsonl)
+// @Param dry_run query bool false "Validate and diff without writing"
+// @Success 200 {object} utils.Response{data=dto.TranslationImportResult}
+// @Router /api/v1/translations/import [post]
+func (h *TranslationHandler) ImportTranslations(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.TranslationImportRequest
+	if err := c.QueryParser(&req); err != nil {
+		logger.WarnContext(ctx, "Invalid query parameters", "error", err)
+		return utils.BadRequestResponse(c, "Invalid query parameters")
+	}
+
+	if err := utils.ValidateStruct(&req); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	var body io.Reader
+	if file, err := c.FormFile("file"); err == nil {
+		if req.Format == "" && strings.HasSuffix(strings.ToLower(file.Filename), ".jsonl") {
+			req.Format = dto.TranslationFormatJSONL
+		}
+		f, err := file.Open()
+		if err != nil {
+			logger.ErrorContext(ctx, "Failed to open uploaded file", "filename", file.Filename, "error", err)
+			return utils.InternalServerErrorResponse(c)
+		}
+		defer f.Close()
+		body = f
+	} else {
+		body = bytes.NewReader(c.Body())
+	}
+
+	result, err := h.translationService.Import(ctx, &req, body)
+	if err != nil {
+		switch err.Error() {
+		case "invalid translation file":
+			return utils.BadRequestResponse(c, "Invalid translation file")
+		case "too many translation rows":
+			return utils.BadRequestResponse(c, "Too many rows in translation file")
+		}
+		logger.ErrorContext(ctx, "Failed to import translations", "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, result)
+}
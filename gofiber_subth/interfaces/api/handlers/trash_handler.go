@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/services"
+	"gofiber-template/pkg/logger"
+	"gofiber-template/pkg/utils"
+)
+
+type TrashHandler struct {
+	trashService services.TrashService
+}
+
+func NewTrashHandler(trashService services.TrashService) *TrashHandler {
+	return &TrashHandler{
+		trashService: trashService,
+	}
+}
+
+// ListTrash godoc
+// @Summary List soft-deleted videos, articles and reels (admin)
+// @Description ลบล่าสุดก่อน รายการจะถูกลบถาวรอัตโนมัติหลัง purgeAt
+// @Tags trash
+// @Produce json
+// @Security BearerAuth
+// @Param type query string false "Type" Enums(video, article, reel)
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} utils.Response{data=[]dto.TrashItemResponse}
+// @Router /api/v1/trash [get]
+func (h *TrashHandler) ListTrash(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.TrashListRequest
+	if err := c.QueryParser(&req); err != nil {
+		logger.WarnContext(ctx, "Invalid query parameters", "error", err)
+		return utils.BadRequestResponse(c, "Invalid query parameters")
+	}
+
+	if err := utils.ValidateStruct(&req); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	items, total, err := h.trashService.ListTrash(ctx, &req)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to list trash", "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.PaginatedSuccessResponse(c, items, total, req.Page, req.Limit)
+}
+
+// RestoreTrash godoc
+// @Summary Restore a video, article or reel from trash (admin)
+// @Description video จะคืนบทความ/reels ที่ถูกลบพร้อมกันด้วย
+// @Tags trash
+// @Produce json
+// @Security BearerAuth
+// @Param type path string true "Type" Enums(video, article, reel)
+// @Param id path string true "Item ID"
+// @Success 200 {object} utils.Response
+// @Router /api/v1/trash/{type}/{id}/restore [post]
+func (h *TrashHandler) RestoreTrash(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	itemType := c.Params("type")
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid ID")
+	}
+
+	if err := h.trashService.Restore(ctx, itemType, id); err != nil {
+		switch err.Error() {
+		case "invalid trash type":
+			return utils.BadRequestResponse(c, "Invalid trash type")
+		case "not found in trash":
+			return utils.NotFoundResponse(c, "Item not found in trash")
+		case "video is in trash":
+			return utils.ConflictResponse(c, "Restore the video first")
+		case "article slug conflict":
+			return utils.ConflictResponse(c, "An active article already uses this slug or video language")
+		}
+		logger.ErrorContext(ctx, "Failed to restore from trash", "type", itemType, "id", id, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, map[string]interface{}{
+		"type":     itemType,
+		"id":       id.String(),
+		"restored": true,
+	})
+}
+
+// PurgeTrash godoc
+// @Summary Permanently delete a video, article or reel from trash (admin)
+// @Description ลบถาวรทันที + ล้างไฟล์ใน storage (ย้อนกลับไม่ได้)
+// @Tags trash
+// @Produce json
+// @Security BearerAuth
+// @Param type path string true "Type" Enums(video, article, reel)
+// @Param id path string true "Item ID"
+// @Success 204
+// @Router /api/v1/trash/{type}/{id} [delete]
+func (h *TrashHandler) PurgeTrash(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	itemType := c.Params("type")
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid ID")
+	}
+
+	if err := h.trashService.Purge(ctx, itemType, id); err != nil {
+		switch err.Error() {
+		case "invalid trash type":
+			return utils.BadRequestResponse(c, "Invalid trash type")
+		case "not found in trash":
+			return utils.NotFoundResponse(c, "Item not found in trash")
+		}
+		logger.ErrorContext(ctx, "Failed to purge from trash", "type", itemType, "id", id, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.NoContentResponse(c)
+}
+
+// PurgeExpiredTrash godoc
+// @Summary Purge trash items past the retention period now (admin)
+// @Tags trash
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} utils.Response{data=dto.TrashPurgeResult}
+// @Router /api/v1/trash/purge-expired [post]
+func (h *TrashHandler) PurgeExpiredTrash(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	result, err := h.trashService.PurgeExpired(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to purge expired trash", "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, result)
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"gofiber-template/domain/dto"
+	"gofiber-template/domain/services"
+	"gofiber-template/pkg/logger"
+	"gofiber-template/pkg/utils"
+)
+
+type TrendingHandler struct {
+	trendingService services.TrendingService
+}
+
+func NewTrendingHandler(trendingService services.TrendingService) *TrendingHandler {
+	return &TrendingHandler{
+		trendingService: trendingService,
+	}
+}
+
+// GetTrending godoc
+// @Summary Get trending videos, casts, tags or makers
+// @Description อันดับจากคะแนน activity ที่ decay ตามเวลา (คำนวณใหม่ทุก 15 นาที)
+// @Tags trending
+// @Produce json
+// @Param kind query string false "Kind" Enums(video, cast, tag, maker) default(video)
+// @Param window query string false "Window" Enums(24h, 7d, 30d) default(24h)
+// @Param lang query string false "Language" Enums(en, th, ja)
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} utils.Response{data=dto.TrendingResponse}
+// @Router /api/v1/trending [get]
+func (h *TrendingHandler) GetTrending(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.TrendingRequest
+	if err := c.QueryParser(&req); err != nil {
+		logger.WarnContext(ctx, "Invalid query parameters", "error", err)
+		return utils.BadRequestResponse(c, "Invalid query parameters")
+	}
+
+	if err := utils.ValidateStruct(&req); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	result, err := h.trendingService.GetTrending(ctx, &req)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to get trending", "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, result)
+}
+
+// RefreshTrending godoc
+// @Summary Recompute trending scores now (admin)
+// @Tags trending
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} utils.Response{data=dto.TrendingRefreshResult}
+// @Router /api/v1/trending/refresh [post]
+func (h *TrendingHandler) RefreshTrending(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	result, err := h.trendingService.Refresh(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to refresh trending", "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, result)
+}
@@ -117,6 +117,11 @@ func (h *VideoHandler) GetVideo(c *fiber.Ctx) error {
 		return utils.InternalServerErrorResponse(c)
 	}
 
+	// video ที่ถูก merge ไปแล้ว → 301 ไป video ที่เหลืออยู่
+	if video.Redirect != nil {
+		return utils.RedirectResponse(c, video.Redirect.Path, video)
+	}
+
 	return utils.SuccessResponse(c, video)
 }
 
@@ -197,6 +202,7 @@ func (h *VideoHandler) DeleteVideo(c *fiber.Ctx) error {
 // @Param auto_tags query string false "Filter by auto tags (comma-separated)"
 // @Param sort_by query string false "Sort by field" Enums(created_at, date)
 // @Param order query string false "Sort order" Enums(asc, desc)
+// @Param cursor query string false "Opaque cursor from meta.nextCursor (empty = first page); switches to cursor pagination"
 // @Success 200 {object} utils.PaginatedResponse{data=[]dto.VideoListItemResponse}
 // @Router /api/v1/videos [get]
 func (h *VideoHandler) ListVideos(c *fiber.Ctx) error {
@@ -222,6 +228,19 @@ func (h *VideoHandler) ListVideos(c *fiber.Ctx) error {
 		req.Lang = "en"
 	}
 
+	// ?cursor= → keyset pagination (page/limit เดิมยังใช้ได้สำหรับ admin)
+	if utils.UseCursor(c) {
+		videos, nextCursor, err := h.videoService.ListVideosAfter(ctx, &req)
+		if err != nil {
+			if err == utils.ErrInvalidCursor {
+				return utils.BadRequestResponse(c, "Invalid cursor")
+			}
+			logger.ErrorContext(ctx, "Failed to list videos", "error", err)
+			return utils.InternalServerErrorResponse(c)
+		}
+		return utils.CursorPaginatedSuccessResponse(c, videos, nextCursor, req.Limit)
+	}
+
 	videos, total, err := h.videoService.ListVideos(ctx, &req)
 	if err != nil {
 		logger.ErrorContext(ctx, "Failed to list videos", "error", err)
@@ -231,6 +250,47 @@ func (h *VideoHandler) ListVideos(c *fiber.Ctx) error {
 	return utils.PaginatedSuccessResponse(c, videos, total, req.Page, req.Limit)
 }
 
+// FacetedSearch godoc
+// @Summary Faceted video search
+// @Description filter หลายค่าต่อมิติ (comma separated, *_mode=and|or) + จำนวนต่อ maker/cast/tag/category/auto tag
+// @Tags videos
+// @Produce json
+// @Param casts query string false "Cast IDs (comma separated)"
+// @Param cast_mode query string false "and | or" Enums(and, or)
+// @Param released_from query string false "YYYY-MM-DD"
+// @Param released_to query string false "YYYY-MM-DD"
+// @Success 200 {object} utils.Response{data=dto.VideoFacetSearchResponse}
+// @Router /api/v1/videos/facets [get]
+func (h *VideoHandler) FacetedSearch(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.VideoFacetSearchRequest
+	if err := c.QueryParser(&req); err != nil {
+		logger.WarnContext(ctx, "Invalid query parameters", "error", err)
+		return utils.BadRequestResponse(c, "Invalid query parameters")
+	}
+
+	if err := utils.ValidateStruct(&req); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	result, err := h.videoService.FacetedSearch(ctx, &req)
+	if err != nil {
+		switch err.Error() {
+		case "invalid facet id":
+			return utils.BadRequestResponse(c, "Invalid maker, cast, tag or category ID")
+		case "invalid release date range":
+			return utils.BadRequestResponse(c, "released_to must not be before released_from")
+		}
+		logger.ErrorContext(ctx, "Failed to run faceted video search", "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, result)
+}
+
 // GetRandomVideos godoc
 // @Summary Get random videos
 // @Tags videos
@@ -510,3 +570,141 @@ func (h *VideoHandler) GetVideosByEmbedCodes(c *fiber.Ctx) error {
 
 	return utils.SuccessResponse(c, videos)
 }
+
+// ListDuplicates godoc
+// @Summary List groups of likely duplicate videos (admin)
+// @Description จัดกลุ่มจาก code (normalize แล้ว), embed URL และ thumbnail
+// @Tags videos
+// @Produce json
+// @Security BearerAuth
+// @Param match query string false "Match only by" Enums(code, embed, thumbnail)
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Groups per page" default(20)
+// @Success 200 {object} utils.Response{data=[]dto.VideoDuplicateGroupResponse}
+// @Router /api/v1/videos/duplicates [get]
+func (h *VideoHandler) ListDuplicates(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var params dto.VideoDuplicateListParams
+	if err := c.QueryParser(&params); err != nil {
+		logger.WarnContext(ctx, "Invalid query parameters", "error", err)
+		return utils.BadRequestResponse(c, "Invalid query parameters")
+	}
+
+	if err := utils.ValidateStruct(&params); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	groups, total, err := h.videoService.FindDuplicates(ctx, &params)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to find duplicate videos", "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.PaginatedSuccessResponse(c, groups, total, params.Page, params.Limit)
+}
+
+// MergeVideos godoc
+// @Summary Merge duplicate videos into one (admin)
+// @Description ย้าย translations, casts, tags, categories, reels, บทความ ไปที่ targetId แล้วลบ sourceIds (ID เดิม redirect ไป target)
+// @Tags videos
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.MergeVideosRequest true "Merge request"
+// @Success 200 {object} utils.Response{data=dto.MergeVideosResponse}
+// @Router /api/v1/videos/merge [post]
+func (h *VideoHandler) MergeVideos(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	user, err := utils.GetUserFromContext(c)
+	if err != nil {
+		return utils.UnauthorizedResponse(c, "User not authenticated")
+	}
+
+	var req dto.MergeVideosRequest
+	if err := c.BodyParser(&req); err != nil {
+		logger.WarnContext(ctx, "Invalid request body", "error", err)
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+
+	if err := utils.ValidateStruct(&req); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	result, err := h.videoService.MergeVideos(ctx, user.ID, &req)
+	if err != nil {
+		switch err.Error() {
+		case "invalid video ID":
+			return utils.BadRequestResponse(c, "Invalid video ID")
+		case "cannot merge video into itself":
+			return utils.BadRequestResponse(c, "targetId must not be in sourceIds")
+		case "video not found":
+			return utils.NotFoundResponse(c, "Video not found")
+		case "article language conflict":
+			return utils.ConflictResponse(c, "More than one video has an article in the same language; delete the unwanted article first")
+		}
+		logger.ErrorContext(ctx, "Failed to merge videos", "target_id", req.TargetID, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, result)
+}
+
+// BulkEditVideos godoc
+// @Summary Bulk edit casts, tags, categories, maker, auto tags and SEO status (admin)
+// @Description เลือก video ด้วย selector (ids, codes หรือ filter อย่างใดอย่างหนึ่ง) แล้วทำ operations ตามลำดับใน transaction เดียว, dryRun = true คืนผลโดยไม่เขียน
+// @Tags videos
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.BulkVideoEditRequest true "Bulk edit request"
+// @Success 200 {object} utils.Response{data=dto.BulkVideoEditResponse}
+// @Router /api/v1/videos/bulk-edit [post]
+func (h *VideoHandler) BulkEditVideos(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	var req dto.BulkVideoEditRequest
+	if err := c.BodyParser(&req); err != nil {
+		logger.WarnContext(ctx, "Invalid request body", "error", err)
+		return utils.BadRequestResponse(c, "Invalid request body")
+	}
+
+	if err := utils.ValidateStruct(&req); err != nil {
+		errors := utils.GetValidationErrors(err)
+		logger.WarnContext(ctx, "Validation failed", "errors", errors)
+		return utils.ValidationErrorResponse(c, errors)
+	}
+
+	result, err := h.videoService.BulkEditVideos(ctx, &req)
+	if err != nil {
+		switch err.Error() {
+		case "invalid selector":
+			return utils.BadRequestResponse(c, "Selector must contain exactly one of ids, codes or a non-empty filter")
+		case "invalid video ID":
+			return utils.BadRequestResponse(c, "Invalid video ID")
+		case "invalid operation":
+			return utils.BadRequestResponse(c, "Invalid operation: add/remove need ids, set_maker needs a maker ID or empty value, set_seo_status needs pending, draft or published")
+		case "too many videos selected":
+			return utils.BadRequestResponse(c, "Filter matches too many videos; narrow it down")
+		case "unknown auto tag":
+			return utils.BadRequestResponse(c, "Unknown auto tag")
+		case "cast not found":
+			return utils.NotFoundResponse(c, "Cast not found")
+		case "tag not found":
+			return utils.NotFoundResponse(c, "Tag not found")
+		case "category not found":
+			return utils.NotFoundResponse(c, "Category not found")
+		case "maker not found":
+			return utils.NotFoundResponse(c, "Maker not found")
+		}
+		logger.ErrorContext(ctx, "Failed to bulk edit videos", "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, result)
+}
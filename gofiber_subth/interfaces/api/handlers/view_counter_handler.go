@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"gofiber-template/domain/services"
+	"gofiber-template/pkg/logger"
+	"gofiber-template/pkg/utils"
+)
+
+type ViewCounterHandler struct {
+	service services.ViewCounterService
+}
+
+func NewViewCounterHandler(service services.ViewCounterService) *ViewCounterHandler {
+	return &ViewCounterHandler{service: service}
+}
+
+// RecordArticleView นับยอดวิวบทความ (POST /api/v1/articles/:id/view)
+// @Summary Record article view
+// @Description นับยอดวิว (visitor เดิมภายใน 30 นาทีนับครั้งเดียว) ยอดจะลง DB ภายใน 1 นาที
+// @Tags Views
+// @Produce json
+// @Param id path string true "Article ID"
+// @Success 200 {object} utils.Response{data=dto.ViewCountResponse}
+// @Failure 400 {object} utils.ErrorResponse
+// @Router /articles/{id}/view [post]
+func (h *ViewCounterHandler) RecordArticleView(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	articleID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid article ID")
+	}
+
+	result, err := h.service.RecordArticleView(ctx, articleID, visitorID(c))
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to record article view", "article_id", articleID, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, result)
+}
+
+// RecordVideoView นับยอดวิววิดีโอ (POST /api/v1/videos/:id/view)
+// @Summary Record video view
+// @Description นับยอดวิว (visitor เดิมภายใน 30 นาทีนับครั้งเดียว) ยอดจะลง DB ภายใน 1 นาที
+// @Tags Views
+// @Produce json
+// @Param id path string true "Video ID"
+// @Success 200 {object} utils.Response{data=dto.ViewCountResponse}
+// @Failure 400 {object} utils.ErrorResponse
+// @Router /videos/{id}/view [post]
+func (h *ViewCounterHandler) RecordVideoView(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	videoID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return utils.BadRequestResponse(c, "Invalid video ID")
+	}
+
+	result, err := h.service.RecordVideoView(ctx, videoID, visitorID(c))
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to record video view", "video_id", videoID, "error", err)
+		return utils.InternalServerErrorResponse(c)
+	}
+
+	return utils.SuccessResponse(c, result)
+}
+
+// visitorID ระบุตัว visitor สำหรับกันนับซ้ำ
+// login แล้วใช้ user ID, ถ้าไม่ login ใช้ hash ของ IP + User-Agent (ไม่เก็บ IP ตรงๆ ใน Redis)
+func visitorID(c *fiber.Ctx) string {
+	if user, err := utils.GetUserFromContext(c); err == nil {
+		return "u:" + user.ID.String()
+	}
+	sum := sha256.Sum256([]byte(c.IP() + "|" + c.Get("User-Agent")))
+	return "a:" + hex.EncodeToString(sum[:12])
+}
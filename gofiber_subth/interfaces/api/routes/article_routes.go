@@ -12,13 +12,28 @@ func SetupArticleRoutes(api fiber.Router, h *handlers.Handlers) {
 	// Internal API for worker to ingest articles
 	articles.Post("/ingest", h.ArticleHandler.IngestArticle)
 
+	// Internal API: "needs article" queue (worker claim งานแบบ lease, ingest = ปิดงาน)
+	articles.Post("/queue/claim", h.ArticleCoverageHandler.ClaimQueueItems)
+	articles.Post("/queue/:id/extend", h.ArticleCoverageHandler.ExtendLease)
+	articles.Post("/queue/:id/release", h.ArticleCoverageHandler.ReleaseLease)
+
 	// Public API (must be before :id to avoid conflict)
 	articles.Get("/public", h.ArticleHandler.ListPublishedArticles)        // List published articles
+	articles.Get("/public/search", h.ArticleHandler.SearchPublishedArticles) // Full-text search (ranked + facets)
 	articles.Get("/slug/:slug", h.ArticleHandler.GetPublishedArticle)      // Get single article by slug (deprecated)
 	articles.Get("/cast/:slug", h.ArticleHandler.ListArticlesByCast)       // List articles by cast
 	articles.Get("/tag/:slug", h.ArticleHandler.ListArticlesByTag)         // List articles by tag
 	articles.Get("/maker/:slug", h.ArticleHandler.ListArticlesByMaker)     // List articles by maker
 
+	// Preview (signed token จาก admin, ได้ทุกสถานะ)
+	articles.Get("/preview/:token", h.ArticleHandler.GetPreviewArticle)
+
+	// RSS/Atom feeds (:format = rss | atom)
+	articles.Get("/feed/:format", h.ArticleFeedHandler.GetLatestFeed)
+	articles.Get("/cast/:slug/feed/:format", h.ArticleFeedHandler.GetCastFeed)
+	articles.Get("/tag/:slug/feed/:format", h.ArticleFeedHandler.GetTagFeed)
+	articles.Get("/maker/:slug/feed/:format", h.ArticleFeedHandler.GetMakerFeed)
+
 	// Type-based article routes (new URL structure)
 	// GET /api/v1/articles/:type/:slug (e.g., /articles/review/dass-541)
 	articles.Get("/review/:slug", h.ArticleHandler.GetPublishedArticleByType)   // Review articles
@@ -27,9 +42,18 @@ func SetupArticleRoutes(api fiber.Router, h *handlers.Handlers) {
 	articles.Get("/guide/:slug", h.ArticleHandler.GetPublishedArticleByType)    // Guide articles
 	articles.Get("/news/:slug", h.ArticleHandler.GetPublishedArticleByType)     // News articles
 
+	// Related articles ("อ่านต่อ")
+	// GET /api/v1/articles/:type/:slug/related
+	articles.Get("/review/:slug/related", h.ArticleHandler.GetRelatedArticles)
+	articles.Get("/ranking/:slug/related", h.ArticleHandler.GetRelatedArticles)
+	articles.Get("/best-of/:slug/related", h.ArticleHandler.GetRelatedArticles)
+	articles.Get("/guide/:slug/related", h.ArticleHandler.GetRelatedArticles)
+	articles.Get("/news/:slug/related", h.ArticleHandler.GetRelatedArticles)
+
 	// Like/Comment (public read, protected write)
 	articles.Get("/:id/like", middleware.Optional(), h.ArticleLikeHandler.GetStatus) // Get like status (optional auth)
 	articles.Post("/:id/like", middleware.Protected(), h.ArticleLikeHandler.Toggle)            // Toggle like
+	articles.Post("/:id/view", middleware.Optional(), h.ViewCounterHandler.RecordArticleView)     // Record view (dedup per visitor)
 	articles.Get("/:id/comments", h.ArticleCommentHandler.List)                                // List comments
 	articles.Post("/:id/comments", middleware.Protected(), h.ArticleCommentHandler.Create)    // Create comment
 	articles.Put("/:id/comments/:commentId", middleware.Protected(), h.ArticleCommentHandler.Update)    // Update comment
@@ -38,10 +62,41 @@ func SetupArticleRoutes(api fiber.Router, h *handlers.Handlers) {
 	// Admin routes
 	articles.Get("/", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.ListArticles)
 	articles.Get("/stats", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.GetStats)
+	articles.Get("/redirects", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.ListRedirects)
+	articles.Post("/redirects", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.CreateRedirect)
+	articles.Delete("/redirects/:redirectId", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.DeleteRedirect)
+	articles.Post("/content/validate", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.ValidateStoredContent)
+	articles.Post("/generate", middleware.Protected(), middleware.AdminOnly(), h.ArticleGeneratorHandler.GenerateArticle)
+	articles.Get("/review-queue", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.ListReviewQueue)
+	articles.Get("/coverage", middleware.Protected(), middleware.AdminOnly(), h.ArticleCoverageHandler.GetCoverage)
+	articles.Get("/coverage/missing", middleware.Protected(), middleware.AdminOnly(), h.ArticleCoverageHandler.ListMissingVideos)
+	articles.Post("/coverage/enqueue", middleware.Protected(), middleware.AdminOnly(), h.ArticleCoverageHandler.EnqueueMissing)
+	articles.Get("/queue", middleware.Protected(), middleware.AdminOnly(), h.ArticleCoverageHandler.ListQueue)
 	articles.Get("/:id", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.GetArticle)
 	articles.Patch("/:id/status", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.UpdateStatus)
 	articles.Post("/bulk-schedule", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.BulkSchedule)
+	articles.Get("/schedule-plan/policy", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.GetSchedulePolicy)
+	articles.Post("/schedule-plan/preview", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.PreviewSchedulePlan)
+	articles.Post("/schedule-plan/apply", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.ApplySchedulePlan)
+	articles.Post("/schedule-plan/rebalance", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.RebalanceSchedule)
 	articles.Delete("/:id", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.DeleteArticle)
+	articles.Put("/:id", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.UpdateArticle)
+
+	// Editorial review (Admin)
+	articles.Post("/:id/review/assign", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.AssignReviewer)
+	articles.Post("/:id/review/decision", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.DecideReview)
+	articles.Get("/:id/status-history", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.ListStatusHistory)
+
+	// Preview tokens (Admin)
+	articles.Post("/:id/preview-tokens", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.CreatePreviewToken)
+	articles.Delete("/:id/preview-tokens", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.RevokePreviewTokens)
+	articles.Delete("/:id/preview-tokens/:tokenId", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.RevokePreviewTokens)
+
+	// Revisions (Admin)
+	articles.Get("/:id/revisions", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.ListRevisions)
+	articles.Get("/:id/revisions/diff", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.DiffRevisions)
+	articles.Get("/:id/revisions/:revision", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.GetRevision)
+	articles.Post("/:id/revisions/:revision/restore", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.RestoreRevision)
 
 	// Cache management (Admin)
 	articles.Delete("/:type/:slug/cache", middleware.Protected(), middleware.AdminOnly(), h.ArticleHandler.ClearArticleCache)
@@ -0,0 +1,14 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"gofiber-template/interfaces/api/handlers"
+	"gofiber-template/interfaces/api/middleware"
+)
+
+func SetupCatalogRoutes(api fiber.Router, h *handlers.Handlers) {
+	catalog := api.Group("/catalog")
+
+	// Admin routes (streaming dump for partners / analytics)
+	catalog.Get("/export", middleware.Protected(), middleware.AdminOnly(), h.CatalogHandler.ExportCatalog)
+}
@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"gofiber-template/interfaces/api/handlers"
+	"gofiber-template/interfaces/api/middleware"
+)
+
+func SetupCollectionRoutes(api fiber.Router, h *handlers.Handlers) {
+	collections := api.Group("/collections")
+
+	// Public routes (เฉพาะ collection ที่ public)
+	collections.Get("/", h.CollectionHandler.ListCollections)
+	collections.Get("/home", h.CollectionHandler.GetHomeCollections) // Homepage - sections คู่กับ /videos/by-categories
+	collections.Get("/slug/:slug", h.CollectionHandler.GetCollectionBySlug)
+	collections.Get("/slug/:slug/videos", h.CollectionHandler.ListCollectionVideosBySlug)
+
+	// Admin routes (protected, เห็น draft ด้วย)
+	collections.Get("/admin", middleware.Protected(), middleware.AdminOnly(), h.CollectionHandler.ListAllCollections)
+	collections.Get("/admin/:id", middleware.Protected(), middleware.AdminOnly(), h.CollectionHandler.GetCollection)
+	collections.Get("/admin/:id/videos", middleware.Protected(), middleware.AdminOnly(), h.CollectionHandler.ListCollectionVideos)
+	collections.Post("/", middleware.Protected(), middleware.AdminOnly(), h.CollectionHandler.CreateCollection)
+	collections.Put("/reorder", middleware.Protected(), middleware.AdminOnly(), h.CollectionHandler.ReorderCollections)
+	collections.Put("/:id", middleware.Protected(), middleware.AdminOnly(), h.CollectionHandler.UpdateCollection)
+	collections.Put("/:id/videos", middleware.Protected(), middleware.AdminOnly(), h.CollectionHandler.SetCollectionVideos)
+	collections.Delete("/:id", middleware.Protected(), middleware.AdminOnly(), h.CollectionHandler.DeleteCollection)
+}
@@ -35,6 +35,12 @@ func SetupRoutes(app *fiber.App, h *handlers.Handlers, communityChatHandler *han
 	SetupTagRoutes(api, h)
 	SetupCategoryRoutes(api, h)
 	SetupStatsRoutes(api, h)
+	SetupTrendingRoutes(api, h)
+	SetupTrashRoutes(api, h)
+	SetupTranslationRoutes(api, h)
+	SetupCatalogRoutes(api, h)
+	SetupSeriesRoutes(api, h)
+	SetupCollectionRoutes(api, h)
 	SetupSemanticRoutes(api, h.SemanticHandler)
 	SetupChatRoutes(api, h.ChatHandler)
 
@@ -56,6 +62,9 @@ func SetupRoutes(app *fiber.App, h *handlers.Handlers, communityChatHandler *han
 	// Site setting routes
 	SetupSiteSettingRoutes(api, h)
 
+	// Sitemap routes
+	SetupSitemapRoutes(api, h)
+
 	// Community chat routes
 	if communityChatHandler != nil {
 		SetupCommunityChatRoutes(api, communityChatHandler)
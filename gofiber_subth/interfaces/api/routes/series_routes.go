@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"gofiber-template/interfaces/api/handlers"
+	"gofiber-template/interfaces/api/middleware"
+)
+
+func SetupSeriesRoutes(api fiber.Router, h *handlers.Handlers) {
+	series := api.Group("/series")
+
+	// Public routes
+	series.Get("/", h.SeriesHandler.ListSeries)
+	series.Get("/slug/:slug", h.SeriesHandler.GetSeriesBySlug)
+	series.Get("/:id", h.SeriesHandler.GetSeries)
+
+	// Admin routes (protected)
+	series.Post("/", middleware.Protected(), middleware.AdminOnly(), h.SeriesHandler.CreateSeries)
+	series.Put("/:id", middleware.Protected(), middleware.AdminOnly(), h.SeriesHandler.UpdateSeries)
+	series.Put("/:id/videos", middleware.Protected(), middleware.AdminOnly(), h.SeriesHandler.SetSeriesVideos)
+	series.Delete("/:id", middleware.Protected(), middleware.AdminOnly(), h.SeriesHandler.DeleteSeries)
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"gofiber-template/interfaces/api/handlers"
+	"gofiber-template/interfaces/api/middleware"
+)
+
+func SetupSitemapRoutes(api fiber.Router, h *handlers.Handlers) {
+	sitemaps := api.Group("/sitemaps")
+
+	// Admin routes
+	sitemaps.Post("/rebuild", middleware.Protected(), middleware.AdminOnly(), h.SitemapHandler.RebuildSitemaps)
+
+	// Public routes
+	sitemaps.Get("/:name", h.SitemapHandler.GetSitemap)
+}
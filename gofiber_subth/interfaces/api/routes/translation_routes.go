@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"gofiber-template/interfaces/api/handlers"
+	"gofiber-template/interfaces/api/middleware"
+)
+
+func SetupTranslationRoutes(api fiber.Router, h *handlers.Handlers) {
+	translations := api.Group("/translations")
+
+	// Admin routes (bulk import/export for video titles and taxonomy names)
+	translations.Get("/export", middleware.Protected(), middleware.AdminOnly(), h.TranslationHandler.ExportTranslations)
+	translations.Post("/import", middleware.Protected(), middleware.AdminOnly(), h.TranslationHandler.ImportTranslations)
+}
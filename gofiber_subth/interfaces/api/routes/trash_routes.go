@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"gofiber-template/interfaces/api/handlers"
+	"gofiber-template/interfaces/api/middleware"
+)
+
+func SetupTrashRoutes(api fiber.Router, h *handlers.Handlers) {
+	trash := api.Group("/trash")
+
+	// Admin routes (soft-deleted videos, articles, reels)
+	trash.Get("/", middleware.Protected(), middleware.AdminOnly(), h.TrashHandler.ListTrash)
+	trash.Post("/purge-expired", middleware.Protected(), middleware.AdminOnly(), h.TrashHandler.PurgeExpiredTrash)
+	trash.Post("/:type/:id/restore", middleware.Protected(), middleware.AdminOnly(), h.TrashHandler.RestoreTrash)
+	trash.Delete("/:type/:id", middleware.Protected(), middleware.AdminOnly(), h.TrashHandler.PurgeTrash)
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"gofiber-template/interfaces/api/handlers"
+	"gofiber-template/interfaces/api/middleware"
+)
+
+func SetupTrendingRoutes(api fiber.Router, h *handlers.Handlers) {
+	trending := api.Group("/trending")
+
+	// Public routes
+	trending.Get("/", h.TrendingHandler.GetTrending)
+
+	// Admin routes
+	trending.Post("/refresh", middleware.Protected(), middleware.AdminOnly(), h.TrendingHandler.RefreshTrending)
+}
@@ -13,12 +13,15 @@ func SetupVideoRoutes(api fiber.Router, h *handlers.Handlers) {
 	videos.Get("/", h.VideoHandler.ListVideos)
 	videos.Get("/random", h.VideoHandler.GetRandomVideos)
 	videos.Get("/search", h.VideoHandler.SearchVideos)
+	videos.Get("/facets", h.VideoHandler.FacetedSearch) // Faceted search (multi-value filters + facet counts)
 	videos.Get("/auto-tags", h.VideoHandler.GetVideosByAutoTags)
 	videos.Get("/by-categories", h.VideoHandler.GetVideosByCategories) // Homepage - videos grouped by categories
 	videos.Get("/maker/:maker_id", h.VideoHandler.GetVideosByMaker)
 	videos.Get("/cast/:cast_id", h.VideoHandler.GetVideosByCast)
 	videos.Get("/tag/:tag_id", h.VideoHandler.GetVideosByTag)
+	videos.Get("/duplicates", middleware.Protected(), middleware.AdminOnly(), h.VideoHandler.ListDuplicates) // Duplicate groups (admin)
 	videos.Get("/:id", h.VideoHandler.GetVideo)
+	videos.Post("/:id/view", middleware.Optional(), h.ViewCounterHandler.RecordVideoView) // Record view (dedup per visitor)
 
 
 	
@@ -29,6 +32,10 @@ func SetupVideoRoutes(api fiber.Router, h *handlers.Handlers) {
 	videos.Post("/batch", middleware.Protected(), h.VideoHandler.CreateVideoBatch)
 	videos.Put("/:id", middleware.Protected(), h.VideoHandler.UpdateVideo)
 	videos.Delete("/:id", middleware.Protected(), h.VideoHandler.DeleteVideo)
+	// Merge duplicates → survivor (removed IDs redirect)
+	videos.Post("/merge", middleware.Protected(), middleware.AdminOnly(), h.VideoHandler.MergeVideos)
+	// Bulk edit taxonomy / maker / auto tags / seo status (dryRun = preview)
+	videos.Post("/bulk-edit", middleware.Protected(), middleware.AdminOnly(), h.VideoHandler.BulkEditVideos)
 
 	// Cleanup routes (for deleting videos by embed codes)
 	videos.Post("/find-by-codes", middleware.Protected(), h.VideoHandler.GetVideosByEmbedCodes)
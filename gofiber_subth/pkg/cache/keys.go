@@ -50,6 +50,22 @@ func ArticleByMakerKey(makerSlug string, page int) string {
 	return fmt.Sprintf("article:maker:%s:%d", makerSlug, page)
 }
 
+// ArticleRelatedCacheTTL - บทความใหม่ที่ควรติด related จะโผล่หลัง TTL นี้
+const ArticleRelatedCacheTTL = 6 * time.Hour
+
+// ArticleRelatedKey returns cache key for related articles of an article
+// Format: article:related:{id}:{limit}
+func ArticleRelatedKey(articleID string, limit int) string {
+	return fmt.Sprintf("article:related:%s:%d", articleID, limit)
+}
+
+// ArticleRelatedRefsKey returns set key ของ article ID ที่ cache related ของมันมีบทความนี้อยู่
+// ใช้ล้าง cache ของบทความต้นทางเมื่อบทความปลายทางเปลี่ยน
+// Format: article:related-refs:{id}
+func ArticleRelatedRefsKey(articleID string) string {
+	return fmt.Sprintf("article:related-refs:%s", articleID)
+}
+
 // ========================================
 // Pattern Keys (for cache invalidation)
 // ========================================
@@ -77,3 +93,108 @@ func ArticleByTagPattern(tagSlug string) string {
 func ArticleByMakerPattern(makerSlug string) string {
 	return fmt.Sprintf("article:maker:%s:*", makerSlug)
 }
+
+// ArticleRelatedPattern returns pattern to match related caches of an article (all limits)
+// Pattern: article:related:{id}:*
+func ArticleRelatedPattern(articleID string) string {
+	return fmt.Sprintf("article:related:%s:*", articleID)
+}
+
+// ========================================
+// Indexing
+// ========================================
+
+// IndexingQuotaKey returns counter key ของ quota รายวันต่อ provider (วันที่เป็น UTC)
+// Format: indexing:quota:{provider}:{YYYY-MM-DD}
+func IndexingQuotaKey(provider, date string) string {
+	return fmt.Sprintf("indexing:quota:%s:%s", provider, date)
+}
+
+// ========================================
+// Sitemap
+// ========================================
+
+// SitemapCacheTTL - นานกว่ารอบ rebuild เผื่อ rebuild ล้มเหลวบางรอบ
+const SitemapCacheTTL = 48 * time.Hour
+
+// SitemapFileKey returns cache key ของไฟล์ sitemap
+// Format: sitemap:file:{name}
+func SitemapFileKey(name string) string {
+	return fmt.Sprintf("sitemap:file:%s", name)
+}
+
+// ========================================
+// Article Feeds (RSS/Atom)
+// ========================================
+
+// FeedCacheTTL - feed ถูกล้างตอน publish อยู่แล้ว TTL นี้กันกรณีพลาด
+const FeedCacheTTL = 30 * time.Minute
+
+// FeedListKey returns cache key ของ feed บทความทั้งหมด/ตาม type (type ว่าง = all)
+// Format: feed:list:{type}:{lang}:{format}
+func FeedListKey(articleType, lang, format string) string {
+	if articleType == "" {
+		articleType = "all"
+	}
+	return fmt.Sprintf("feed:list:%s:%s:%s", articleType, lang, format)
+}
+
+// FeedByCastKey returns cache key ของ feed บทความตาม cast
+// Format: feed:cast:{slug}:{lang}:{format}
+func FeedByCastKey(castSlug, lang, format string) string {
+	return fmt.Sprintf("feed:cast:%s:%s:%s", castSlug, lang, format)
+}
+
+// FeedByTagKey returns cache key ของ feed บทความตาม tag
+// Format: feed:tag:{slug}:{lang}:{format}
+func FeedByTagKey(tagSlug, lang, format string) string {
+	return fmt.Sprintf("feed:tag:%s:%s:%s", tagSlug, lang, format)
+}
+
+// FeedByMakerKey returns cache key ของ feed บทความตาม maker
+// Format: feed:maker:{slug}:{lang}:{format}
+func FeedByMakerKey(makerSlug, lang, format string) string {
+	return fmt.Sprintf("feed:maker:%s:%s:%s", makerSlug, lang, format)
+}
+
+// FeedListPattern returns pattern to match ทุก feed ทั้งหมด/ตาม type
+// Pattern: feed:list:*
+func FeedListPattern() string {
+	return "feed:list:*"
+}
+
+// FeedByCastPattern returns pattern to match ทุก feed ของ cast
+// Pattern: feed:cast:{slug}:*
+func FeedByCastPattern(castSlug string) string {
+	return fmt.Sprintf("feed:cast:%s:*", castSlug)
+}
+
+// FeedByTagPattern returns pattern to match ทุก feed ของ tag
+// Pattern: feed:tag:{slug}:*
+func FeedByTagPattern(tagSlug string) string {
+	return fmt.Sprintf("feed:tag:%s:*", tagSlug)
+}
+
+// FeedByMakerPattern returns pattern to match ทุก feed ของ maker
+// Pattern: feed:maker:{slug}:*
+func FeedByMakerPattern(makerSlug string) string {
+	return fmt.Sprintf("feed:maker:%s:*", makerSlug)
+}
+
+// Article preview tokens (draft/scheduled preview บน frontend)
+const (
+	ArticlePreviewDefaultTTL = 60 * time.Minute
+	ArticlePreviewMaxTTL     = 24 * time.Hour
+)
+
+// ArticlePreviewTokenKey returns key ของ preview token ที่ยังใช้ได้ (ลบ = revoke)
+// Format: preview:article:token:{tokenID}
+func ArticlePreviewTokenKey(tokenID string) string {
+	return fmt.Sprintf("preview:article:token:%s", tokenID)
+}
+
+// ArticlePreviewTokensKey returns set key ของ token ID ทั้งหมดของบทความ (ใช้ revoke ทั้งหมด)
+// Format: preview:article:tokens:{articleID}
+func ArticlePreviewTokensKey(articleID string) string {
+	return fmt.Sprintf("preview:article:tokens:%s", articleID)
+}
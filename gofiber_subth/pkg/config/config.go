@@ -20,6 +20,27 @@ type Config struct {
 	RAG      RAGConfig
 	Google   GoogleOAuthConfig
 	Gemini   GeminiConfig
+	Site     SiteConfig
+	Indexing IndexingConfig
+}
+
+// SiteConfig สำหรับ public frontend (ใช้สร้าง canonical URL)
+type SiteConfig struct {
+	URL            string // เช่น https://subth.com
+	SitemapBaseURL string // URL prefix ของไฟล์ sitemap ใน index (ว่าง = {URL}/sitemap)
+}
+
+// IndexingConfig สำหรับส่ง URL บทความให้ search engine
+type IndexingConfig struct {
+	Providers             []string // google, indexnow, fake (ว่าง = ปิด)
+	BatchSize             int      // จำนวนบทความ pending ต่อรอบ
+	VerifyBatchSize       int      // จำนวนบทความ submitted ที่ตรวจสถานะต่อรอบ
+	GoogleCredentialsFile string
+	GoogleSiteURL         string // Search Console property สำหรับ URL Inspection
+	GoogleDailyQuota      int
+	IndexNowKey           string
+	IndexNowKeyLocation   string
+	IndexNowDailyQuota    int
 }
 
 // GeminiConfig สำหรับ AI Title Generation
@@ -109,6 +130,10 @@ func LoadConfig() (*Config, error) {
 	logMaxBackups, _ := strconv.Atoi(getEnv("LOG_MAX_BACKUPS", "5"))
 	logMaxAge, _ := strconv.Atoi(getEnv("LOG_MAX_AGE", "30"))
 	logCompress := getEnv("LOG_COMPRESS", "true") == "true"
+	indexingBatchSize, _ := strconv.Atoi(getEnv("INDEXING_BATCH_SIZE", "50"))
+	indexingVerifyBatchSize, _ := strconv.Atoi(getEnv("INDEXING_VERIFY_BATCH_SIZE", "20"))
+	googleIndexingQuota, _ := strconv.Atoi(getEnv("GOOGLE_INDEXING_DAILY_QUOTA", "200"))
+	indexNowQuota, _ := strconv.Atoi(getEnv("INDEXNOW_DAILY_QUOTA", "10000"))
 
 	config := &Config{
 		App: AppConfig{
@@ -174,6 +199,21 @@ func LoadConfig() (*Config, error) {
 			APIKey: getEnv("GEMINI_API_KEY", ""),
 			Model:  getEnv("GEMINI_MODEL", "gemini-2.0-flash"),
 		},
+		Site: SiteConfig{
+			URL:            getEnv("SITE_URL", "https://subth.com"),
+			SitemapBaseURL: getEnv("SITEMAP_BASE_URL", ""),
+		},
+		Indexing: IndexingConfig{
+			Providers:             getEnvList("INDEXING_PROVIDERS", ""),
+			BatchSize:             indexingBatchSize,
+			VerifyBatchSize:       indexingVerifyBatchSize,
+			GoogleCredentialsFile: getEnv("GOOGLE_INDEXING_CREDENTIALS_FILE", ""),
+			GoogleSiteURL:         getEnv("GOOGLE_SEARCH_CONSOLE_SITE", ""),
+			GoogleDailyQuota:      googleIndexingQuota,
+			IndexNowKey:           getEnv("INDEXNOW_KEY", ""),
+			IndexNowKeyLocation:   getEnv("INDEXNOW_KEY_LOCATION", ""),
+			IndexNowDailyQuota:    indexNowQuota,
+		},
 	}
 
 	return config, nil
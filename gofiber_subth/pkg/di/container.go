@@ -8,6 +8,7 @@ import (
 	"gofiber-template/domain/ports"
 	"gofiber-template/domain/repositories"
 	"gofiber-template/domain/services"
+	"gofiber-template/infrastructure/indexing"
 	"gofiber-template/infrastructure/postgres"
 	"gofiber-template/infrastructure/redis"
 	"gofiber-template/infrastructure/storage"
@@ -52,14 +53,51 @@ type Container struct {
 	ContactChannelRepository   repositories.ContactChannelRepository
 	ChatRepository             repositories.ChatRepository
 	ArticleRepository          repositories.ArticleRepository
+	ArticleRevisionRepository  repositories.ArticleRevisionRepository
+	ArticleRedirectRepository  repositories.ArticleRedirectRepository
 	ArticleLikeRepository      repositories.ArticleLikeRepository
 	ArticleCommentRepository   repositories.ArticleCommentRepository
 	SiteSettingRepository      repositories.SiteSettingRepository
+	SitemapRepository          repositories.SitemapRepository
+
+	// Article review workflow (status history)
+	ArticleStatusChangeRepository repositories.ArticleStatusChangeRepository
+
+	// Article coverage report + "needs article" queue
+	ArticleCoverageRepository repositories.ArticleCoverageRepository
+
+	// Trending scores (Postgres) + ranking store (Redis)
+	TrendingRepository repositories.TrendingRepository
+	TrendingStore      *redis.TrendingStore
+
+	// Trash (soft-deleted videos, articles, reels)
+	TrashRepository repositories.TrashRepository
+
+	// Translations (bulk import/export)
+	TranslationRepository repositories.TranslationRepository
+
+	// Catalogue export (streaming dump)
+	CatalogRepository repositories.CatalogRepository
+
+	// Series & curated collections (ordered video membership)
+	SeriesRepository     repositories.SeriesRepository
+	CollectionRepository repositories.CollectionRepository
 
 	// Activity Queue
 	ActivityQueue  *redis.ActivityQueue
 	ActivityWorker *worker.ActivityWorker
 
+	// View Counter (Redis buffer)
+	ViewCounter *redis.ViewCounter
+
+	// System Jobs (registered on EventScheduler)
+	ArticlePublisher *worker.ArticlePublisher
+	IndexingWorker   *worker.IndexingWorker
+	SitemapWorker     *worker.SitemapWorker
+	ViewCounterWorker *worker.ViewCounterWorker
+	TrendingWorker    *worker.TrendingWorker
+	TrashPurgeWorker  *worker.TrashPurgeWorker
+
 	// WebSocket
 	ChatHub *websocket.ChatHub
 
@@ -89,6 +127,31 @@ type Container struct {
 	ArticleLikeService     services.ArticleLikeService
 	ArticleCommentService  services.ArticleCommentService
 	SiteSettingService     services.SiteSettingService
+	SitemapService         services.SitemapService
+	ArticleFeedService     services.ArticleFeedService
+	ViewCounterService     services.ViewCounterService
+
+	// Article generator (admin)
+	ArticleGeneratorService services.ArticleGeneratorService
+
+	// Article coverage report + SEO worker queue
+	ArticleCoverageService services.ArticleCoverageService
+
+	// Trending videos/casts/tags/makers
+	TrendingService services.TrendingService
+
+	// Trash listing, restore, purge
+	TrashService services.TrashService
+
+	// Translation CSV/JSONL import/export
+	TranslationService services.TranslationService
+
+	// Catalogue export JSONL/CSV
+	CatalogService services.CatalogService
+
+	// Series & curated collections
+	SeriesService     services.SeriesService
+	CollectionService services.CollectionService
 
 	// Handlers that need special initialization
 	CommunityChatHandler *handlers.CommunityChatHandler
@@ -258,9 +321,20 @@ func (c *Container) initRepositories() error {
 	c.ContactChannelRepository = postgres.NewContactChannelRepository(c.DB)
 	c.ChatRepository = postgres.NewChatRepository(c.DB)
 	c.ArticleRepository = postgres.NewArticleRepository(c.DB)
+	c.ArticleRevisionRepository = postgres.NewArticleRevisionRepository(c.DB)
+	c.ArticleRedirectRepository = postgres.NewArticleRedirectRepository(c.DB)
+	c.ArticleStatusChangeRepository = postgres.NewArticleStatusChangeRepository(c.DB)
+	c.ArticleCoverageRepository = postgres.NewArticleCoverageRepository(c.DB)
+	c.TrendingRepository = postgres.NewTrendingRepository(c.DB)
+	c.TrashRepository = postgres.NewTrashRepository(c.DB)
+	c.TranslationRepository = postgres.NewTranslationRepository(c.DB)
+	c.CatalogRepository = postgres.NewCatalogRepository(c.DB)
+	c.SeriesRepository = postgres.NewSeriesRepository(c.DB)
+	c.CollectionRepository = postgres.NewCollectionRepository(c.DB)
 	c.ArticleLikeRepository = postgres.NewArticleLikeRepository(c.DB)
 	c.ArticleCommentRepository = postgres.NewArticleCommentRepository(c.DB)
 	c.SiteSettingRepository = postgres.NewSiteSettingRepository(c.DB)
+	c.SitemapRepository = postgres.NewSitemapRepository(c.DB)
 
 	// Activity Queue (Redis)
 	c.ActivityQueue = redis.NewActivityQueue(c.RedisClient)
@@ -268,6 +342,12 @@ func (c *Container) initRepositories() error {
 	// Activity Worker
 	c.ActivityWorker = worker.NewActivityWorker(c.ActivityQueue, c.ActivityLogRepository)
 
+	// View Counter (Redis)
+	c.ViewCounter = redis.NewViewCounter(c.RedisClient)
+
+	// Trending Store (Redis sorted sets)
+	c.TrendingStore = redis.NewTrendingStore(c.RedisClient)
+
 	logger.Info("Repositories initialized")
 	return nil
 }
@@ -290,10 +370,14 @@ func (c *Container) initServices() error {
 		c.TagRepository,
 		c.AutoTagLabelRepository,
 		c.CategoryRepository,
+		c.ArticleRepository,
+		c.SeriesRepository,
 		c.Storage,
+		c.RedisClient,
+		c.Config.Site.URL,
 	)
 	c.MakerService = serviceimpl.NewMakerService(c.MakerRepository)
-	c.CastService = serviceimpl.NewCastService(c.CastRepository)
+	c.CastService = serviceimpl.NewCastService(c.CastRepository, c.Config.Site.URL)
 	c.TagService = serviceimpl.NewTagService(c.TagRepository, c.AutoTagLabelRepository)
 	c.StatsService = serviceimpl.NewStatsService(c.DB, c.MakerService, c.CastService, c.TagService)
 	c.SemanticService = serviceimpl.NewSemanticService(c.Config)
@@ -327,7 +411,7 @@ func (c *Container) initServices() error {
 	c.CommunityChatService = serviceimpl.NewCommunityChatService(c.ChatRepository, c.VideoRepository)
 
 	// SEO Article Service (with Storage for R2 cleanup on delete, and Redis for caching)
-	c.ArticleService = serviceimpl.NewArticleService(c.ArticleRepository, c.VideoRepository, c.Storage, c.RedisClient)
+	c.ArticleService = serviceimpl.NewArticleService(c.ArticleRepository, c.ArticleRevisionRepository, c.ArticleRedirectRepository, c.ArticleStatusChangeRepository, c.ArticleCoverageRepository, c.VideoRepository, c.UserRepository, c.Storage, c.RedisClient, c.Config.JWT.Secret, c.Config.Site.URL)
 
 	// Article Like/Comment Services
 	c.ArticleLikeService = serviceimpl.NewArticleLikeService(c.ArticleLikeRepository, c.ArticleRepository, c.UserStatsRepository)
@@ -336,6 +420,37 @@ func (c *Container) initServices() error {
 	// Site Setting Service
 	c.SiteSettingService = serviceimpl.NewSiteSettingService(c.SiteSettingRepository)
 
+	// Sitemap Service (upload ขึ้น R2 + cache ใน Redis สำหรับ endpoint)
+	c.SitemapService = serviceimpl.NewSitemapService(c.SitemapRepository, c.Storage, c.RedisClient, c.Config.Site.URL, c.Config.Site.SitemapBaseURL)
+
+	// Article Feed Service (RSS/Atom, cache ใน Redis ถูกล้างตอน publish)
+	c.ArticleFeedService = serviceimpl.NewArticleFeedService(c.ArticleRepository, c.RedisClient, c.Config.Site.URL)
+
+	// View Counter Service (buffer ใน Redis, worker flush ลง DB)
+	c.ViewCounterService = serviceimpl.NewViewCounterService(c.ArticleRepository, c.VideoRepository, c.ViewCounter)
+
+	// Article Generator Service (ranking/best-of/guide จาก catalogue → draft)
+	c.ArticleGeneratorService = serviceimpl.NewArticleGeneratorService(c.ArticleRepository, c.CastRepository, c.MakerRepository, c.TagRepository)
+
+	// Article Coverage Service (รายงาน video/hub ที่ขาดบทความ + คิว lease ของ SEO worker)
+	c.ArticleCoverageService = serviceimpl.NewArticleCoverageService(c.ArticleCoverageRepository, c.MakerRepository, c.CastRepository, c.CategoryRepository)
+
+	// Trending Service (คะแนน decay จาก activity → Redis sorted set)
+	c.TrendingService = serviceimpl.NewTrendingService(c.TrendingRepository, c.TrendingStore)
+
+	// Trash Service (restore + purge ที่ล้างไฟล์ใน R2 ตามหลัง)
+	c.TrashService = serviceimpl.NewTrashService(c.TrashRepository, c.VideoRepository, c.Storage, c.RedisClient)
+
+	// Translation Service (bulk import/export คำแปล video/cast/tag/category)
+	c.TranslationService = serviceimpl.NewTranslationService(c.TranslationRepository)
+
+	// Catalog Service (stream จาก cursor ไม่โหลดทั้งหมดเข้า memory)
+	c.CatalogService = serviceimpl.NewCatalogService(c.CatalogRepository)
+
+	// Series & Collection Services (collection ใช้เป็น section หน้าแรกได้)
+	c.SeriesService = serviceimpl.NewSeriesService(c.SeriesRepository)
+	c.CollectionService = serviceimpl.NewCollectionService(c.CollectionRepository)
+
 	// Chat Hub (WebSocket)
 	c.ChatHub = websocket.NewChatHub(c.CommunityChatService)
 	go c.ChatHub.Run()
@@ -360,6 +475,9 @@ func (c *Container) initScheduler() error {
 	go c.ActivityWorker.Start(context.Background())
 	logger.Info("Activity worker started")
 
+	// Register system jobs
+	c.registerSystemJobs()
+
 	// Load and schedule existing active jobs
 	ctx := context.Background()
 	jobs, _, err := c.JobService.ListJobs(ctx, 0, 1000)
@@ -389,6 +507,108 @@ func (c *Container) initScheduler() error {
 	return nil
 }
 
+// registerSystemJobs ลงทะเบียน job ภายในระบบ (ไม่ได้เก็บใน jobs table)
+func (c *Container) registerSystemJobs() {
+	// Scheduled article publisher
+	c.ArticlePublisher = worker.NewArticlePublisher(c.ArticleService, c.RedisClient)
+	if err := c.EventScheduler.AddJob(worker.ArticlePublisherJobID, worker.ArticlePublisherCron, c.ArticlePublisher.Run); err != nil {
+		logger.Warn("Failed to schedule article publisher", "error", err)
+	} else {
+		logger.Info("Article publisher scheduled", "cron", worker.ArticlePublisherCron)
+	}
+
+	// Search engine indexing
+	indexers := c.initSearchIndexers()
+	c.IndexingWorker = worker.NewIndexingWorker(
+		c.ArticleRepository,
+		indexers,
+		c.RedisClient,
+		c.Config.Site.URL,
+		c.Config.Indexing.BatchSize,
+		c.Config.Indexing.VerifyBatchSize,
+	)
+	if len(indexers) == 0 {
+		logger.Info("Indexing worker disabled (no providers configured)")
+	} else if err := c.EventScheduler.AddJob(worker.IndexingWorkerJobID, worker.IndexingWorkerCron, c.IndexingWorker.Run); err != nil {
+		logger.Warn("Failed to schedule indexing worker", "error", err)
+	} else {
+		logger.Info("Indexing worker scheduled", "cron", worker.IndexingWorkerCron, "providers", len(indexers))
+	}
+
+	// Sitemap rebuild
+	c.SitemapWorker = worker.NewSitemapWorker(c.SitemapService, c.RedisClient)
+	if err := c.EventScheduler.AddJob(worker.SitemapWorkerJobID, worker.SitemapWorkerCron, c.SitemapWorker.Run); err != nil {
+		logger.Warn("Failed to schedule sitemap worker", "error", err)
+	} else {
+		logger.Info("Sitemap worker scheduled", "cron", worker.SitemapWorkerCron)
+	}
+	go c.SitemapWorker.RunOnStartup()
+
+	// View counter flush
+	c.ViewCounterWorker = worker.NewViewCounterWorker(c.ViewCounterService, c.RedisClient)
+	if err := c.EventScheduler.AddJob(worker.ViewCounterWorkerJobID, worker.ViewCounterWorkerCron, c.ViewCounterWorker.Run); err != nil {
+		logger.Warn("Failed to schedule view counter worker", "error", err)
+	} else {
+		logger.Info("View counter worker scheduled", "cron", worker.ViewCounterWorkerCron)
+	}
+
+	// Trending scores refresh
+	c.TrendingWorker = worker.NewTrendingWorker(c.TrendingService, c.RedisClient)
+	if err := c.EventScheduler.AddJob(worker.TrendingWorkerJobID, worker.TrendingWorkerCron, c.TrendingWorker.Run); err != nil {
+		logger.Warn("Failed to schedule trending worker", "error", err)
+	} else {
+		logger.Info("Trending worker scheduled", "cron", worker.TrendingWorkerCron)
+	}
+
+	// Trash purge (ลบถาวรหลังครบ retention)
+	c.TrashPurgeWorker = worker.NewTrashPurgeWorker(c.TrashService, c.RedisClient)
+	if err := c.EventScheduler.AddJob(worker.TrashPurgeWorkerJobID, worker.TrashPurgeWorkerCron, c.TrashPurgeWorker.Run); err != nil {
+		logger.Warn("Failed to schedule trash purge worker", "error", err)
+	} else {
+		logger.Info("Trash purge worker scheduled", "cron", worker.TrashPurgeWorkerCron)
+	}
+}
+
+// initSearchIndexers สร้าง indexer ตาม INDEXING_PROVIDERS (provider ที่ config ไม่ครบจะถูกข้าม)
+func (c *Container) initSearchIndexers() []ports.SearchIndexer {
+	cfg := c.Config.Indexing
+	indexers := make([]ports.SearchIndexer, 0, len(cfg.Providers))
+
+	for _, provider := range cfg.Providers {
+		switch provider {
+		case "google":
+			googleIndexer, err := indexing.NewGoogleIndexer(indexing.GoogleConfig{
+				CredentialsFile: cfg.GoogleCredentialsFile,
+				SiteURL:         cfg.GoogleSiteURL,
+				DailyQuota:      cfg.GoogleDailyQuota,
+			})
+			if err != nil {
+				logger.Warn("Google indexer initialization failed", "error", err)
+				continue
+			}
+			indexers = append(indexers, googleIndexer)
+		case "indexnow":
+			indexNowIndexer, err := indexing.NewIndexNowIndexer(indexing.IndexNowConfig{
+				SiteURL:     c.Config.Site.URL,
+				Key:         cfg.IndexNowKey,
+				KeyLocation: cfg.IndexNowKeyLocation,
+				DailyQuota:  cfg.IndexNowDailyQuota,
+			})
+			if err != nil {
+				logger.Warn("IndexNow indexer initialization failed", "error", err)
+				continue
+			}
+			indexers = append(indexers, indexNowIndexer)
+		case "fake":
+			indexers = append(indexers, indexing.NewFakeIndexer())
+		default:
+			logger.Warn("Unknown indexing provider", "provider", provider)
+		}
+	}
+
+	return indexers
+}
+
 func (c *Container) Cleanup() error {
 	logger.Info("Starting cleanup...")
 
@@ -467,6 +687,23 @@ func (c *Container) GetHandlerServices() *handlers.Services {
 		ArticleLikeService:    c.ArticleLikeService,
 		ArticleCommentService: c.ArticleCommentService,
 		SiteSettingService:    c.SiteSettingService,
+		SitemapService:        c.SitemapService,
+		ArticleFeedService:    c.ArticleFeedService,
+		ViewCounterService:    c.ViewCounterService,
+
+		ArticleGeneratorService: c.ArticleGeneratorService,
+		ArticleCoverageService:  c.ArticleCoverageService,
+
+		TrendingService: c.TrendingService,
+
+		TrashService: c.TrashService,
+
+		TranslationService: c.TranslationService,
+
+		CatalogService: c.CatalogService,
+
+		SeriesService:     c.SeriesService,
+		CollectionService: c.CollectionService,
 	}
 }
 
@@ -0,0 +1,219 @@
+package schema
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ========================================
+// Article Content Schemas (keyed by ArticleType)
+// ========================================
+
+// articleFAQItem - faqItems[] ใช้ทำ FAQPage schema บน frontend
+var articleFAQItem = Object(map[string]*Field{
+	"question": String().Req(),
+	"answer":   String().Req(),
+})
+
+var articleGalleryImage = Object(map[string]*Field{
+	"url":    String().Req(),
+	"alt":    String(),
+	"width":  Integer().AtLeast(0),
+	"height": Integer().AtLeast(0),
+})
+
+// articleMetadataFields - field ที่ API เติมให้ (ทุก type ใช้ร่วมกัน) ตรวจแค่ชนิดข้อมูล
+func articleMetadataFields() map[string]*Field {
+	return map[string]*Field{
+		"thumbnailUrl": String(),
+		"castProfiles": ArrayOf(Object(map[string]*Field{
+			"id":         String(),
+			"name":       String().Req(),
+			"nameTH":     String(),
+			"bio":        String(),
+			"imageUrl":   String(),
+			"profileUrl": String(),
+		})),
+		"makerInfo": Object(map[string]*Field{
+			"id":          String(),
+			"name":        String().Req(),
+			"description": String(),
+			"profileUrl":  String(),
+		}),
+		"tagDescriptions": ArrayOf(Object(map[string]*Field{
+			"id":          String(),
+			"name":        String().Req(),
+			"description": String(),
+			"url":         String(),
+		})),
+		"galleryImages":       ArrayOf(articleGalleryImage),
+		"memberGalleryImages": ArrayOf(articleGalleryImage),
+		"memberGalleryCount":  Integer().AtLeast(0),
+	}
+}
+
+// articleBaseFields - Quick Answer + SEO chunk ที่ทุก type ต้องมี
+func articleBaseFields() map[string]*Field {
+	fields := articleMetadataFields()
+	for k, v := range map[string]*Field{
+		"quickAnswer":     String().Req(),
+		"titleAggressive": String(),
+		"titleBalanced":   String().Req(),
+		"metaDescription": String().Req().MaxLen(250),
+		"slug":            String().Req().MaxLen(100),
+		"keywords":        ArrayOf(String()),
+		"searchIntents":   ArrayOf(String()),
+		"faqItems":        ArrayOf(articleFAQItem),
+	} {
+		fields[k] = v
+	}
+	return fields
+}
+
+// reviewContentSchema - V3 review (dto.ArticleContentV3)
+func reviewContentSchema() *Field {
+	fields := articleBaseFields()
+	for k, v := range map[string]*Field{
+		// Chunk 1: Quick Answer
+		"mainHook": String().Req(),
+		"verdict":  String().Req(),
+
+		// Chunk 2: Facts
+		"facts": Object(map[string]*Field{
+			"code":              String().Req(),
+			"studio":            String(),
+			"cast":              ArrayOf(String()),
+			"duration":          String(),
+			"durationMinutes":   Integer().AtLeast(0),
+			"genre":             ArrayOf(String()),
+			"releaseYear":       String(),
+			"subtitleAvailable": Boolean(),
+		}).Req(),
+
+		// Chunk 3: Story
+		"synopsis":            String().Req(),
+		"storyFlow":           String(),
+		"keyScenes":           ArrayOf(String()),
+		"featuredScene":       String(),
+		"tone":                String(),
+		"relationshipDynamic": String(),
+
+		// Chunk 4: Review
+		"reviewSummary":  String().Req(),
+		"strengths":      ArrayOf(String()).Req().NonEmpty(),
+		"weaknesses":     ArrayOf(String()),
+		"whoShouldWatch": String(),
+		"verdictReason":  String(),
+
+		// Chunk 5: FAQ
+		"faqItems": ArrayOf(articleFAQItem).Req(),
+
+		// Chunk 6: SEO
+		"rating": Number().Range(0, 5),
+	} {
+		fields[k] = v
+	}
+	return Object(fields)
+}
+
+// articleRankingItem - rankingItems[] (dto.GeneratedRankingItem) 1 รายการต่อ video ลิงก์ไปหน้ารีวิว
+var articleRankingItem = Object(map[string]*Field{
+	"position":     Integer().Req().AtLeast(1),
+	"videoId":      String().Req(),
+	"code":         String().Req(),
+	"title":        String(),
+	"thumbnailUrl": String(),
+	"views":        Integer().AtLeast(0),
+	"rating":       Number().Range(0, 5),
+	"cast":         ArrayOf(String()),
+	"reviewSlug":   String(),
+	"releaseDate":  String(),
+})
+
+// listContentSchema - ranking/best-of (dto.GeneratedArticleContent) ต้องมี rankingItems อย่างน้อย 1 รายการ
+func listContentSchema() *Field {
+	fields := articleBaseFields()
+	for k, v := range map[string]*Field{
+		"mainHook":       String().Req(),
+		"verdict":        String().Req(), // เรื่องที่แนะนำให้เริ่มดู
+		"synopsis":       String(),       // สรุปแต่ละอันดับ
+		"reviewSummary":  String().Req(),
+		"strengths":      ArrayOf(String()),
+		"whoShouldWatch": String(),
+		"verdictReason":  String(),
+		"rankingItems":   ArrayOf(articleRankingItem).Req().NonEmpty(),
+		"rating":         Number().Range(0, 5),
+	} {
+		fields[k] = v
+	}
+	return Object(fields)
+}
+
+// guideContentSchema - คู่มือแนะนำ tag: เนื้อหาหลักอยู่ที่ synopsis, rankingItems เป็นเรื่องแนะนำ (ไม่บังคับ)
+func guideContentSchema() *Field {
+	fields := articleBaseFields()
+	for k, v := range map[string]*Field{
+		"mainHook":       String().Req(),
+		"synopsis":       String().Req(),
+		"verdict":        String(),
+		"reviewSummary":  String(),
+		"strengths":      ArrayOf(String()),
+		"whoShouldWatch": String(),
+		"rankingItems":   ArrayOf(articleRankingItem),
+		"rating":         Number().Range(0, 5),
+	} {
+		fields[k] = v
+	}
+	return Object(fields)
+}
+
+// newsContentSchema - ข่าว: hook + เนื้อข่าว (synopsis) + แหล่งอ้างอิง
+func newsContentSchema() *Field {
+	fields := articleBaseFields()
+	for k, v := range map[string]*Field{
+		"mainHook": String().Req(),
+		"synopsis": String().Req(),
+		"sources":  ArrayOf(String()),
+	} {
+		fields[k] = v
+	}
+	return Object(fields)
+}
+
+// articleContentSchemas - registry ของ schema ตาม ArticleType
+var articleContentSchemas = map[string]*Field{
+	"review":  reviewContentSchema(),
+	"ranking": listContentSchema(),
+	"best-of": listContentSchema(),
+	"guide":   guideContentSchema(),
+	"news":    newsContentSchema(),
+}
+
+// ArticleContentSchema returns schema ของ article type
+func ArticleContentSchema(articleType string) (*Field, bool) {
+	s, ok := articleContentSchemas[articleType]
+	return s, ok
+}
+
+// ArticleContentTypes returns ทุก type ที่มี schema (เรียงตามตัวอักษร)
+func ArticleContentTypes() []string {
+	types := make([]string, 0, len(articleContentSchemas))
+	for t := range articleContentSchemas {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// ValidateArticleContent ตรวจ content ของบทความตาม type
+// returns: nil ถ้าผ่าน, *ValidationError ถ้าไม่ผ่าน (รวมกรณี type ไม่รู้จัก)
+func ValidateArticleContent(articleType string, raw []byte) error {
+	s, ok := ArticleContentSchema(articleType)
+	if !ok {
+		return &ValidationError{Errors: map[string]string{
+			"type": fmt.Sprintf("type must be one of %s", strings.Join(ArticleContentTypes(), ", ")),
+		}}
+	}
+	return s.Validate(raw)
+}
@@ -0,0 +1,218 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Kind - ชนิดข้อมูลของ JSON value
+type Kind string
+
+const (
+	KindString  Kind = "string"
+	KindNumber  Kind = "number"
+	KindInteger Kind = "integer"
+	KindBoolean Kind = "boolean"
+	KindArray   Kind = "array"
+	KindObject  Kind = "object"
+)
+
+// Field - schema ของ JSON value หนึ่งตัว (subset ของ JSON Schema ที่ใช้จริงในระบบ)
+// field ที่ไม่ได้ประกาศใน Properties ถือว่าอนุญาต (additionalProperties: true)
+type Field struct {
+	Kind       Kind
+	Required   bool
+	NotEmpty   bool     // string ต้องไม่ใช่ "" (หลัง trim)
+	MaxLength  int      // string (0 = ไม่จำกัด)
+	Min        *float64 // number/integer
+	Max        *float64 // number/integer
+	MinItems   int      // array
+	Items      *Field   // array element
+	Properties map[string]*Field
+}
+
+// ValidationError - error พร้อมรายละเอียดราย field
+// Errors: map[path]message เช่น {"facts.durationMinutes": "facts.durationMinutes must be a number"}
+type ValidationError struct {
+	Errors map[string]string
+}
+
+func (e *ValidationError) Error() string {
+	paths := make([]string, 0, len(e.Errors))
+	for p := range e.Errors {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return fmt.Sprintf("content does not match schema: %s", strings.Join(paths, ", "))
+}
+
+// Validate ตรวจ raw JSON กับ schema
+// returns: nil ถ้าผ่าน, *ValidationError ถ้าไม่ผ่าน
+func (f *Field) Validate(raw []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return &ValidationError{Errors: map[string]string{"$": "body must be valid JSON"}}
+	}
+
+	errs := make(map[string]string)
+	f.validate("", value, errs)
+	if len(errs) > 0 {
+		return &ValidationError{Errors: errs}
+	}
+	return nil
+}
+
+func (f *Field) validate(path string, value interface{}, errs map[string]string) {
+	name := path
+	if name == "" {
+		name = "body"
+	}
+
+	switch f.Kind {
+	case KindString:
+		s, ok := value.(string)
+		if !ok {
+			errs[name] = name + " must be a string"
+			return
+		}
+		if f.NotEmpty && strings.TrimSpace(s) == "" {
+			errs[name] = name + " must not be empty"
+			return
+		}
+		if f.MaxLength > 0 && len([]rune(s)) > f.MaxLength {
+			errs[name] = fmt.Sprintf("%s must be at most %d characters", name, f.MaxLength)
+		}
+
+	case KindNumber, KindInteger:
+		n, ok := value.(float64)
+		if !ok {
+			errs[name] = fmt.Sprintf("%s must be a %s", name, f.Kind)
+			return
+		}
+		if f.Kind == KindInteger && n != math.Trunc(n) {
+			errs[name] = name + " must be an integer"
+			return
+		}
+		if f.Min != nil && n < *f.Min {
+			errs[name] = fmt.Sprintf("%s must be greater than or equal to %v", name, *f.Min)
+			return
+		}
+		if f.Max != nil && n > *f.Max {
+			errs[name] = fmt.Sprintf("%s must be less than or equal to %v", name, *f.Max)
+		}
+
+	case KindBoolean:
+		if _, ok := value.(bool); !ok {
+			errs[name] = name + " must be a boolean"
+		}
+
+	case KindArray:
+		items, ok := value.([]interface{})
+		if !ok {
+			errs[name] = name + " must be an array"
+			return
+		}
+		if len(items) < f.MinItems {
+			errs[name] = fmt.Sprintf("%s must contain at least %d item(s)", name, f.MinItems)
+			return
+		}
+		if f.Items != nil {
+			for i, item := range items {
+				f.Items.validate(fmt.Sprintf("%s[%d]", path, i), item, errs)
+			}
+		}
+
+	case KindObject:
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			errs[name] = name + " must be an object"
+			return
+		}
+		for key, prop := range f.Properties {
+			child := key
+			if path != "" {
+				child = path + "." + key
+			}
+
+			v, exists := obj[key]
+			if !exists || v == nil {
+				if prop.Required {
+					errs[child] = child + " is required"
+				}
+				continue
+			}
+			prop.validate(child, v, errs)
+		}
+	}
+}
+
+// ========================================
+// Builders (ให้ประกาศ schema ได้สั้นลง)
+// ========================================
+
+// Object สร้าง object schema
+func Object(props map[string]*Field) *Field {
+	return &Field{Kind: KindObject, Properties: props}
+}
+
+// String สร้าง string schema
+func String() *Field {
+	return &Field{Kind: KindString}
+}
+
+// Number สร้าง number schema
+func Number() *Field {
+	return &Field{Kind: KindNumber}
+}
+
+// Integer สร้าง integer schema
+func Integer() *Field {
+	return &Field{Kind: KindInteger}
+}
+
+// Boolean สร้าง boolean schema
+func Boolean() *Field {
+	return &Field{Kind: KindBoolean}
+}
+
+// ArrayOf สร้าง array schema ที่ทุก element ตรงกับ items
+func ArrayOf(items *Field) *Field {
+	return &Field{Kind: KindArray, Items: items}
+}
+
+// Req - required และ (ถ้าเป็น string) ต้องไม่ว่าง
+func (f *Field) Req() *Field {
+	f.Required = true
+	if f.Kind == KindString {
+		f.NotEmpty = true
+	}
+	return f
+}
+
+// MaxLen กำหนดความยาวสูงสุดของ string (นับเป็นตัวอักษร)
+func (f *Field) MaxLen(n int) *Field {
+	f.MaxLength = n
+	return f
+}
+
+// Range กำหนดช่วงค่าของ number/integer
+func (f *Field) Range(min, max float64) *Field {
+	f.Min = &min
+	f.Max = &max
+	return f
+}
+
+// AtLeast กำหนดค่าต่ำสุดของ number/integer
+func (f *Field) AtLeast(min float64) *Field {
+	f.Min = &min
+	return f
+}
+
+// NonEmpty - array ต้องมีอย่างน้อย 1 element
+func (f *Field) NonEmpty() *Field {
+	f.MinItems = 1
+	return f
+}
@@ -0,0 +1,193 @@
+package seo
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// SiteName - ชื่อเว็บที่ใช้ใน feed
+const SiteName = "SubTH"
+
+const (
+	atomNS  = "http://www.w3.org/2005/Atom"
+	mediaNS = "http://search.yahoo.com/mrss/"
+)
+
+// FeedChannel - ข้อมูลหัว feed (ใช้ร่วมกันทั้ง RSS และ Atom)
+type FeedChannel struct {
+	Title       string
+	Link        string // หน้าเว็บของ feed นี้
+	Description string
+	Language    string
+	Updated     time.Time
+}
+
+// FeedItem - บทความหนึ่งรายการใน feed
+type FeedItem struct {
+	Title       string
+	Link        string
+	Description string
+	ImageURL    string
+	Categories  []string
+	PublishedAt time.Time
+	UpdatedAt   time.Time
+}
+
+// ========================================
+// RSS 2.0
+// ========================================
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Media   string     `xml:"xmlns:media,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	Language      string    `xml:"language,omitempty"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string          `xml:"title"`
+	Link        string          `xml:"link"`
+	GUID        rssGUID         `xml:"guid"`
+	Description string          `xml:"description"`
+	PubDate     string          `xml:"pubDate,omitempty"`
+	Categories  []string        `xml:"category,omitempty"`
+	Thumbnail   *mediaThumbnail `xml:"media:thumbnail,omitempty"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type mediaThumbnail struct {
+	URL string `xml:"url,attr"`
+}
+
+// EncodeRSS สร้าง RSS 2.0 พร้อม media:thumbnail
+func EncodeRSS(channel FeedChannel, items []FeedItem) ([]byte, error) {
+	feed := rssFeed{
+		Version: "2.0",
+		Media:   mediaNS,
+		Channel: rssChannel{
+			Title:       channel.Title,
+			Link:        channel.Link,
+			Description: channel.Description,
+			Language:    channel.Language,
+			Items:       make([]rssItem, 0, len(items)),
+		},
+	}
+	if !channel.Updated.IsZero() {
+		feed.Channel.LastBuildDate = channel.Updated.UTC().Format(time.RFC1123Z)
+	}
+
+	for _, item := range items {
+		entry := rssItem{
+			Title:       item.Title,
+			Link:        item.Link,
+			GUID:        rssGUID{IsPermaLink: true, Value: item.Link},
+			Description: item.Description,
+			Categories:  item.Categories,
+		}
+		if !item.PublishedAt.IsZero() {
+			entry.PubDate = item.PublishedAt.UTC().Format(time.RFC1123Z)
+		}
+		if item.ImageURL != "" {
+			entry.Thumbnail = &mediaThumbnail{URL: item.ImageURL}
+		}
+		feed.Channel.Items = append(feed.Channel.Items, entry)
+	}
+
+	return encodeXML(feed)
+}
+
+// ========================================
+// Atom 1.0
+// ========================================
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"feed"`
+	XMLNS   string      `xml:"xmlns,attr"`
+	Lang    string      `xml:"xml:lang,attr,omitempty"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Link    atomLink    `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Updated    string         `xml:"updated"`
+	Published  string         `xml:"published,omitempty"`
+	Summary    string         `xml:"summary,omitempty"`
+	Links      []atomLink     `xml:"link"`
+	Categories []atomCategory `xml:"category,omitempty"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+// EncodeAtom สร้าง Atom 1.0 (รูปปกเป็น link rel="enclosure")
+func EncodeAtom(channel FeedChannel, items []FeedItem) ([]byte, error) {
+	feed := atomFeed{
+		XMLNS:   atomNS,
+		Lang:    channel.Language,
+		ID:      channel.Link,
+		Title:   channel.Title,
+		Updated: formatAtomTime(channel.Updated),
+		Link:    atomLink{Rel: "alternate", Type: "text/html", Href: channel.Link},
+		Entries: make([]atomEntry, 0, len(items)),
+	}
+
+	for _, item := range items {
+		updated := item.UpdatedAt
+		if updated.IsZero() {
+			updated = item.PublishedAt
+		}
+
+		entry := atomEntry{
+			ID:      item.Link,
+			Title:   item.Title,
+			Updated: formatAtomTime(updated),
+			Summary: item.Description,
+			Links:   []atomLink{{Rel: "alternate", Type: "text/html", Href: item.Link}},
+		}
+		if !item.PublishedAt.IsZero() {
+			entry.Published = formatAtomTime(item.PublishedAt)
+		}
+		if item.ImageURL != "" {
+			entry.Links = append(entry.Links, atomLink{Rel: "enclosure", Href: item.ImageURL})
+		}
+		for _, c := range item.Categories {
+			entry.Categories = append(entry.Categories, atomCategory{Term: c})
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+
+	return encodeXML(feed)
+}
+
+// formatAtomTime - Atom บังคับให้มี updated เสมอ ถ้าไม่มีเวลาใช้เวลาปัจจุบัน
+func formatAtomTime(t time.Time) string {
+	if t.IsZero() {
+		t = time.Now()
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,196 @@
+package seo
+
+import (
+	"fmt"
+	"time"
+)
+
+// ========================================
+// Schema.org JSON-LD
+// ========================================
+// frontend ใส่ทั้งก้อนลงใน <script type="application/ld+json"> ได้เลย
+// node ที่อ้างถึงกันใช้ @id (URL ของหน้า + #fragment) แทนการซ้อนข้อมูลซ้ำ
+
+const schemaContext = "https://schema.org"
+
+// Rating scale ของรีวิว (content.rating 1-5)
+const (
+	BestRating  = 5
+	WorstRating = 1
+)
+
+// JSONLDGraph - {"@context": ..., "@graph": [...]}
+type JSONLDGraph struct {
+	Context string        `json:"@context"`
+	Graph   []interface{} `json:"@graph"`
+}
+
+// NewJSONLDGraph รวม node เป็น graph เดียว (ข้าม nil)
+func NewJSONLDGraph(nodes ...interface{}) *JSONLDGraph {
+	graph := &JSONLDGraph{Context: schemaContext, Graph: make([]interface{}, 0, len(nodes))}
+	for _, n := range nodes {
+		if n == nil {
+			continue
+		}
+		graph.Graph = append(graph.Graph, n)
+	}
+	return graph
+}
+
+// JSONLDRef - อ้าง node อื่นใน graph ด้วย @id
+type JSONLDRef struct {
+	ID string `json:"@id"`
+}
+
+type JSONLDPerson struct {
+	Type          string   `json:"@type"`
+	ID            string   `json:"@id,omitempty"`
+	Name          string   `json:"name"`
+	AlternateName []string `json:"alternateName,omitempty"`
+	URL           string   `json:"url,omitempty"`
+}
+
+type JSONLDOrganization struct {
+	Type string             `json:"@type"`
+	ID   string             `json:"@id,omitempty"`
+	Name string             `json:"name"`
+	URL  string             `json:"url,omitempty"`
+	Logo *JSONLDImageObject `json:"logo,omitempty"`
+}
+
+type JSONLDImageObject struct {
+	Type string `json:"@type"`
+	URL  string `json:"url"`
+}
+
+// JSONLDRating - reviewRating ของรีวิว 1 ชิ้น
+type JSONLDRating struct {
+	Type        string  `json:"@type"`
+	RatingValue float64 `json:"ratingValue"`
+	BestRating  int     `json:"bestRating"`
+	WorstRating int     `json:"worstRating"`
+}
+
+// JSONLDAggregateRating - คะแนนรวมของสิ่งที่ถูกรีวิว
+type JSONLDAggregateRating struct {
+	Type        string  `json:"@type"`
+	RatingValue float64 `json:"ratingValue"`
+	BestRating  int     `json:"bestRating"`
+	WorstRating int     `json:"worstRating"`
+	RatingCount int     `json:"ratingCount"`
+}
+
+type JSONLDVideoObject struct {
+	Type                string                 `json:"@type"`
+	ID                  string                 `json:"@id,omitempty"`
+	Name                string                 `json:"name"`
+	Description         string                 `json:"description,omitempty"`
+	ThumbnailURL        string                 `json:"thumbnailUrl,omitempty"`
+	UploadDate          string                 `json:"uploadDate,omitempty"`
+	Duration            string                 `json:"duration,omitempty"` // ISO 8601 เช่น PT120M
+	EmbedURL            string                 `json:"embedUrl,omitempty"`
+	URL                 string                 `json:"url,omitempty"`
+	InLanguage          string                 `json:"inLanguage,omitempty"`
+	Genre               []string               `json:"genre,omitempty"`
+	Actor               []JSONLDPerson         `json:"actor,omitempty"`
+	ProductionCompany   *JSONLDOrganization    `json:"productionCompany,omitempty"`
+	AggregateRating     *JSONLDAggregateRating `json:"aggregateRating,omitempty"`
+	IsAccessibleForFree bool                   `json:"isAccessibleForFree"`
+}
+
+type JSONLDReview struct {
+	Type          string              `json:"@type"`
+	ID            string              `json:"@id,omitempty"`
+	Name          string              `json:"name"`
+	URL           string              `json:"url"`
+	Description   string              `json:"description,omitempty"`
+	InLanguage    string              `json:"inLanguage,omitempty"`
+	DatePublished string              `json:"datePublished,omitempty"`
+	DateModified  string              `json:"dateModified,omitempty"`
+	Author        *JSONLDOrganization `json:"author,omitempty"`
+	Publisher     *JSONLDOrganization `json:"publisher,omitempty"`
+	ItemReviewed  interface{}         `json:"itemReviewed,omitempty"`
+	ReviewRating  *JSONLDRating       `json:"reviewRating,omitempty"`
+}
+
+// JSONLDArticle - บทความทั่วไป (guide/news และ ranking ที่ไม่มีรายการ video)
+type JSONLDArticle struct {
+	Type          string              `json:"@type"`
+	ID            string              `json:"@id,omitempty"`
+	Headline      string              `json:"headline"`
+	URL           string              `json:"url"`
+	Description   string              `json:"description,omitempty"`
+	Image         string              `json:"image,omitempty"`
+	InLanguage    string              `json:"inLanguage,omitempty"`
+	DatePublished string              `json:"datePublished,omitempty"`
+	DateModified  string              `json:"dateModified,omitempty"`
+	Author        *JSONLDOrganization `json:"author,omitempty"`
+	Publisher     *JSONLDOrganization `json:"publisher,omitempty"`
+	MainEntity    *JSONLDRef          `json:"mainEntity,omitempty"` // ItemList ของหน้า (ถ้ามี)
+}
+
+type JSONLDItemList struct {
+	Type            string           `json:"@type"`
+	ID              string           `json:"@id,omitempty"`
+	Name            string           `json:"name"`
+	URL             string           `json:"url,omitempty"`
+	Description     string           `json:"description,omitempty"`
+	NumberOfItems   int              `json:"numberOfItems"`
+	ItemListOrder   string           `json:"itemListOrder,omitempty"`
+	ItemListElement []JSONLDListItem `json:"itemListElement"`
+}
+
+type JSONLDListItem struct {
+	Type     string `json:"@type"`
+	Position int    `json:"position"`
+	URL      string `json:"url,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Image    string `json:"image,omitempty"`
+}
+
+// NewPerson - Person node
+func NewPerson(id, name, url string, alternateNames []string) JSONLDPerson {
+	return JSONLDPerson{Type: "Person", ID: id, Name: name, URL: url, AlternateName: alternateNames}
+}
+
+// NewOrganization - Organization node (ไม่มี url = ไม่ใส่ @id)
+func NewOrganization(name, url string) *JSONLDOrganization {
+	org := &JSONLDOrganization{Type: "Organization", Name: name, URL: url}
+	if url != "" {
+		org.ID = url + "#organization"
+	}
+	return org
+}
+
+// SiteOrganization - publisher/author ของบทความ (ตัวเว็บเอง)
+func SiteOrganization(siteURL string) *JSONLDOrganization {
+	org := NewOrganization(SiteName, PageURL(siteURL, "/"))
+	org.Logo = &JSONLDImageObject{Type: "ImageObject", URL: PageURL(siteURL, "/logo.png")}
+	return org
+}
+
+// NewRating - reviewRating scale 1-5
+func NewRating(value float64) *JSONLDRating {
+	return &JSONLDRating{Type: "Rating", RatingValue: value, BestRating: BestRating, WorstRating: WorstRating}
+}
+
+// NewAggregateRating - aggregateRating scale 1-5
+func NewAggregateRating(value float64, count int) *JSONLDAggregateRating {
+	return &JSONLDAggregateRating{Type: "AggregateRating", RatingValue: value, BestRating: BestRating, WorstRating: WorstRating, RatingCount: count}
+}
+
+// ISODuration แปลงนาทีเป็น ISO 8601 duration (0 = ว่าง)
+func ISODuration(minutes int) string {
+	if minutes <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("PT%dM", minutes)
+}
+
+// ISODate - format วันที่สำหรับ JSON-LD (nil/zero = ว่าง)
+func ISODate(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
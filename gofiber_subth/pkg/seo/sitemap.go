@@ -0,0 +1,105 @@
+package seo
+
+import (
+	"bytes"
+	"encoding/xml"
+	"time"
+)
+
+const (
+	// MaxSitemapURLs - จำนวน URL สูงสุดต่อไฟล์ตาม sitemaps.org
+	MaxSitemapURLs = 50000
+
+	sitemapNS = "http://www.sitemaps.org/schemas/sitemap/0.9"
+	xhtmlNS   = "http://www.w3.org/1999/xhtml"
+	videoNS   = "http://www.google.com/schemas/sitemap-video/1.1"
+)
+
+// SitemapURL - <url> หนึ่งรายการใน urlset
+type SitemapURL struct {
+	XMLName    xml.Name           `xml:"url"`
+	Loc        string             `xml:"loc"`
+	LastMod    string             `xml:"lastmod,omitempty"`
+	ChangeFreq string             `xml:"changefreq,omitempty"`
+	Priority   string             `xml:"priority,omitempty"`
+	Alternates []SitemapAlternate `xml:"xhtml:link,omitempty"`
+	Videos     []SitemapVideo     `xml:"video:video,omitempty"`
+}
+
+// SitemapAlternate - hreflang alternate (<xhtml:link rel="alternate">)
+type SitemapAlternate struct {
+	Rel      string `xml:"rel,attr"`
+	Hreflang string `xml:"hreflang,attr"`
+	Href     string `xml:"href,attr"`
+}
+
+// SitemapVideo - Google video sitemap extension (<video:video>)
+type SitemapVideo struct {
+	ThumbnailLoc    string `xml:"video:thumbnail_loc"`
+	Title           string `xml:"video:title"`
+	Description     string `xml:"video:description"`
+	PlayerLoc       string `xml:"video:player_loc,omitempty"`
+	Duration        int    `xml:"video:duration,omitempty"` // วินาที
+	PublicationDate string `xml:"video:publication_date,omitempty"`
+}
+
+// SitemapRef - <sitemap> หนึ่งรายการใน sitemapindex
+type SitemapRef struct {
+	XMLName xml.Name `xml:"sitemap"`
+	Loc     string   `xml:"loc"`
+	LastMod string   `xml:"lastmod,omitempty"`
+}
+
+type urlSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	XHTML   string       `xml:"xmlns:xhtml,attr,omitempty"`
+	Video   string       `xml:"xmlns:video,attr,omitempty"`
+	URLs    []SitemapURL `xml:"url"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name     `xml:"sitemapindex"`
+	XMLNS    string       `xml:"xmlns,attr"`
+	Sitemaps []SitemapRef `xml:"sitemap"`
+}
+
+// EncodeURLSet สร้าง XML ของ sitemap หนึ่งไฟล์
+// ใส่ namespace xhtml/video เฉพาะเมื่อมีการใช้งานจริง
+func EncodeURLSet(urls []SitemapURL) ([]byte, error) {
+	set := urlSet{XMLNS: sitemapNS, URLs: urls}
+	for _, u := range urls {
+		if len(u.Alternates) > 0 {
+			set.XHTML = xhtmlNS
+		}
+		if len(u.Videos) > 0 {
+			set.Video = videoNS
+		}
+	}
+	return encodeXML(set)
+}
+
+// EncodeSitemapIndex สร้าง XML ของ sitemap index
+func EncodeSitemapIndex(refs []SitemapRef) ([]byte, error) {
+	return encodeXML(sitemapIndex{XMLNS: sitemapNS, Sitemaps: refs})
+}
+
+// FormatLastMod - W3C datetime ตามที่ sitemap กำหนด
+func FormatLastMod(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func encodeXML(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	buf.WriteString("\n")
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,59 @@
+package seo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultLanguage - ภาษาหลักของเว็บ (ไม่มี prefix ใน URL)
+const DefaultLanguage = "th"
+
+// LangPrefix returns path prefix ของภาษา
+// th = "" (default), en = "/en"
+func LangPrefix(lang string) string {
+	if lang == "" || lang == DefaultLanguage {
+		return ""
+	}
+	return "/" + lang
+}
+
+// ArticlePath returns path ของบทความบน frontend
+// Format: [/{lang}]/articles/{type}/{slug}
+func ArticlePath(lang, articleType, slug string) string {
+	return fmt.Sprintf("%s/articles/%s/%s", LangPrefix(lang), articleType, slug)
+}
+
+// ArticleURL returns canonical URL ของบทความบน frontend
+// Format: {siteURL}[/{lang}]/articles/{type}/{slug}
+func ArticleURL(siteURL, lang, articleType, slug string) string {
+	return strings.TrimRight(siteURL, "/") + ArticlePath(lang, articleType, slug)
+}
+
+// CastPath returns path ของหน้ารวมบทความของนักแสดง
+// Format: [/{lang}]/casts/{slug}
+func CastPath(lang, slug string) string {
+	return fmt.Sprintf("%s/casts/%s", LangPrefix(lang), slug)
+}
+
+// TagPath returns path ของหน้ารวมบทความของ tag
+// Format: [/{lang}]/tags/{slug}
+func TagPath(lang, slug string) string {
+	return fmt.Sprintf("%s/tags/%s", LangPrefix(lang), slug)
+}
+
+// MakerPath returns path ของหน้ารวมบทความของค่าย
+// Format: [/{lang}]/makers/{slug}
+func MakerPath(lang, slug string) string {
+	return fmt.Sprintf("%s/makers/%s", LangPrefix(lang), slug)
+}
+
+// VideoPath returns path ของหน้า video (member)
+// Format: [/{lang}]/member/videos/{id}
+func VideoPath(lang, id string) string {
+	return fmt.Sprintf("%s/member/videos/%s", LangPrefix(lang), id)
+}
+
+// PageURL ต่อ path เข้ากับ siteURL
+func PageURL(siteURL, path string) string {
+	return strings.TrimRight(siteURL, "/") + path
+}
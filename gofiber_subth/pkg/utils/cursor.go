@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor - ตำแหน่งของ keyset pagination = sort key + id ของแถวสุดท้ายในหน้าก่อน
+// client ได้เป็น string ทึบ (base64url ของ JSON) แล้วส่งกลับมาตามเดิม ห้ามแก้เอง
+type Cursor struct {
+	Sort string     `json:"s"`           // sort ที่ใช้ตอนสร้าง (กันเอา cursor ไปใช้ข้าม sort)
+	Time *time.Time `json:"t,omitempty"` // sort key แบบเวลา (created_at, release_date)
+	Int  *int64     `json:"n,omitempty"` // sort key แบบตัวเลข (views)
+	ID   uuid.UUID  `json:"id"`
+}
+
+func EncodeCursor(cursor Cursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor อ่าน cursor และตรวจว่าสร้างจาก sort เดียวกัน
+func DecodeCursor(value, sort string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if cursor.Sort != sort || cursor.ID == uuid.Nil || (cursor.Time == nil) == (cursor.Int == nil) {
+		return nil, ErrInvalidCursor
+	}
+	return &cursor, nil
+}
+
+// UseCursor - client ขอแบบ cursor เมื่อส่ง ?cursor= มา (ค่าว่าง = หน้าแรก) ไม่งั้นใช้ page/limit เดิม
+func UseCursor(c *fiber.Ctx) bool {
+	return c.Request().URI().QueryArgs().Has("cursor")
+}
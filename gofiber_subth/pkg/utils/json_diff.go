@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// JSON diff operations
+const (
+	JSONChangeAdded   = "added"
+	JSONChangeRemoved = "removed"
+	JSONChangeChanged = "changed"
+)
+
+// JSONChange การเปลี่ยนแปลงหนึ่งจุดใน JSON document
+type JSONChange struct {
+	Path string
+	Op   string
+	From interface{}
+	To   interface{}
+}
+
+// DiffJSON เปรียบเทียบค่า JSON ที่ decode แล้ว (map[string]interface{}, []interface{}, scalar)
+// แบบ recursive และคืนรายการจุดที่ต่างกัน เรียงตาม key เพื่อให้ผลลัพธ์คงที่
+// Path format: content.facts.cast[2]
+func DiffJSON(path string, from, to interface{}) []JSONChange {
+	var changes []JSONChange
+	diffJSONValue(path, from, to, &changes)
+	return changes
+}
+
+func diffJSONValue(path string, from, to interface{}, changes *[]JSONChange) {
+	switch fromVal := from.(type) {
+	case map[string]interface{}:
+		toVal, ok := to.(map[string]interface{})
+		if !ok {
+			break
+		}
+
+		keys := make([]string, 0, len(fromVal)+len(toVal))
+		for k := range fromVal {
+			keys = append(keys, k)
+		}
+		for k := range toVal {
+			if _, exists := fromVal[k]; !exists {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			childPath := k
+			if path != "" {
+				childPath = path + "." + k
+			}
+
+			fromChild, inFrom := fromVal[k]
+			toChild, inTo := toVal[k]
+			switch {
+			case !inFrom:
+				*changes = append(*changes, JSONChange{Path: childPath, Op: JSONChangeAdded, To: toChild})
+			case !inTo:
+				*changes = append(*changes, JSONChange{Path: childPath, Op: JSONChangeRemoved, From: fromChild})
+			default:
+				diffJSONValue(childPath, fromChild, toChild, changes)
+			}
+		}
+		return
+
+	case []interface{}:
+		toVal, ok := to.([]interface{})
+		if !ok {
+			break
+		}
+
+		for i := 0; i < len(fromVal) || i < len(toVal); i++ {
+			childPath := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= len(fromVal):
+				*changes = append(*changes, JSONChange{Path: childPath, Op: JSONChangeAdded, To: toVal[i]})
+			case i >= len(toVal):
+				*changes = append(*changes, JSONChange{Path: childPath, Op: JSONChangeRemoved, From: fromVal[i]})
+			default:
+				diffJSONValue(childPath, fromVal[i], toVal[i], changes)
+			}
+		}
+		return
+	}
+
+	// scalar หรือ type ไม่ตรงกัน
+	if !reflect.DeepEqual(from, to) {
+		*changes = append(*changes, JSONChange{Path: path, Op: JSONChangeChanged, From: from, To: to})
+	}
+}
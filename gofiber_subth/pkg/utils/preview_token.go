@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// ArticlePreviewAudience - กัน preview token ถูกใช้เป็น access token (และกลับกัน)
+const ArticlePreviewAudience = "article-preview"
+
+type ArticlePreviewClaims struct {
+	ArticleID string `json:"article_id"`
+	jwt.RegisteredClaims
+}
+
+// articlePreviewKey - แยก key จาก access token แม้ใช้ JWT secret เดียวกัน
+func articlePreviewKey(secret string) []byte {
+	return []byte(secret + ":" + ArticlePreviewAudience)
+}
+
+// GenerateArticlePreviewToken สร้าง signed token สำหรับดูบทความที่ยังไม่ publish
+// tokenID (jti) ใช้ตรวจ/revoke ใน Redis
+func GenerateArticlePreviewToken(articleID uuid.UUID, tokenID string, expiresAt time.Time, secret string) (string, error) {
+	claims := ArticlePreviewClaims{
+		ArticleID: articleID.String(),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        tokenID,
+			Audience:  jwt.ClaimStrings{ArticlePreviewAudience},
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(articlePreviewKey(secret))
+}
+
+// ParseArticlePreviewToken ตรวจ signature/วันหมดอายุ แล้วคืน claims
+func ParseArticlePreviewToken(tokenString, secret string) (*ArticlePreviewClaims, error) {
+	if tokenString == "" {
+		return nil, ErrMissingToken
+	}
+
+	token, err := jwt.ParseWithClaims(tokenString, &ArticlePreviewClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+		return articlePreviewKey(secret), nil
+	}, jwt.WithAudience(ArticlePreviewAudience))
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, ErrExpiredToken
+		}
+		return nil, ErrInvalidToken
+	}
+
+	claims, ok := token.Claims.(*ArticlePreviewClaims)
+	if !ok || !token.Valid || claims.ID == "" {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
@@ -34,6 +34,20 @@ type Meta struct {
 	HasPrev    bool  `json:"hasPrev"`
 }
 
+// CursorPaginatedResponse - แบบ cursor ไม่มี total/page (ไม่ต้อง COUNT ทั้งตาราง)
+type CursorPaginatedResponse struct {
+	Success bool       `json:"success"`
+	Data    any        `json:"data,omitempty"`
+	Meta    CursorMeta `json:"meta"`
+	Error   *ErrorInfo `json:"error,omitempty"`
+}
+
+type CursorMeta struct {
+	Limit      int    `json:"limit"`
+	NextCursor string `json:"nextCursor,omitempty"`
+	HasNext    bool   `json:"hasNext"`
+}
+
 // ========== Error Code Constants ==========
 
 const (
@@ -62,6 +76,17 @@ func CreatedResponse(c *fiber.Ctx, data any) error {
 	})
 }
 
+// RedirectResponse ตอบข้อมูล redirect แบบ 301 ให้ frontend (SSR) ไปทำ permanent redirect เอง
+// ใช้ status 200 + header แทน 3xx จริง เพราะ fetch ฝั่ง server จะ follow Location ไปที่ API อัตโนมัติ
+func RedirectResponse(c *fiber.Ctx, location string, data any) error {
+	c.Set("X-Redirect-Status", "301")
+	c.Set("X-Redirect-Location", location)
+	return c.Status(fiber.StatusOK).JSON(Response{
+		Success: true,
+		Data:    data,
+	})
+}
+
 func NoContentResponse(c *fiber.Ctx) error {
 	return c.SendStatus(fiber.StatusNoContent)
 }
@@ -86,6 +111,18 @@ func PaginatedSuccessResponse(c *fiber.Ctx, data any, total int64, page, limit i
 	})
 }
 
+func CursorPaginatedSuccessResponse(c *fiber.Ctx, data any, nextCursor string, limit int) error {
+	return c.Status(fiber.StatusOK).JSON(CursorPaginatedResponse{
+		Success: true,
+		Data:    data,
+		Meta: CursorMeta{
+			Limit:      limit,
+			NextCursor: nextCursor,
+			HasNext:    nextCursor != "",
+		},
+	})
+}
+
 // ========== Error Responses ==========
 
 func ErrorResponse(c *fiber.Ctx, statusCode int, code, message string, details any) error {
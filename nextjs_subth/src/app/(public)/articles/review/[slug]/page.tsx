@@ -1,5 +1,5 @@
 import { Metadata } from "next";
-import { notFound, redirect } from "next/navigation";
+import { notFound, permanentRedirect } from "next/navigation";
 import { getArticleByTypeAndSlug, ArticlePage } from "@/features/article";
 
 interface PageProps {
@@ -64,9 +64,9 @@ export default async function ReviewArticlePage({ params }: PageProps) {
     notFound();
   }
 
-  // Redirect ไปยัง slug ที่ถูกต้องถ้าภาษาไม่ตรง
-  if (article.redirectSlug) {
-    redirect(`/articles/review/${article.redirectSlug}`);
+  // Redirect (301) ไปยัง URL ที่ถูกต้อง - slug เปลี่ยน, type เปลี่ยน หรือภาษาไม่ตรง
+  if (article.redirect?.path) {
+    permanentRedirect(article.redirect.path);
   }
 
   return <ArticlePage article={article} locale="th" />;
@@ -1,5 +1,5 @@
 import { Metadata } from "next";
-import { notFound, redirect } from "next/navigation";
+import { notFound, permanentRedirect } from "next/navigation";
 import { getArticleByTypeAndSlug, ArticlePage } from "@/features/article";
 
 interface PageProps {
@@ -64,9 +64,9 @@ export default async function ReviewArticlePageEN({ params }: PageProps) {
     notFound();
   }
 
-  // Redirect to correct slug if language mismatch
-  if (article.redirectSlug) {
-    redirect(`/en/articles/review/${article.redirectSlug}`);
+  // Redirect (301) to canonical URL - slug/type changed or language mismatch
+  if (article.redirect?.path) {
+    permanentRedirect(article.redirect.path);
   }
 
   return <ArticlePage article={article} locale="en" />;
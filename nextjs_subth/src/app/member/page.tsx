@@ -1,6 +1,9 @@
 import { VideoGrid } from "@/features/video/components";
 import { videoService } from "@/features/video/service";
-import type { CategoryWithVideos } from "@/features/video/types";
+import type {
+  CategoryWithVideos,
+  CollectionWithVideos,
+} from "@/features/video/types";
 import { getDictionary } from "@/lib/i18n/dictionaries";
 import Link from "next/link";
 import { ChevronRight } from "lucide-react";
@@ -10,21 +13,44 @@ export default async function HomePage() {
   const dict = await getDictionary("th");
 
   let categoryGroups: CategoryWithVideos[] = [];
+  let collectionGroups: CollectionWithVideos[] = [];
 
-  try {
-    categoryGroups = await videoService.getByCategories({
-      limit: 6,
-      lang: "th",
-    });
-  } catch (e) {
-    console.error("Failed to fetch videos by categories:", e);
+  // collection ที่ admin เลือกแสดงหน้าแรก ขึ้นก่อนหมวดหมู่ (ดึงไม่ได้ = แสดงแค่หมวดหมู่)
+  const [categoriesResult, collectionsResult] = await Promise.allSettled([
+    videoService.getByCategories({ limit: 6, lang: "th" }),
+    videoService.getHomeCollections({ limit: 6, lang: "th" }),
+  ]);
+  if (categoriesResult.status === "fulfilled") {
+    categoryGroups = categoriesResult.value;
+  } else {
+    console.error("Failed to fetch videos by categories:", categoriesResult.reason);
+  }
+  if (collectionsResult.status === "fulfilled") {
+    collectionGroups = collectionsResult.value.filter((group) => group.videos.length > 0);
+  } else {
+    console.error("Failed to fetch home collections:", collectionsResult.reason);
   }
 
   return (
     <div className="space-y-6">
+      {collectionGroups.map((group, index) => (
+        <section key={group.collection.id}>
+          {index > 0 && <Separator className="mb-6" />}
+          <div className="mb-4">
+            <h2 className="text-2xl font-semibold">{group.collection.name}</h2>
+            {group.collection.description && (
+              <p className="text-sm text-muted-foreground mt-1">
+                {group.collection.description}
+              </p>
+            )}
+          </div>
+          <VideoGrid videos={group.videos} cols={6} />
+        </section>
+      ))}
+
       {categoryGroups.map((group, index) => (
         <section key={group.category.id}>
-          {index > 0 && <Separator className="mb-6" />}
+          {(index > 0 || collectionGroups.length > 0) && <Separator className="mb-6" />}
           <div className="flex items-center justify-between mb-4">
             <h2 className="text-2xl font-semibold">
               {group.category.name}{" "}
@@ -44,7 +70,7 @@ export default async function HomePage() {
         </section>
       ))}
 
-      {categoryGroups.length === 0 && (
+      {categoryGroups.length === 0 && collectionGroups.length === 0 && (
         <div className="text-center py-12 text-muted-foreground">
           {dict.common.noData}
         </div>
@@ -1,4 +1,5 @@
 import { videoService } from "@/features/video/service";
+import { VideoGrid } from "@/features/video/components";
 import { getDictionary } from "@/lib/i18n/dictionaries";
 import { notFound } from "next/navigation";
 import Image from "next/image";
@@ -84,6 +85,13 @@ export default async function VideoDetailPage({ params }: PageProps) {
           {/* Title */}
           <h1 className="text-xl font-bold">{video.title}</h1>
 
+          {/* Series */}
+          {video.series && (
+            <p className="text-sm text-muted-foreground">
+              {video.series.name} • {dict.video.episode} {video.series.position}/{video.series.total}
+            </p>
+          )}
+
           {/* Meta Info */}
           <div className="flex flex-wrap gap-2 text-sm text-muted-foreground">
             {video.releaseDate && (
@@ -154,6 +162,16 @@ export default async function VideoDetailPage({ params }: PageProps) {
           )}
         </div>
       </div>
+
+      {/* Other episodes in the same series */}
+      {video.series && video.series.episodes.length > 0 && (
+        <section className="mt-8">
+          <h2 className="text-lg font-semibold mb-4">
+            {dict.video.otherEpisodes}: {video.series.name}
+          </h2>
+          <VideoGrid videos={video.series.episodes} cols={6} />
+        </section>
+      )}
     </div>
   );
 }
@@ -23,11 +23,11 @@ export default async function ReelsPage() {
   let initialData: ReelListResponse = {
     success: true,
     data: [],
-    meta: { total: 0, page: 1, limit: 10, totalPages: 0, hasNext: false, hasPrev: false },
+    meta: { limit: 10, hasNext: false },
   };
 
   try {
-    initialData = await feedService.getReels({ page: 1, limit: 10, lang: "th" });
+    initialData = await feedService.getReels({ limit: 10, lang: "th" });
   } catch (error) {
     console.error("Failed to fetch initial reels:", error);
   }
@@ -10,6 +10,7 @@ export default function robots(): MetadataRoute.Robots {
         disallow: ["/api/", "/member/", "/en/member/"],
       },
     ],
+    // sitemap index ที่ backend สร้าง (ลิงก์ไปทุกไฟล์ลูก articles-N, videos-N, ...)
     sitemap: `${SITE_URL}/sitemap.xml`,
   };
 }
@@ -0,0 +1,10 @@
+import { proxySitemap } from "@/lib/sitemap";
+
+// ไฟล์ลูกใน sitemap index ของ backend อ้างเป็น {SITE_URL}/sitemap/{name} (ค่า default ของ SITEMAP_BASE_URL)
+export async function GET(
+  _request: Request,
+  { params }: { params: Promise<{ name: string }> }
+) {
+  const { name } = await params;
+  return proxySitemap(name);
+}
@@ -63,6 +63,7 @@ export { formatTimestamp, parseDuration, formatDuration } from "./utils";
 // Types
 export type {
   Article,
+  ArticleRedirectTarget,
   ArticleContent,
   CastProfile,
   MakerInfo,
@@ -18,12 +18,22 @@ export interface Article {
   content: ArticleContent;
   translations?: Record<string, string>; // {"en": "slug-en", "th": "slug-th"}
   redirectSlug?: string; // สำหรับ redirect เมื่อ slug ไม่ตรงกับภาษา (fallback)
+  redirect?: ArticleRedirectTarget; // canonical URL เมื่อ slug/type/ภาษาเปลี่ยน (301)
   // Engagement counts (from API)
   likesCount?: number;
   commentsCount?: number;
   viewCount?: number;
 }
 
+// ArticleRedirectTarget - ปลายทาง redirect จาก API (path รวม prefix ภาษาและ type แล้ว)
+export interface ArticleRedirectTarget {
+  type: string;
+  slug: string;
+  language: string;
+  path: string; // เช่น /en/articles/review/{slug}
+  statusCode: number;
+}
+
 export interface ArticleContent {
   // === Chunk 1: Quick Answer ===
   quickAnswer: string;
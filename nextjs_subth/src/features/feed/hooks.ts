@@ -9,7 +9,7 @@ import type { FeedListResponse, FeedListParams } from "./types";
 // Client-side fetch function for React Query
 async function fetchFeed(params: FeedListParams): Promise<FeedListResponse> {
   const searchParams = new URLSearchParams();
-  searchParams.set("page", String(params.page || 1));
+  searchParams.set("cursor", params.cursor || "");
   searchParams.set("limit", String(params.limit || 20));
   if (params.lang) searchParams.set("lang", params.lang);
 
@@ -27,7 +27,7 @@ export const feedKeys = {
 
 // Infinite scroll hook
 export function useInfiniteFeed(
-  params?: Omit<FeedListParams, "page">,
+  params?: Omit<FeedListParams, "cursor">,
   initialData?: FeedListResponse
 ) {
   // Get token for authenticated requests (to get isLiked status)
@@ -35,16 +35,16 @@ export function useInfiniteFeed(
 
   return useInfiniteQuery({
     queryKey: [...feedKeys.list(params), !!token],
-    queryFn: ({ pageParam = 1 }) =>
-      fetchFeed({ ...params, page: pageParam }),
+    queryFn: ({ pageParam }) =>
+      fetchFeed({ ...params, cursor: pageParam }),
     getNextPageParam: (lastPage) =>
-      lastPage.meta.hasNext ? lastPage.meta.page + 1 : undefined,
-    initialPageParam: 1,
+      lastPage.meta.hasNext ? lastPage.meta.nextCursor : undefined,
+    initialPageParam: "",
     // Only use initialData when not authenticated (server-side data doesn't have isLiked)
     initialData: !token && initialData
       ? {
           pages: [initialData],
-          pageParams: [1],
+          pageParams: [""],
         }
       : undefined,
     // Refetch immediately when authenticated to get isLiked status
@@ -6,7 +6,7 @@ export const feedService = {
   // Get feed items for home page (cover images)
   async getFeed(params?: FeedListParams): Promise<FeedListResponse> {
     const searchParams = new URLSearchParams();
-    searchParams.set("page", String(params?.page || 1));
+    searchParams.set("cursor", params?.cursor || "");
     searchParams.set("limit", String(params?.limit || 20));
     if (params?.lang) searchParams.set("lang", params.lang);
 
@@ -19,7 +19,7 @@ export const feedService = {
   // Get reels for reels page (videos)
   async getReels(params?: FeedListParams): Promise<ReelListResponse> {
     const searchParams = new URLSearchParams();
-    searchParams.set("page", String(params?.page || 1));
+    searchParams.set("cursor", params?.cursor || "");
     searchParams.set("limit", String(params?.limit || 10));
     if (params?.lang) searchParams.set("lang", params.lang);
 
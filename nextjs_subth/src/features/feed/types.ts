@@ -24,34 +24,27 @@ export interface ReelItem {
 }
 
 // API Response types
+// Feed/reels ใช้ cursor pagination (ไม่มี total/page) ส่ง meta.nextCursor กลับไปเพื่อโหลดหน้าถัดไป
+export interface CursorMeta {
+  limit: number;
+  nextCursor?: string;
+  hasNext: boolean;
+}
+
 export interface FeedListResponse {
   success: boolean;
   data: FeedItem[];
-  meta: {
-    total: number;
-    page: number;
-    limit: number;
-    totalPages: number;
-    hasNext: boolean;
-    hasPrev: boolean;
-  };
+  meta: CursorMeta;
 }
 
 export interface ReelListResponse {
   success: boolean;
   data: ReelItem[];
-  meta: {
-    total: number;
-    page: number;
-    limit: number;
-    totalPages: number;
-    hasNext: boolean;
-    hasPrev: boolean;
-  };
+  meta: CursorMeta;
 }
 
 export interface FeedListParams {
-  page?: number;
+  cursor?: string; // ว่าง = หน้าแรก
   limit?: number;
   lang?: string;
 }
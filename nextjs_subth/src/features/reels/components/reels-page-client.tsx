@@ -5,6 +5,7 @@ import { ReelsLayout } from "./reels-layout";
 import { ReelsFeed } from "./reels-feed";
 import { useInfiniteReels } from "../hooks";
 import type { ReelItem } from "../types";
+import type { CursorMeta } from "@/features/feed/types";
 
 // Accept data from feed service (server-side)
 interface InitialData {
@@ -19,14 +20,7 @@ interface InitialData {
     tags: string[];
     createdAt: string;
   }>;
-  meta: {
-    total: number;
-    page: number;
-    limit: number;
-    totalPages: number;
-    hasNext: boolean;
-    hasPrev: boolean;
-  };
+  meta: CursorMeta;
 }
 
 interface ReelsPageClientProps {
@@ -9,7 +9,7 @@ import type { ReelListResponse, ReelListParams } from "./types";
 // Client-side fetch function for React Query
 async function fetchReels(params: ReelListParams): Promise<ReelListResponse> {
   const searchParams = new URLSearchParams();
-  searchParams.set("page", String(params.page || 1));
+  searchParams.set("cursor", params.cursor || "");
   searchParams.set("limit", String(params.limit || 10));
   if (params.lang) searchParams.set("lang", params.lang);
 
@@ -27,7 +27,7 @@ export const reelsKeys = {
 
 // Infinite scroll hook
 export function useInfiniteReels(
-  params?: Omit<ReelListParams, "page">,
+  params?: Omit<ReelListParams, "cursor">,
   initialData?: ReelListResponse
 ) {
   // Get token for authenticated requests (to get isLiked status)
@@ -35,16 +35,16 @@ export function useInfiniteReels(
 
   return useInfiniteQuery({
     queryKey: [...reelsKeys.list(params), !!token],
-    queryFn: ({ pageParam = 1 }) =>
-      fetchReels({ ...params, page: pageParam }),
+    queryFn: ({ pageParam }) =>
+      fetchReels({ ...params, cursor: pageParam }),
     getNextPageParam: (lastPage) =>
-      lastPage.meta.hasNext ? lastPage.meta.page + 1 : undefined,
-    initialPageParam: 1,
+      lastPage.meta.hasNext ? lastPage.meta.nextCursor : undefined,
+    initialPageParam: "",
     // Only use initialData when not authenticated (server-side data doesn't have isLiked)
     initialData: !token && initialData
       ? {
           pages: [initialData],
-          pageParams: [1],
+          pageParams: [""],
         }
       : undefined,
     // Refetch immediately when authenticated to get isLiked status
@@ -29,17 +29,14 @@ export interface ReelListResponse {
   success: boolean;
   data: ReelItem[];
   meta: {
-    total: number;
-    page: number;
     limit: number;
-    totalPages: number;
+    nextCursor?: string;
     hasNext: boolean;
-    hasPrev: boolean;
   };
 }
 
 export interface ReelListParams {
-  page?: number;
+  cursor?: string; // ว่าง = หน้าแรก
   limit?: number;
   lang?: string;
 }
@@ -7,6 +7,8 @@ import type {
   VideoListResponse,
   CategoryWithVideos,
   VideosByCategoriesParams,
+  CollectionWithVideos,
+  HomeCollectionsParams,
 } from "./types";
 
 export const videoService = {
@@ -137,4 +139,19 @@ export const videoService = {
       { revalidate: 60 }
     );
   },
+
+  async getHomeCollections(
+    params?: HomeCollectionsParams
+  ): Promise<CollectionWithVideos[]> {
+    const searchParams = new URLSearchParams();
+    if (params?.limit) searchParams.set("limit", String(params.limit));
+    if (params?.collections)
+      searchParams.set("collections", String(params.collections));
+    if (params?.lang) searchParams.set("lang", params.lang);
+
+    return apiClient.serverGet<CollectionWithVideos[]>(
+      `${API_ROUTES.COLLECTIONS.HOME}?${searchParams.toString()}`,
+      { revalidate: 60 }
+    );
+  },
 };
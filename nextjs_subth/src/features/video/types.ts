@@ -28,10 +28,21 @@ export interface Video {
   casts?: Cast[];
   tags?: Tag[];
   autoTags?: AutoTag[];
+  series?: VideoSeries; // มีเมื่อ video อยู่ใน series
   createdAt: string;
   updatedAt: string;
 }
 
+// Series ของ video (VideoSeriesResponse)
+export interface VideoSeries {
+  id: string;
+  name: string;
+  slug: string;
+  position: number; // ตอนที่ของ video นี้ (เริ่มที่ 1)
+  total: number;
+  episodes: VideoListItem[]; // ตอนอื่นใน series (ไม่รวม video นี้) เรียงตามตอน
+}
+
 export interface Cast {
   id: string;
   name: string;
@@ -106,3 +117,24 @@ export interface VideosByCategoriesParams {
   categories?: number; // number of categories
   lang?: string;
 }
+
+// Collection with videos (for homepage)
+export interface Collection {
+  id: string;
+  name: string;
+  slug: string;
+  description?: string;
+  coverImage?: string;
+  videoCount: number;
+}
+
+export interface CollectionWithVideos {
+  collection: Collection;
+  videos: VideoListItem[];
+}
+
+export interface HomeCollectionsParams {
+  limit?: number; // videos per collection
+  collections?: number; // number of collections (0 = all)
+  lang?: string;
+}
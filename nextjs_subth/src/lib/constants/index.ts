@@ -40,6 +40,11 @@ export const API_ROUTES = {
     AUTO_TAGS: "/api/v1/videos/auto-tags",
   },
 
+  // Collections (homepage sections)
+  COLLECTIONS: {
+    HOME: "/api/v1/collections/home",
+  },
+
   // Casts
   CASTS: {
     LIST: "/api/v1/casts",
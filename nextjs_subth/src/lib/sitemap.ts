@@ -0,0 +1,39 @@
+import { NextResponse } from "next/server";
+
+const API_URL =
+  process.env.INTERNAL_API_URL ||
+  process.env.NEXT_PUBLIC_API_URL ||
+  "http://localhost:8080";
+
+// ชื่อไฟล์ที่ backend สร้าง (index.xml, static.xml, articles-1.xml, videos-1.xml, ...)
+const SITEMAP_NAME_PATTERN = /^[a-z]+(-[0-9]+)?\.xml$/;
+
+/**
+ * proxySitemap - ส่งต่อไฟล์ sitemap ที่ backend สร้างไว้ (SitemapWorker)
+ * sitemap ทั้งชุดสร้างที่ backend ที่เดียว frontend ไม่ render เอง
+ */
+export async function proxySitemap(name: string): Promise<NextResponse> {
+  if (!SITEMAP_NAME_PATTERN.test(name)) {
+    return new NextResponse("Not Found", { status: 404 });
+  }
+
+  try {
+    const response = await fetch(`${API_URL}/api/v1/sitemaps/${name}`, {
+      next: { revalidate: 3600 },
+    });
+    if (!response.ok) {
+      return new NextResponse("Not Found", { status: response.status === 404 ? 404 : 502 });
+    }
+
+    const xml = await response.text();
+    return new NextResponse(xml, {
+      headers: {
+        "Content-Type": "application/xml",
+        "Cache-Control": "public, max-age=3600, s-maxage=3600",
+      },
+    });
+  } catch (error) {
+    console.error("Failed to fetch sitemap:", name, error);
+    return new NextResponse("Bad Gateway", { status: 502 });
+  }
+}